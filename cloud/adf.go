@@ -0,0 +1,536 @@
+package cloud
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ADF node types
+const (
+	ADFTypeDoc         = "doc"
+	ADFTypeParagraph   = "paragraph"
+	ADFTypeText        = "text"
+	ADFTypeHardBreak   = "hardBreak"
+	ADFTypeHeading     = "heading"
+	ADFTypeMention     = "mention"
+	ADFTypeEmoji       = "emoji"
+	ADFTypeInlineCard  = "inlineCard"
+	ADFTypeCodeBlock   = "codeBlock"
+	ADFTypeBlockquote  = "blockquote"
+	ADFTypePanel       = "panel"
+	ADFTypeRule        = "rule"
+	ADFTypeBulletList  = "bulletList"
+	ADFTypeOrderedList = "orderedList"
+	ADFTypeListItem    = "listItem"
+	ADFTypeTable       = "table"
+	ADFTypeTableRow    = "tableRow"
+	ADFTypeTableHeader = "tableHeader"
+	ADFTypeTableCell   = "tableCell"
+	ADFTypeMediaSingle = "mediaSingle"
+	ADFTypeMediaGroup  = "mediaGroup"
+	ADFTypeMedia       = "media"
+)
+
+// ADF mark types
+const (
+	ADFMarkStrong    = "strong"
+	ADFMarkEm        = "em"
+	ADFMarkCode      = "code"
+	ADFMarkStrike    = "strike"
+	ADFMarkUnderline = "underline"
+	ADFMarkLink      = "link"
+)
+
+// ADFNode is a node of an Atlassian Document Format (ADF) document,
+// the rich text format of descriptions, comments and text custom fields in the v3 REST API of Jira Cloud.
+//
+// The type of a node defines which of its attributes are used.
+// Documents are best created via NewADFBuilder or the ADF* node functions instead of literal ADFNode values:
+//
+//	doc := cloud.NewADFBuilder().
+//		Heading(2, "Release notes").
+//		Paragraph(cloud.ADFText("Deployed by "), cloud.ADFMention("5b10a2844c20165700ede21g", "@Fred")).
+//		CodeBlock("shell", "make deploy").
+//		Build()
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/apis/document/structure/
+type ADFNode struct {
+	Type string `json:"type"`
+	// Version is only set for the root node of type doc.
+	Version int                    `json:"version,omitempty"`
+	Attrs   map[string]interface{} `json:"attrs,omitempty"`
+	Content []*ADFNode             `json:"content,omitempty"`
+	// Text and Marks are only set for nodes of type text.
+	Text  string    `json:"text,omitempty"`
+	Marks []ADFMark `json:"marks,omitempty"`
+}
+
+// ADFMark is a formatting applied to a text node, like strong or a link.
+type ADFMark struct {
+	Type  string                 `json:"type"`
+	Attrs map[string]interface{} `json:"attrs,omitempty"`
+}
+
+// ADFDoc returns the root node of a document with content.
+func ADFDoc(content ...*ADFNode) *ADFNode {
+	return &ADFNode{Type: ADFTypeDoc, Version: 1, Content: content}
+}
+
+// ADFParagraph returns a paragraph of inline nodes, like text and mentions.
+func ADFParagraph(content ...*ADFNode) *ADFNode {
+	return &ADFNode{Type: ADFTypeParagraph, Content: content}
+}
+
+// ADFText returns a text node formatted with marks.
+func ADFText(text string, marks ...ADFMark) *ADFNode {
+	return &ADFNode{Type: ADFTypeText, Text: text, Marks: marks}
+}
+
+// ADFHardBreak returns a line break within a paragraph.
+func ADFHardBreak() *ADFNode {
+	return &ADFNode{Type: ADFTypeHardBreak}
+}
+
+// ADFHeading returns a heading of level 1 to 6.
+func ADFHeading(level int, content ...*ADFNode) *ADFNode {
+	return &ADFNode{Type: ADFTypeHeading, Attrs: map[string]interface{}{"level": level}, Content: content}
+}
+
+// ADFMention returns a mention of the user accountID, shown as text, like "@Fred".
+func ADFMention(accountID, text string) *ADFNode {
+	attrs := map[string]interface{}{"id": accountID}
+	if text != "" {
+		attrs["text"] = text
+	}
+	return &ADFNode{Type: ADFTypeMention, Attrs: attrs}
+}
+
+// ADFCodeBlock returns a block of code.
+// language is used for syntax highlighting and may be empty.
+func ADFCodeBlock(language, code string) *ADFNode {
+	n := &ADFNode{Type: ADFTypeCodeBlock}
+	if language != "" {
+		n.Attrs = map[string]interface{}{"language": language}
+	}
+	if code != "" {
+		n.Content = []*ADFNode{ADFText(code)}
+	}
+	return n
+}
+
+// ADFBlockquote returns a quote of block nodes, like paragraphs.
+func ADFBlockquote(content ...*ADFNode) *ADFNode {
+	return &ADFNode{Type: ADFTypeBlockquote, Content: content}
+}
+
+// ADFRule returns a horizontal rule.
+func ADFRule() *ADFNode {
+	return &ADFNode{Type: ADFTypeRule}
+}
+
+// ADFBulletList returns an unordered list of items created via ADFListItem.
+func ADFBulletList(items ...*ADFNode) *ADFNode {
+	return &ADFNode{Type: ADFTypeBulletList, Content: items}
+}
+
+// ADFOrderedList returns a numbered list of items created via ADFListItem.
+func ADFOrderedList(items ...*ADFNode) *ADFNode {
+	return &ADFNode{Type: ADFTypeOrderedList, Content: items}
+}
+
+// ADFListItem returns a list item of block nodes, like paragraphs and nested lists.
+func ADFListItem(content ...*ADFNode) *ADFNode {
+	return &ADFNode{Type: ADFTypeListItem, Content: content}
+}
+
+// ADFTable returns a table of rows created via ADFTableRow.
+func ADFTable(rows ...*ADFNode) *ADFNode {
+	return &ADFNode{Type: ADFTypeTable, Attrs: map[string]interface{}{"isNumberColumnEnabled": false, "layout": "default"}, Content: rows}
+}
+
+// ADFTableRow returns a table row of cells created via ADFTableHeader or ADFTableCell.
+func ADFTableRow(cells ...*ADFNode) *ADFNode {
+	return &ADFNode{Type: ADFTypeTableRow, Content: cells}
+}
+
+// ADFTableHeader returns a header cell of block nodes, like paragraphs.
+func ADFTableHeader(content ...*ADFNode) *ADFNode {
+	return &ADFNode{Type: ADFTypeTableHeader, Content: content}
+}
+
+// ADFTableCell returns a table cell of block nodes, like paragraphs.
+func ADFTableCell(content ...*ADFNode) *ADFNode {
+	return &ADFNode{Type: ADFTypeTableCell, Content: content}
+}
+
+// ADFMediaSingle returns a single media item, like an image, referencing the Media Services file id in collection.
+// The ID and collection of an attachment are returned as part of the rendered ADF of an issue.
+func ADFMediaSingle(id, collection string) *ADFNode {
+	media := &ADFNode{Type: ADFTypeMedia, Attrs: map[string]interface{}{"type": "file", "id": id, "collection": collection}}
+	return &ADFNode{Type: ADFTypeMediaSingle, Attrs: map[string]interface{}{"layout": "center"}, Content: []*ADFNode{media}}
+}
+
+// ADFStrong returns a mark formatting text bold.
+func ADFStrong() ADFMark {
+	return ADFMark{Type: ADFMarkStrong}
+}
+
+// ADFEm returns a mark formatting text italic.
+func ADFEm() ADFMark {
+	return ADFMark{Type: ADFMarkEm}
+}
+
+// ADFCode returns a mark formatting text as inline code.
+func ADFCode() ADFMark {
+	return ADFMark{Type: ADFMarkCode}
+}
+
+// ADFStrike returns a mark formatting text struck through.
+func ADFStrike() ADFMark {
+	return ADFMark{Type: ADFMarkStrike}
+}
+
+// ADFUnderline returns a mark formatting text underlined.
+func ADFUnderline() ADFMark {
+	return ADFMark{Type: ADFMarkUnderline}
+}
+
+// ADFLink returns a mark linking text to href.
+func ADFLink(href string) ADFMark {
+	return ADFMark{Type: ADFMarkLink, Attrs: map[string]interface{}{"href": href}}
+}
+
+// ADFBuilder builds an ADF document block by block.
+type ADFBuilder struct {
+	doc *ADFNode
+}
+
+// NewADFBuilder returns a builder of an empty document.
+func NewADFBuilder() *ADFBuilder {
+	return &ADFBuilder{doc: ADFDoc()}
+}
+
+// Node appends any block node to the document.
+func (b *ADFBuilder) Node(n *ADFNode) *ADFBuilder {
+	b.doc.Content = append(b.doc.Content, n)
+	return b
+}
+
+// Heading appends a heading of level 1 to 6 with text.
+func (b *ADFBuilder) Heading(level int, text string) *ADFBuilder {
+	return b.Node(ADFHeading(level, ADFText(text)))
+}
+
+// Paragraph appends a paragraph of inline nodes, like text and mentions.
+func (b *ADFBuilder) Paragraph(content ...*ADFNode) *ADFBuilder {
+	return b.Node(ADFParagraph(content...))
+}
+
+// Text appends the paragraphs of plain text, like ADFFromText.
+func (b *ADFBuilder) Text(text string) *ADFBuilder {
+	for _, p := range ADFFromText(text).Content {
+		b.Node(p)
+	}
+	return b
+}
+
+// CodeBlock appends a block of code in language, which may be empty.
+func (b *ADFBuilder) CodeBlock(language, code string) *ADFBuilder {
+	return b.Node(ADFCodeBlock(language, code))
+}
+
+// BulletList appends an unordered list with one item per text.
+func (b *ADFBuilder) BulletList(items ...string) *ADFBuilder {
+	return b.Node(ADFBulletList(textItems(items)...))
+}
+
+// OrderedList appends a numbered list with one item per text.
+func (b *ADFBuilder) OrderedList(items ...string) *ADFBuilder {
+	return b.Node(ADFOrderedList(textItems(items)...))
+}
+
+// Table appends a table with a header row of header and one row per rows.
+// header may be empty for a table without header row.
+func (b *ADFBuilder) Table(header []string, rows ...[]string) *ADFBuilder {
+	var tableRows []*ADFNode
+	if len(header) > 0 {
+		cells := make([]*ADFNode, 0, len(header))
+		for _, text := range header {
+			cells = append(cells, ADFTableHeader(ADFParagraph(ADFText(text))))
+		}
+		tableRows = append(tableRows, ADFTableRow(cells...))
+	}
+	for _, row := range rows {
+		cells := make([]*ADFNode, 0, len(row))
+		for _, text := range row {
+			cells = append(cells, ADFTableCell(ADFParagraph(ADFText(text))))
+		}
+		tableRows = append(tableRows, ADFTableRow(cells...))
+	}
+	return b.Node(ADFTable(tableRows...))
+}
+
+// Rule appends a horizontal rule.
+func (b *ADFBuilder) Rule() *ADFBuilder {
+	return b.Node(ADFRule())
+}
+
+// Build returns the document.
+func (b *ADFBuilder) Build() *ADFNode {
+	return b.doc
+}
+
+func textItems(items []string) []*ADFNode {
+	nodes := make([]*ADFNode, 0, len(items))
+	for _, text := range items {
+		nodes = append(nodes, ADFListItem(ADFParagraph(ADFText(text))))
+	}
+	return nodes
+}
+
+var adfParagraphSeparator = regexp.MustCompile(`\n[ \t]*\n\s*`)
+
+// ADFFromText converts plain text into a document.
+// Text separated by blank lines becomes paragraphs, single line breaks become hard breaks.
+func ADFFromText(text string) *ADFNode {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+	if text == "" {
+		return ADFDoc(ADFParagraph())
+	}
+
+	doc := ADFDoc()
+	for _, paragraph := range adfParagraphSeparator.Split(text, -1) {
+		p := ADFParagraph()
+		for i, line := range strings.Split(paragraph, "\n") {
+			if i > 0 {
+				p.Content = append(p.Content, ADFHardBreak())
+			}
+			if line != "" {
+				p.Content = append(p.Content, ADFText(line))
+			}
+		}
+		doc.Content = append(doc.Content, p)
+	}
+	return doc
+}
+
+// PlainText converts the node into plain text, dropping all formatting and media.
+// Blocks are separated by blank lines, list items are prefixed with "- " or their number
+// and table cells are separated by " | ".
+func (n *ADFNode) PlainText() string {
+	return strings.TrimSpace(adfPlainText(n))
+}
+
+func adfPlainText(n *ADFNode) string {
+	if n == nil {
+		return ""
+	}
+
+	switch n.Type {
+	case ADFTypeText:
+		return n.Text
+	case ADFTypeHardBreak:
+		return "\n"
+	case ADFTypeMention, ADFTypeEmoji:
+		return adfInlineLabel(n)
+	case ADFTypeInlineCard:
+		return n.attr("url")
+	case ADFTypeRule:
+		return "---"
+	case ADFTypeMedia, ADFTypeMediaSingle, ADFTypeMediaGroup:
+		return ""
+	case ADFTypeBulletList, ADFTypeOrderedList:
+		lines := make([]string, 0, len(n.Content))
+		for i, item := range n.Content {
+			prefix := "- "
+			if n.Type == ADFTypeOrderedList {
+				prefix = strconv.Itoa(n.intAttr("order", 1)+i) + ". "
+			}
+			text := strings.ReplaceAll(adfBlocks(item.Content, "\n", adfPlainText), "\n", "\n  ")
+			lines = append(lines, prefix+text)
+		}
+		return strings.Join(lines, "\n")
+	case ADFTypeTable:
+		return adfBlocks(n.Content, "\n", adfPlainText)
+	case ADFTypeTableRow:
+		cells := make([]string, 0, len(n.Content))
+		for _, cell := range n.Content {
+			cells = append(cells, adfBlocks(cell.Content, " ", adfPlainText))
+		}
+		return strings.Join(cells, " | ")
+	case ADFTypeParagraph, ADFTypeHeading, ADFTypeCodeBlock:
+		return adfInline(n.Content, adfPlainText)
+	default:
+		return adfBlocks(n.Content, "\n\n", adfPlainText)
+	}
+}
+
+// WikiMarkup converts the node into the wiki markup of Jira Server and the v2 REST API of Jira Cloud.
+// Media is dropped, as wiki markup references attachments by file name.
+func (n *ADFNode) WikiMarkup() string {
+	return strings.TrimSpace(adfWikiMarkup(n))
+}
+
+func adfWikiMarkup(n *ADFNode) string {
+	if n == nil {
+		return ""
+	}
+
+	switch n.Type {
+	case ADFTypeText:
+		return adfWikiText(n)
+	case ADFTypeHardBreak:
+		return "\n"
+	case ADFTypeMention:
+		if id := n.attr("id"); id != "" {
+			return "[~accountid:" + id + "]"
+		}
+		return adfInlineLabel(n)
+	case ADFTypeEmoji:
+		return adfInlineLabel(n)
+	case ADFTypeInlineCard:
+		return "[" + n.attr("url") + "]"
+	case ADFTypeRule:
+		return "----"
+	case ADFTypeMedia, ADFTypeMediaSingle, ADFTypeMediaGroup:
+		return ""
+	case ADFTypeHeading:
+		return fmt.Sprintf("h%d. %s", n.intAttr("level", 1), adfInline(n.Content, adfWikiMarkup))
+	case ADFTypeParagraph:
+		return adfInline(n.Content, adfWikiMarkup)
+	case ADFTypeCodeBlock:
+		open := "{code}"
+		if language := n.attr("language"); language != "" {
+			open = "{code:" + language + "}"
+		}
+		return open + "\n" + adfInline(n.Content, adfPlainText) + "\n{code}"
+	case ADFTypeBlockquote:
+		return "{quote}\n" + adfBlocks(n.Content, "\n\n", adfWikiMarkup) + "\n{quote}"
+	case ADFTypePanel:
+		return "{panel}\n" + adfBlocks(n.Content, "\n\n", adfWikiMarkup) + "\n{panel}"
+	case ADFTypeBulletList, ADFTypeOrderedList:
+		return adfWikiList(n, "")
+	case ADFTypeTable:
+		return adfBlocks(n.Content, "\n", adfWikiMarkup)
+	case ADFTypeTableRow:
+		var b strings.Builder
+		separator := "|"
+		for _, cell := range n.Content {
+			separator = "|"
+			if cell.Type == ADFTypeTableHeader {
+				separator = "||"
+			}
+			b.WriteString(separator)
+			b.WriteString(adfBlocks(cell.Content, " ", adfWikiMarkup))
+		}
+		b.WriteString(separator)
+		return b.String()
+	default:
+		return adfBlocks(n.Content, "\n\n", adfWikiMarkup)
+	}
+}
+
+// adfWikiList renders a list, nested lists extend the prefix of their parent, like "*#".
+func adfWikiList(n *ADFNode, prefix string) string {
+	marker := "*"
+	if n.Type == ADFTypeOrderedList {
+		marker = "#"
+	}
+	prefix += marker
+
+	var lines []string
+	for _, item := range n.Content {
+		var text []string
+		var nested []string
+		for _, c := range item.Content {
+			if c.Type == ADFTypeBulletList || c.Type == ADFTypeOrderedList {
+				nested = append(nested, adfWikiList(c, prefix))
+				continue
+			}
+			text = append(text, adfWikiMarkup(c))
+		}
+		lines = append(lines, prefix+" "+strings.Join(text, " "))
+		lines = append(lines, nested...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// adfWikiText renders a text node with its marks.
+func adfWikiText(n *ADFNode) string {
+	text := n.Text
+	var link string
+	for _, mark := range n.Marks {
+		switch mark.Type {
+		case ADFMarkStrong:
+			text = "*" + text + "*"
+		case ADFMarkEm:
+			text = "_" + text + "_"
+		case ADFMarkCode:
+			text = "{{" + text + "}}"
+		case ADFMarkStrike:
+			text = "-" + text + "-"
+		case ADFMarkUnderline:
+			text = "+" + text + "+"
+		case ADFMarkLink:
+			link, _ = mark.Attrs["href"].(string)
+		}
+	}
+	if link != "" {
+		return "[" + text + "|" + link + "]"
+	}
+	return text
+}
+
+// adfInlineLabel returns the text shown for a mention or an emoji.
+func adfInlineLabel(n *ADFNode) string {
+	if text := n.attr("text"); text != "" {
+		return text
+	}
+	if name := n.attr("shortName"); name != "" {
+		return name
+	}
+	if id := n.attr("id"); id != "" {
+		return "@" + id
+	}
+	return ""
+}
+
+// adfInline concatenates the rendered inline nodes.
+func adfInline(nodes []*ADFNode, render func(*ADFNode) string) string {
+	var b strings.Builder
+	for _, c := range nodes {
+		b.WriteString(render(c))
+	}
+	return b.String()
+}
+
+// adfBlocks joins the rendered block nodes with separator, skipping empty blocks.
+func adfBlocks(nodes []*ADFNode, separator string, render func(*ADFNode) string) string {
+	blocks := make([]string, 0, len(nodes))
+	for _, c := range nodes {
+		if text := render(c); text != "" {
+			blocks = append(blocks, text)
+		}
+	}
+	return strings.Join(blocks, separator)
+}
+
+// attr returns the string attribute key of the node.
+func (n *ADFNode) attr(key string) string {
+	s, _ := n.Attrs[key].(string)
+	return s
+}
+
+// intAttr returns the number attribute key of the node, or def if it is not set.
+// Decoded documents contain float64 numbers, built ones int numbers.
+func (n *ADFNode) intAttr(key string, def int) int {
+	switch v := n.Attrs[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	}
+	return def
+}
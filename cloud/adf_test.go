@@ -0,0 +1,107 @@
+package cloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestADFBuilder_MarshalJSON(t *testing.T) {
+	doc := NewADFBuilder().
+		Heading(2, "Release").
+		Paragraph(ADFText("Deployed by "), ADFMention("5b10a2844c20165700ede21g", "@Fred"), ADFText(" now", ADFStrong())).
+		CodeBlock("shell", "make deploy").
+		Build()
+
+	got, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := `{"type":"doc","version":1,"content":[` +
+		`{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"Release"}]},` +
+		`{"type":"paragraph","content":[{"type":"text","text":"Deployed by "},{"type":"mention","attrs":{"id":"5b10a2844c20165700ede21g","text":"@Fred"}},{"type":"text","text":" now","marks":[{"type":"strong"}]}]},` +
+		`{"type":"codeBlock","attrs":{"language":"shell"},"content":[{"type":"text","text":"make deploy"}]}]}`
+	if string(got) != want {
+		t.Errorf("Unexpected ADF\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+func TestADFFromText(t *testing.T) {
+	doc := ADFFromText("First line\r\nsecond line\n\n\nNext paragraph\n")
+
+	got, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := `{"type":"doc","version":1,"content":[` +
+		`{"type":"paragraph","content":[{"type":"text","text":"First line"},{"type":"hardBreak"},{"type":"text","text":"second line"}]},` +
+		`{"type":"paragraph","content":[{"type":"text","text":"Next paragraph"}]}]}`
+	if string(got) != want {
+		t.Errorf("Unexpected ADF\ngot:  %s\nwant: %s", got, want)
+	}
+
+	if got := ADFFromText("  ").Content; len(got) != 1 || got[0].Type != ADFTypeParagraph {
+		t.Errorf("Expected an empty paragraph for empty text, got %+v", got)
+	}
+}
+
+// adfTestDocument is a document as returned by the v3 REST API.
+const adfTestDocument = `{"type":"doc","version":1,"content":[
+	{"type":"heading","attrs":{"level":3},"content":[{"type":"text","text":"Steps"}]},
+	{"type":"orderedList","attrs":{"order":1},"content":[
+		{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"Open "},{"type":"text","text":"settings","marks":[{"type":"code"}]}]}]},
+		{"type":"listItem","content":[
+			{"type":"paragraph","content":[{"type":"text","text":"Ask "},{"type":"mention","attrs":{"id":"5b10a2844c20165700ede21g","text":"@Fred"}}]},
+			{"type":"bulletList","content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"nicely"}]}]}]}
+		]}
+	]},
+	{"type":"table","content":[
+		{"type":"tableRow","content":[{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Key"}]}]},{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Value"}]}]}]},
+		{"type":"tableRow","content":[{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"docs","marks":[{"type":"link","attrs":{"href":"https://example.com"}}]}]}]},{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"yes","marks":[{"type":"strong"}]}]}]}]}
+	]},
+	{"type":"mediaSingle","content":[{"type":"media","attrs":{"type":"file","id":"6e7c7f2c","collection":"jira-10000"}}]},
+	{"type":"codeBlock","attrs":{"language":"go"},"content":[{"type":"text","text":"fmt.Println(1)"}]}
+]}`
+
+func TestADFNode_PlainText(t *testing.T) {
+	doc := new(ADFNode)
+	if err := json.Unmarshal([]byte(adfTestDocument), doc); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "Steps\n\n" +
+		"1. Open settings\n" +
+		"2. Ask @Fred\n" +
+		"  - nicely\n\n" +
+		"Key | Value\n" +
+		"docs | yes\n\n" +
+		"fmt.Println(1)"
+	if got := doc.PlainText(); got != want {
+		t.Errorf("Unexpected plain text\ngot:  %q\nwant: %q", got, want)
+	}
+}
+
+func TestADFNode_WikiMarkup(t *testing.T) {
+	doc := new(ADFNode)
+	if err := json.Unmarshal([]byte(adfTestDocument), doc); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "h3. Steps\n\n" +
+		"# Open {{settings}}\n" +
+		"# Ask [~accountid:5b10a2844c20165700ede21g]\n" +
+		"#* nicely\n\n" +
+		"||Key||Value||\n" +
+		"|[docs|https://example.com]|*yes*|\n\n" +
+		"{code:go}\nfmt.Println(1)\n{code}"
+	if got := doc.WikiMarkup(); got != want {
+		t.Errorf("Unexpected wiki markup\ngot:  %q\nwant: %q", got, want)
+	}
+}
+
+func TestADFBuilder_Table(t *testing.T) {
+	doc := NewADFBuilder().Table([]string{"A", "B"}, []string{"1", "2"}).BulletList("x", "y").Build()
+
+	if got, want := doc.WikiMarkup(), "||A||B||\n|1|2|\n\n* x\n* y"; got != want {
+		t.Errorf("Unexpected wiki markup\ngot:  %q\nwant: %q", got, want)
+	}
+}
@@ -0,0 +1,88 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// AppPropertyService handles the properties of Connect apps for the Jira instance / API.
+// App properties store app-level configuration, like settings shared by all users of the app.
+// They can only be accessed by the app itself.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-app-properties/
+type AppPropertyService service
+
+// GetKeys returns the keys of all properties of an app.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-app-properties/#api-rest-atlassian-connect-1-addons-addonkey-properties-get
+func (s *AppPropertyService) GetKeys(ctx context.Context, addonKey string) (*PropertyKeys, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/atlassian-connect/1/addons/%s/properties", url.PathEscape(addonKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(PropertyKeys)
+	resp, err := s.client.Do(req, keys)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return keys, resp, nil
+}
+
+// Get returns a property of an app.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-app-properties/#api-rest-atlassian-connect-1-addons-addonkey-properties-propertykey-get
+func (s *AppPropertyService) Get(ctx context.Context, addonKey, propertyKey string) (*EntityProperty, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, appPropertyEndpoint(addonKey, propertyKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return property, resp, nil
+}
+
+// Set creates or updates a property of an app.
+// value is encoded as JSON and may not be larger than 32 KB.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-app-properties/#api-rest-atlassian-connect-1-addons-addonkey-properties-propertykey-put
+func (s *AppPropertyService) Set(ctx context.Context, addonKey, propertyKey string, value interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPut, appPropertyEndpoint(addonKey, propertyKey), value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete deletes a property of an app.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-app-properties/#api-rest-atlassian-connect-1-addons-addonkey-properties-propertykey-delete
+func (s *AppPropertyService) Delete(ctx context.Context, addonKey, propertyKey string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, appPropertyEndpoint(addonKey, propertyKey), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// appPropertyEndpoint returns the endpoint of a single app property.
+func appPropertyEndpoint(addonKey, propertyKey string) string {
+	return fmt.Sprintf("rest/atlassian-connect/1/addons/%s/properties/%s", url.PathEscape(addonKey), url.PathEscape(propertyKey))
+}
@@ -0,0 +1,90 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAppPropertyService_GetKeys(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/atlassian-connect/1/addons/my-app/properties"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"keys":[{"self":"https://your-domain.atlassian.net/jira/rest/atlassian-connect/1/addons/my-app/properties/settings","key":"settings"}]}`)
+	})
+
+	keys, _, err := testClient.AppProperty.GetKeys(context.Background(), "my-app")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(keys.Keys) != 1 || keys.Keys[0].Key != "settings" {
+		t.Errorf("Expected property key settings. Got %+v", keys.Keys)
+	}
+}
+
+func TestAppPropertyService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/atlassian-connect/1/addons/my-app/properties/settings"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"key":"settings","value":{"enabled":true}}`)
+	})
+
+	property, _, err := testClient.AppProperty.Get(context.Background(), "my-app", "settings")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	value, ok := property.Value.(map[string]interface{})
+	if !ok || value["enabled"] != true {
+		t.Errorf("Expected property value with enabled=true. Got %+v", property.Value)
+	}
+}
+
+func TestAppPropertyService_Set(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/atlassian-connect/1/addons/my-app/properties/settings"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint)
+
+		var value map[string]bool
+		if err := json.NewDecoder(r.Body).Decode(&value); err != nil {
+			t.Fatal(err)
+		}
+		if !value["enabled"] {
+			t.Errorf("Expected value enabled=true. Got %+v", value)
+		}
+		fmt.Fprint(w, `{"message":"Property updated.","statusCode":200}`)
+	})
+
+	if _, err := testClient.AppProperty.Set(context.Background(), "my-app", "settings", map[string]bool{"enabled": true}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestAppPropertyService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/atlassian-connect/1/addons/my-app/properties/settings"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testapiEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.AppProperty.Delete(context.Background(), "my-app", "settings"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
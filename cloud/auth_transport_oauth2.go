@@ -0,0 +1,201 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultOAuth2TokenURL is the token endpoint of Atlassian's OAuth 2.0 authorization server.
+const defaultOAuth2TokenURL = "https://auth.atlassian.com/oauth/token"
+
+// oauth2ExpiryDelta refreshes access tokens a bit before they expire,
+// so that they don't expire while a request is in flight.
+const oauth2ExpiryDelta = 30 * time.Second
+
+// OAuth2Token is an OAuth 2.0 access token together with the refresh token to renew it.
+type OAuth2Token struct {
+	AccessToken  string
+	RefreshToken string
+	// Expiry is the time the access token expires.
+	// A zero value means the access token is refreshed before the first request.
+	Expiry time.Time
+}
+
+// valid reports whether the access token can still be used.
+func (t *OAuth2Token) valid() bool {
+	return t != nil && t.AccessToken != "" && !t.Expiry.IsZero() && time.Now().Add(oauth2ExpiryDelta).Before(t.Expiry)
+}
+
+// OAuth2Transport is an http.RoundTripper that authenticates all requests
+// with an OAuth 2.0 (3LO) access token of an Atlassian app.
+// The access token is refreshed automatically when it expires.
+// The transport is safe for concurrent use: Concurrent requests wait for a single refresh.
+//
+// Requests authenticated via OAuth 2.0 have to be sent to https://api.atlassian.com/ex/jira/{cloudid}/,
+// so use this as base URL of the client.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/
+type OAuth2Transport struct {
+	ClientID     string
+	ClientSecret string
+
+	// Token is the current token.
+	// It has to contain at least a refresh token.
+	Token *OAuth2Token
+
+	// TokenURL is the token endpoint used for refreshing.
+	// It will default to https://auth.atlassian.com/oauth/token if empty.
+	TokenURL string
+
+	// OnTokenRefresh is called with every refreshed token.
+	// Atlassian rotates refresh tokens, so the new token has to be stored to be able to authenticate later on.
+	// If it returns an error, the request fails.
+	OnTokenRefresh func(token *OAuth2Token) error
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu sync.Mutex
+}
+
+// RoundTrip implements the RoundTripper interface.
+// It refreshes the access token if needed and adds it as bearer token to the request.
+// If Jira rejects the access token, it is refreshed once and the request is retried, given the body can be replayed.
+func (t *OAuth2Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.token(req.Context(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.send(req, token)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || (req.Body != nil && req.GetBody == nil) {
+		return resp, err
+	}
+
+	// The access token was revoked or expired earlier than announced
+	token, err = t.token(req.Context(), token)
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+
+	retry := req
+	if req.GetBody != nil {
+		retry = req.Clone(req.Context())
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	return t.send(retry, token)
+}
+
+// send adds the access token to a copy of req and sends it.
+func (t *OAuth2Transport) send(req *http.Request, token *OAuth2Token) (*http.Response, error) {
+	req2 := cloneRequest(req) // per RoundTripper contract
+	req2.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using OAuth 2.0.
+func (t *OAuth2Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *OAuth2Transport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// token returns a valid token and refreshes it if needed.
+// A token equal to rejected is refreshed even if it didn't expire yet.
+func (t *OAuth2Transport) token(ctx context.Context, rejected *OAuth2Token) (*OAuth2Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Token.valid() && (rejected == nil || t.Token.AccessToken != rejected.AccessToken) {
+		return t.Token, nil
+	}
+	if t.Token == nil || t.Token.RefreshToken == "" {
+		return nil, fmt.Errorf("oauth2: no refresh token available")
+	}
+
+	token, err := t.refresh(ctx, t.Token.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if t.OnTokenRefresh != nil {
+		if err := t.OnTokenRefresh(token); err != nil {
+			return nil, fmt.Errorf("oauth2: storing refreshed token: %w", err)
+		}
+	}
+	t.Token = token
+	return token, nil
+}
+
+// refresh requests a new access token from the token endpoint.
+func (t *OAuth2Transport) refresh(ctx context.Context, refreshToken string) (*OAuth2Token, error) {
+	body, err := json.Marshal(map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     t.ClientID,
+		"client_secret": t.ClientSecret,
+		"refresh_token": refreshToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tokenURL := t.TokenURL
+	if tokenURL == "" {
+		tokenURL = defaultOAuth2TokenURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.transport().RoundTrip(req)
+	if err != nil {
+		return nil, fmt.Errorf("oauth2: refreshing token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, maxBodyExcerpt))
+		return nil, fmt.Errorf("oauth2: refreshing token failed with status code %d: %s", resp.StatusCode, excerpt)
+	}
+
+	var result struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("oauth2: decoding token response: %w", err)
+	}
+	if result.AccessToken == "" {
+		return nil, fmt.Errorf("oauth2: token response contains no access token")
+	}
+
+	token := &OAuth2Token{
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		Expiry:       time.Now().Add(time.Duration(result.ExpiresIn) * time.Second),
+	}
+	if token.RefreshToken == "" {
+		// Refresh tokens are only rotated if rotation is enabled for the app
+		token.RefreshToken = refreshToken
+	}
+	return token, nil
+}
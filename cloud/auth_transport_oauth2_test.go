@@ -0,0 +1,115 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// handleOAuth2Token serves a token endpoint that issues the access tokens "access-1", "access-2", ...
+// It returns a pointer to the number of refreshes.
+func handleOAuth2Token(t *testing.T) *int32 {
+	var refreshes int32
+	testMux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload["grant_type"] != "refresh_token" || payload["client_id"] != "client-id" || payload["refresh_token"] == "" {
+			t.Errorf("Unexpected token request %+v", payload)
+		}
+
+		n := atomic.AddInt32(&refreshes, 1)
+		fmt.Fprintf(w, `{"access_token":"access-%d","refresh_token":"refresh-%d","expires_in":3600,"token_type":"Bearer"}`, n, n)
+	})
+	return &refreshes
+}
+
+func TestOAuth2Transport_RefreshesExpiredToken(t *testing.T) {
+	setup()
+	defer teardown()
+	refreshes := handleOAuth2Token(t)
+
+	testMux.HandleFunc("/rest/api/3/myself", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer access-1" {
+			t.Errorf("Expected bearer token access-1. Got %q", got)
+		}
+		fmt.Fprint(w, `{"accountId":"5b10ac8d82e05b22cc7d4ef5"}`)
+	})
+
+	var stored *OAuth2Token
+	tp := &OAuth2Transport{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		Token:        &OAuth2Token{AccessToken: "expired", RefreshToken: "refresh-0", Expiry: time.Now().Add(-time.Minute)},
+		TokenURL:     testServer.URL + "/oauth/token",
+		OnTokenRefresh: func(token *OAuth2Token) error {
+			stored = token
+			return nil
+		},
+	}
+	client, _ := NewClient(testServer.URL, tp.Client())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := client.User.GetCurrentUser(context.Background()); err != nil {
+				t.Errorf("Error given: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(refreshes); n != 1 {
+		t.Errorf("Expected concurrent requests to share one refresh. Got %d refreshes", n)
+	}
+	if stored == nil || stored.RefreshToken != "refresh-1" {
+		t.Errorf("Expected the rotated refresh token to be stored. Got %+v", stored)
+	}
+}
+
+func TestOAuth2Transport_RetriesRejectedToken(t *testing.T) {
+	setup()
+	defer teardown()
+	refreshes := handleOAuth2Token(t)
+
+	testMux.HandleFunc("/rest/api/3/myself", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"accountId":"5b10ac8d82e05b22cc7d4ef5"}`)
+	})
+
+	tp := &OAuth2Transport{
+		ClientID: "client-id",
+		Token:    &OAuth2Token{AccessToken: "revoked", RefreshToken: "refresh-0", Expiry: time.Now().Add(time.Hour)},
+		TokenURL: testServer.URL + "/oauth/token",
+	}
+	client, _ := NewClient(testServer.URL, tp.Client())
+
+	if _, _, err := client.User.GetCurrentUser(context.Background()); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if n := atomic.LoadInt32(refreshes); n != 1 {
+		t.Errorf("Expected 1 refresh. Got %d", n)
+	}
+}
+
+func TestOAuth2Transport_NoRefreshToken(t *testing.T) {
+	tp := &OAuth2Transport{Token: &OAuth2Token{AccessToken: "expired"}}
+	req, _ := http.NewRequest(http.MethodGet, "https://api.atlassian.com/ex/jira/cloud-id/rest/api/3/myself", nil)
+
+	if _, err := tp.RoundTrip(req); err == nil {
+		t.Error("Expected an error without refresh token")
+	}
+}
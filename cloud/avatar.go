@@ -0,0 +1,250 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// AvatarService handles avatars for the Jira instance / API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-avatars/
+type AvatarService service
+
+// AvatarType represents the kind of entity an avatar belongs to.
+type AvatarType string
+
+const (
+	AvatarTypeIssueType AvatarType = "issuetype"
+	AvatarTypeProject   AvatarType = "project"
+	AvatarTypePriority  AvatarType = "priority"
+	AvatarTypeUser      AvatarType = "user"
+)
+
+// IsValid reports whether t is an avatar type known to Jira.
+func (t AvatarType) IsValid() bool {
+	switch t {
+	case AvatarTypeIssueType, AvatarTypeProject, AvatarTypePriority, AvatarTypeUser:
+		return true
+	}
+	return false
+}
+
+// Avatar represents an avatar of a user, project, issue type or priority.
+type Avatar struct {
+	ID             string     `json:"id" structs:"id"`
+	Owner          string     `json:"owner,omitempty" structs:"owner,omitempty"`
+	FileName       string     `json:"fileName,omitempty" structs:"fileName,omitempty"`
+	IsSystemAvatar bool       `json:"isSystemAvatar" structs:"isSystemAvatar"`
+	IsSelected     bool       `json:"isSelected" structs:"isSelected"`
+	IsDeletable    bool       `json:"isDeletable" structs:"isDeletable"`
+	URLs           AvatarUrls `json:"urls,omitempty" structs:"urls,omitempty"`
+}
+
+// Avatars is a list of system and custom avatars.
+type Avatars struct {
+	System []Avatar `json:"system,omitempty" structs:"system,omitempty"`
+	Custom []Avatar `json:"custom,omitempty" structs:"custom,omitempty"`
+}
+
+// AvatarImageOptions specifies the optional parameters for AvatarService.DownloadImage.
+type AvatarImageOptions struct {
+	// Size of the image: "xsmall", "small", "medium", "large" or "xlarge".
+	Size string `url:"size,omitempty"`
+	// Format of the image: "png" or "svg".
+	Format string `url:"format,omitempty"`
+}
+
+// AvatarUploadOptions specifies the optional parameters for AvatarService.Upload and IssueTypeService.LoadAvatar.
+// The image is cropped to the square at X and Y with the length Size.
+// By default, the largest square in the top left corner is used.
+type AvatarUploadOptions struct {
+	X    int `url:"x,omitempty"`
+	Y    int `url:"y,omitempty"`
+	Size int `url:"size,omitempty"`
+}
+
+// GetSystemAvatars returns the system avatars of an avatar type.
+// Only "issuetype", "project" and "user" have system avatars.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-avatars/#api-rest-api-3-avatar-type-system-get
+func (s *AvatarService) GetSystemAvatars(ctx context.Context, avatarType AvatarType) ([]Avatar, *Response, error) {
+	if !avatarType.IsValid() {
+		return nil, nil, fmt.Errorf("jira: invalid avatar type %q", avatarType)
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/avatar/%s/system", avatarType)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatars := new(Avatars)
+	resp, err := s.client.Do(req, avatars)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return avatars.System, resp, nil
+}
+
+// GetOwnerAvatars returns the system and custom avatars available for an entity.
+// entityID is the ID of the project, issue type or priority.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-avatars/#api-rest-api-3-universal-avatar-type-type-owner-entityid-get
+func (s *AvatarService) GetOwnerAvatars(ctx context.Context, avatarType AvatarType, entityID string) (*Avatars, *Response, error) {
+	if !avatarType.IsValid() {
+		return nil, nil, fmt.Errorf("jira: invalid avatar type %q", avatarType)
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/universal_avatar/type/%s/owner/%s", avatarType, entityID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatars := new(Avatars)
+	resp, err := s.client.Do(req, avatars)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return avatars, resp, nil
+}
+
+// DownloadImage returns a Response of an avatar image for a given avatarID.
+// The image is in the Response.Body of the response.
+// This is an io.ReadCloser.
+// The content type of the image is in the "Content-Type" header of the response.
+// Caller must close resp.Body.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-avatars/#api-rest-api-3-universal-avatar-view-type-type-avatar-id-get
+func (s *AvatarService) DownloadImage(ctx context.Context, avatarType AvatarType, avatarID string, options *AvatarImageOptions) (*Response, error) {
+	if !avatarType.IsValid() {
+		return nil, fmt.Errorf("jira: invalid avatar type %q", avatarType)
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/universal_avatar/view/type/%s/avatar/%s", avatarType, avatarID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Upload uploads image as custom avatar of an entity and returns the created avatar.
+// entityID is the ID of the project, issue type or priority.
+// contentType is the media type of image, like "image/png".
+// The avatar is not selected automatically, see SetProjectAvatar and SetIssueTypeAvatar.
+//
+// The avatars of users are managed by their Atlassian account and can't be uploaded via the Jira API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-avatars/#api-rest-api-3-universal-avatar-type-type-owner-entityid-post
+func (s *AvatarService) Upload(ctx context.Context, avatarType AvatarType, entityID, contentType string, image io.Reader, options *AvatarUploadOptions) (*Avatar, *Response, error) {
+	if !avatarType.IsValid() || avatarType == AvatarTypeUser {
+		return nil, nil, fmt.Errorf("jira: invalid avatar type %q", avatarType)
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/universal_avatar/type/%s/owner/%s", avatarType, url.PathEscape(entityID))
+	u, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRawRequest(ctx, http.MethodPost, u, image)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Atlassian-Token", "nocheck")
+
+	avatar := new(Avatar)
+	resp, err := s.client.Do(req, avatar)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return avatar, resp, nil
+}
+
+// Delete deletes the custom avatar avatarID of an entity.
+// entityID is the ID of the project, issue type or priority. System avatars can't be deleted.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-avatars/#api-rest-api-3-universal-avatar-type-type-owner-owningobjectid-avatar-id-delete
+func (s *AvatarService) Delete(ctx context.Context, avatarType AvatarType, entityID, avatarID string) (*Response, error) {
+	if !avatarType.IsValid() || avatarType == AvatarTypeUser {
+		return nil, fmt.Errorf("jira: invalid avatar type %q", avatarType)
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/universal_avatar/type/%s/owner/%s/avatar/%s", avatarType, url.PathEscape(entityID), url.PathEscape(avatarID))
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// SetProjectAvatar selects the system or custom avatar avatarID as avatar of the project projectIDOrKey.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-avatars/#api-rest-api-3-project-projectidorkey-avatar-put
+func (s *AvatarService) SetProjectAvatar(ctx context.Context, projectIDOrKey, avatarID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/project/%s/avatar", url.PathEscape(projectIDOrKey))
+	body := struct {
+		ID string `json:"id"`
+	}{avatarID}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// SetIssueTypeAvatar selects the system or custom avatar avatarID as avatar of the issue type issueTypeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-types/#api-rest-api-3-issuetype-id-put
+func (s *AvatarService) SetIssueTypeAvatar(ctx context.Context, issueTypeID, avatarID string) (*Response, error) {
+	id, err := strconv.ParseInt(avatarID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("jira: invalid avatar ID %q: %w", avatarID, err)
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/issuetype/%s", url.PathEscape(issueTypeID))
+	body := struct {
+		AvatarID int64 `json:"avatarId"`
+	}{id}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
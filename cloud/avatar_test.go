@@ -0,0 +1,187 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAvatarService_GetSystemAvatars(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/avatar/project/system"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"system":[{"id":"1000","isSystemAvatar":true,"isSelected":false,"isDeletable":false,"urls":{"16x16":"https://your-domain.atlassian.net/secure/useravatar?size=xsmall&avatarId=10040&avatarType=project"}}]}`)
+	})
+
+	avatars, _, err := testClient.Avatar.GetSystemAvatars(context.Background(), AvatarTypeProject)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(avatars) != 1 || avatars[0].ID != "1000" {
+		t.Fatalf("Expected avatar 1000. Got %+v", avatars)
+	}
+	if avatars[0].URLs.One6X16 == "" {
+		t.Error("Expected the 16x16 avatar URL to be set")
+	}
+}
+
+func TestAvatarService_GetSystemAvatars_InvalidType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if _, _, err := testClient.Avatar.GetSystemAvatars(context.Background(), "board"); err == nil {
+		t.Error("Expected an error for an invalid avatar type")
+	}
+}
+
+func TestAvatarService_GetOwnerAvatars(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/universal_avatar/type/issuetype/owner/10001"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"system":[{"id":"1000","isSystemAvatar":true}],"custom":[{"id":"1010","owner":"10001","isSystemAvatar":false,"isDeletable":true}]}`)
+	})
+
+	avatars, _, err := testClient.Avatar.GetOwnerAvatars(context.Background(), AvatarTypeIssueType, "10001")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(avatars.System) != 1 || len(avatars.Custom) != 1 {
+		t.Fatalf("Expected one system and one custom avatar. Got %+v", avatars)
+	}
+	if avatars.Custom[0].Owner != "10001" {
+		t.Errorf("Expected custom avatar owned by 10001. Got %q", avatars.Custom[0].Owner)
+	}
+}
+
+func TestAvatarService_DownloadImage(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/universal_avatar/view/type/project/avatar/10040"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?format=png&size=small")
+		w.Header().Set("Content-Type", "image/png")
+		fmt.Fprint(w, "PNG")
+	})
+
+	resp, err := testClient.Avatar.DownloadImage(context.Background(), AvatarTypeProject, "10040", &AvatarImageOptions{Size: "small", Format: "png"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if got := resp.Header.Get("Content-Type"); got != "image/png" {
+		t.Errorf("Expected content type image/png. Got %q", got)
+	}
+	if b, _ := io.ReadAll(resp.Body); string(b) != "PNG" {
+		t.Errorf("Expected image content PNG. Got %q", b)
+	}
+}
+
+func TestAvatarService_Upload(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/universal_avatar/type/project/owner/EX"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testapiEndpoint+"?size=48&x=16&y=8")
+		if got := r.Header.Get("Content-Type"); got != "image/png" {
+			t.Errorf("Expected content type image/png. Got %q", got)
+		}
+		if got := r.Header.Get("X-Atlassian-Token"); got != "nocheck" {
+			t.Errorf("Expected X-Atlassian-Token nocheck. Got %q", got)
+		}
+		if b, _ := io.ReadAll(r.Body); string(b) != "PNG" {
+			t.Errorf("Expected image content PNG. Got %q", b)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"1010","isSystemAvatar":false,"isSelected":false,"isDeletable":true}`)
+	})
+
+	avatar, _, err := testClient.Avatar.Upload(context.Background(), AvatarTypeProject, "EX", "image/png", strings.NewReader("PNG"), &AvatarUploadOptions{X: 16, Y: 8, Size: 48})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if avatar.ID != "1010" || !avatar.IsDeletable {
+		t.Errorf("Expected deletable avatar 1010. Got %+v", avatar)
+	}
+}
+
+func TestAvatarService_Upload_User(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if _, _, err := testClient.Avatar.Upload(context.Background(), AvatarTypeUser, "5b10a2844c20165700ede21g", "image/png", strings.NewReader("PNG"), nil); err == nil {
+		t.Error("Expected an error for user avatars")
+	}
+}
+
+func TestAvatarService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/universal_avatar/type/issuetype/owner/10001/avatar/1010"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testapiEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Avatar.Delete(context.Background(), AvatarTypeIssueType, "10001", "1010"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestAvatarService_SetProjectAvatar(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/project/EX/avatar"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint)
+		if b, _ := io.ReadAll(r.Body); string(b) != `{"id":"1010"}`+"\n" {
+			t.Errorf("Unexpected request body %s", b)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Avatar.SetProjectAvatar(context.Background(), "EX", "1010"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestAvatarService_SetIssueTypeAvatar(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/issuetype/10001"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint)
+		if b, _ := io.ReadAll(r.Body); string(b) != `{"avatarId":1010}`+"\n" {
+			t.Errorf("Unexpected request body %s", b)
+		}
+		fmt.Fprint(w, `{"id":"10001","avatarId":1010}`)
+	})
+
+	if _, err := testClient.Avatar.SetIssueTypeAvatar(context.Background(), "10001", "1010"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Avatar.SetIssueTypeAvatar(context.Background(), "10001", "system"); err == nil {
+		t.Error("Expected an error for a non-numeric avatar ID")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -12,21 +13,60 @@ import (
 // Jira API docs: https://docs.atlassian.com/jira-software/REST/server/
 type BoardService service
 
-// BoardsList reflects a list of agile boards
-type BoardsList struct {
-	MaxResults int     `json:"maxResults" structs:"maxResults"`
-	StartAt    int     `json:"startAt" structs:"startAt"`
-	Total      int     `json:"total" structs:"total"`
-	IsLast     bool    `json:"isLast" structs:"isLast"`
-	Values     []Board `json:"values" structs:"values"`
+// BoardType represents the type of an agile board.
+type BoardType string
+
+const (
+	BoardTypeScrum  BoardType = "scrum"
+	BoardTypeKanban BoardType = "kanban"
+	BoardTypeSimple BoardType = "simple"
+)
+
+// IsValid reports whether t is a board type known to Jira.
+func (t BoardType) IsValid() bool {
+	switch t {
+	case BoardTypeScrum, BoardTypeKanban, BoardTypeSimple:
+		return true
+	}
+	return false
+}
+
+// SprintState represents the state of a sprint.
+type SprintState string
+
+const (
+	SprintStateFuture SprintState = "future"
+	SprintStateActive SprintState = "active"
+	SprintStateClosed SprintState = "closed"
+)
+
+// IsValid reports whether s is a sprint state known to Jira.
+func (s SprintState) IsValid() bool {
+	switch s {
+	case SprintStateFuture, SprintStateActive, SprintStateClosed:
+		return true
+	}
+	return false
+}
+
+// SprintStates joins states to the comma-separated list expected by GetAllSprintsOptions.State.
+func SprintStates(states ...SprintState) string {
+	values := make([]string, 0, len(states))
+	for _, state := range states {
+		values = append(values, string(state))
+	}
+	return strings.Join(values, ",")
 }
 
+// BoardsList reflects a list of agile boards
+type BoardsList = PagedList[Board]
+
 // Board represents a Jira agile board
 type Board struct {
 	ID       int           `json:"id,omitempty" structs:"id,omitempty"`
 	Self     string        `json:"self,omitempty" structs:"self,omitempty"`
 	Name     string        `json:"name,omitempty" structs:"name,omitemtpy"`
-	Type     string        `json:"type,omitempty" structs:"type,omitempty"`
+	Type     BoardType     `json:"type,omitempty" structs:"type,omitempty"`
 	Location BoardLocation `json:"location,omitempty" structs:"location,omitempty"`
 	FilterID int           `json:"filterId,omitempty" structs:"filterId,omitempty"`
 }
@@ -46,8 +86,8 @@ type BoardLocation struct {
 // BoardListOptions specifies the optional parameters to the BoardService.GetList
 type BoardListOptions struct {
 	// BoardType filters results to boards of the specified type.
-	// Valid values: scrum, kanban.
-	BoardType string `url:"type,omitempty"`
+	// Valid values: BoardTypeScrum, BoardTypeKanban, BoardTypeSimple.
+	BoardType BoardType `url:"type,omitempty"`
 	// Name filters results to boards that match or partially match the specified name.
 	Name string `url:"name,omitempty"`
 	// ProjectKeyOrID filters results to boards that are relevant to a project.
@@ -57,34 +97,49 @@ type BoardListOptions struct {
 	SearchOptions
 }
 
+// BoardCreatePayload is the request body of BoardService.CreateBoard.
+type BoardCreatePayload struct {
+	// Name must be less than 255 characters.
+	Name string `json:"name" structs:"name"`
+	// Type is BoardTypeScrum or BoardTypeKanban.
+	Type BoardType `json:"type" structs:"type"`
+	// FilterID is the ID of a filter that the user has permissions to view.
+	FilterID int `json:"filterId" structs:"filterId"`
+	// Location is the container of the board. If nil, the board is located in the user's profile.
+	Location *BoardCreateLocation `json:"location,omitempty" structs:"location,omitempty"`
+}
+
+// BoardCreateLocation is the container a board is created in.
+type BoardCreateLocation struct {
+	// Type is "project" or "user".
+	Type string `json:"type" structs:"type"`
+	// ProjectKeyOrID is required if Type is "project".
+	ProjectKeyOrID string `json:"projectKeyOrId,omitempty" structs:"projectKeyOrId,omitempty"`
+}
+
 // GetAllSprintsOptions specifies the optional parameters to the BoardService.GetList
 type GetAllSprintsOptions struct {
-	// State filters results to sprints in the specified states, comma-separate list
+	// State filters results to sprints in the specified states, comma-separate list.
+	// Use SprintStates to build it from SprintState values.
 	State string `url:"state,omitempty"`
 
 	SearchOptions
 }
 
 // SprintsList reflects a list of agile sprints
-type SprintsList struct {
-	MaxResults int      `json:"maxResults" structs:"maxResults"`
-	StartAt    int      `json:"startAt" structs:"startAt"`
-	Total      int      `json:"total" structs:"total"`
-	IsLast     bool     `json:"isLast" structs:"isLast"`
-	Values     []Sprint `json:"values" structs:"values"`
-}
+type SprintsList = PagedList[Sprint]
 
 // Sprint represents a sprint on Jira agile board
 type Sprint struct {
-	ID            int        `json:"id" structs:"id"`
-	Name          string     `json:"name" structs:"name"`
-	CompleteDate  *time.Time `json:"completeDate" structs:"completeDate"`
-	EndDate       *time.Time `json:"endDate" structs:"endDate"`
-	StartDate     *time.Time `json:"startDate" structs:"startDate"`
-	OriginBoardID int        `json:"originBoardId" structs:"originBoardId"`
-	Self          string     `json:"self" structs:"self"`
-	State         string     `json:"state" structs:"state"`
-	Goal          string     `json:"goal,omitempty" structs:"goal"`
+	ID            int         `json:"id" structs:"id"`
+	Name          string      `json:"name" structs:"name"`
+	CompleteDate  *time.Time  `json:"completeDate" structs:"completeDate"`
+	EndDate       *time.Time  `json:"endDate" structs:"endDate"`
+	StartDate     *time.Time  `json:"startDate" structs:"startDate"`
+	OriginBoardID int         `json:"originBoardId" structs:"originBoardId"`
+	Self          string      `json:"self" structs:"self"`
+	State         SprintState `json:"state" structs:"state"`
+	Goal          string      `json:"goal,omitempty" structs:"goal"`
 }
 
 // BoardConfiguration represents a boardConfiguration of a jira board
@@ -96,6 +151,26 @@ type BoardConfiguration struct {
 	Filter       BoardConfigurationFilter       `json:"filter"`
 	SubQuery     BoardConfigurationSubQuery     `json:"subQuery"`
 	ColumnConfig BoardConfigurationColumnConfig `json:"columnConfig"`
+	Estimation   *BoardEstimation               `json:"estimation,omitempty"`
+	Ranking      *BoardRanking                  `json:"ranking,omitempty"`
+}
+
+// BoardEstimation represents the estimation statistic of a board, like story points or the original estimate.
+type BoardEstimation struct {
+	// Type is "field" if estimation is based on a field and "none" if estimation is disabled.
+	Type  string                `json:"type"`
+	Field *BoardEstimationField `json:"field,omitempty"`
+}
+
+// BoardEstimationField references the field used for estimation.
+type BoardEstimationField struct {
+	FieldID     string `json:"fieldId"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// BoardRanking references the field used to rank the issues of a board.
+type BoardRanking struct {
+	RankCustomFieldID int `json:"rankCustomFieldId"`
 }
 
 // BoardConfigurationFilter reference to the filter used by the given board.
@@ -146,24 +221,17 @@ type BoardConfigurationColumnStatus struct {
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *BoardService) GetAllBoards(ctx context.Context, opt *BoardListOptions) (*BoardsList, *Response, error) {
+	if opt != nil && opt.BoardType != "" && !opt.BoardType.IsValid() {
+		return nil, nil, fmt.Errorf("invalid board type %q", opt.BoardType)
+	}
+
 	apiEndpoint := "rest/agile/1.0/board"
 	url, err := addOptions(apiEndpoint, opt)
 	if err != nil {
 		return nil, nil, err
 	}
-	req, err := s.client.NewRequest(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, nil, err
-	}
 
-	boards := new(BoardsList)
-	resp, err := s.client.Do(req, boards)
-	if err != nil {
-		jerr := NewJiraError(resp, err)
-		return nil, resp, jerr
-	}
-
-	return boards, resp, err
+	return getPage[Board](ctx, s.client, url, agilePaging)
 }
 
 // GetBoard returns the board for the given board ID.
@@ -188,18 +256,12 @@ func (s *BoardService) GetBoard(ctx context.Context, boardID int64) (*Board, *Re
 	return board, resp, nil
 }
 
-// CreateBoard creates a new board. Board name, type and filter Id is required.
-// name - Must be less than 255 characters.
-// type - Valid values: scrum, kanban
-// filterId - Id of a filter that the user has permissions to view.
+// CreateBoard creates a new board. Board name, type and filter ID are required.
 // Note, if the user does not have the 'Create shared objects' permission and tries to create a shared board, a private
 // board will be created instead (remember that board sharing depends on the filter sharing).
 //
-// Jira API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-createBoard
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *BoardService) CreateBoard(ctx context.Context, board *Board) (*Board, *Response, error) {
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-post
+func (s *BoardService) CreateBoard(ctx context.Context, board *BoardCreatePayload) (*Board, *Response, error) {
 	apiEndpoint := "rest/agile/1.0/board"
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, board)
 	if err != nil {
@@ -247,40 +309,81 @@ func (s *BoardService) GetAllSprints(ctx context.Context, boardID int64, options
 	if err != nil {
 		return nil, nil, err
 	}
-	req, err := s.client.NewRequest(ctx, http.MethodGet, url, nil)
+
+	return getPage[Sprint](ctx, s.client, url, agilePaging)
+}
+
+// GetBoardConfiguration returns the configuration of the board boardID:
+// its columns with their statuses, the estimation field and the custom field used for ranking.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-configuration-get
+func (s *BoardService) GetBoardConfiguration(ctx context.Context, boardID int) (*BoardConfiguration, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/configuration", boardID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	result := new(SprintsList)
+	result := new(BoardConfiguration)
 	resp, err := s.client.Do(req, result)
 	if err != nil {
-		err = NewJiraError(resp, err)
+		return nil, resp, NewJiraError(resp, err)
 	}
 
-	return result, resp, err
+	return result, resp, nil
 }
 
-// GetBoardConfiguration will return a board configuration for a given board Id
-// Jira API docs:https://developer.atlassian.com/cloud/jira/software/rest/#api-rest-agile-1-0-board-boardId-configuration-get
+// GetBoardByFilterID returns the boards using the filter filterID.
+// Only the ID, name and self link of the boards are returned.
 //
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *BoardService) GetBoardConfiguration(ctx context.Context, boardID int) (*BoardConfiguration, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/configuration", boardID)
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-filter-filterid-get
+func (s *BoardService) GetBoardByFilterID(ctx context.Context, filterID int, options *SearchOptions) (*BoardsList, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/filter/%d", filterID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	return getPage[Board](ctx, s.client, url, agilePaging)
+}
 
+// GetBoardEstimation returns the estimation statistic of a board.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-estimation-get
+func (s *BoardService) GetBoardEstimation(ctx context.Context, boardID int) (*BoardEstimation, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/estimation", boardID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	result := new(BoardConfiguration)
-	resp, err := s.client.Do(req, result)
+	estimation := new(BoardEstimation)
+	resp, err := s.client.Do(req, estimation)
 	if err != nil {
-		err = NewJiraError(resp, err)
+		return nil, resp, NewJiraError(resp, err)
 	}
+	return estimation, resp, nil
+}
 
-	return result, resp, err
+// SetBoardEstimation sets the estimation statistic of a board to the field with the given ID,
+// like "timeoriginalestimate" or the ID of the story points custom field.
+// The estimation fields available for a board are listed in the "estimation" section of GetBoardConfiguration.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-estimation-put
+func (s *BoardService) SetBoardEstimation(ctx context.Context, boardID int, fieldID string) (*BoardEstimation, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/estimation", boardID)
+	payload := struct {
+		Value string `json:"value"`
+	}{Value: fieldID}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
 
+	estimation := new(BoardEstimation)
+	resp, err := s.client.Do(req, estimation)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return estimation, resp, nil
 }
@@ -0,0 +1,218 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// BulkService handles bulk operations on issues for the Jira instance / API.
+// Bulk operations run asynchronously: Every submission returns the ID of a task,
+// whose progress can be requested via BulkService.GetTask or awaited via BulkService.WaitForTask.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-bulk-operations/
+type BulkService service
+
+// Status of a bulk operation task
+const (
+	BulkTaskStatusEnqueued        = "ENQUEUED"
+	BulkTaskStatusRunning         = "RUNNING"
+	BulkTaskStatusComplete        = "COMPLETE"
+	BulkTaskStatusFailed          = "FAILED"
+	BulkTaskStatusCancelRequested = "CANCEL_REQUESTED"
+	BulkTaskStatusCancelled       = "CANCELLED"
+	BulkTaskStatusDead            = "DEAD"
+)
+
+// defaultBulkPollInterval is the interval BulkService.WaitForTask requests the progress of a task with by default.
+const defaultBulkPollInterval = time.Second
+
+// BulkEditRequest edits fields of up to 1000 issues.
+type BulkEditRequest struct {
+	// SelectedIssueIdsOrKeys are the issues to edit.
+	SelectedIssueIdsOrKeys []string `json:"selectedIssueIdsOrKeys"`
+	// SelectedActions are the IDs of the fields to edit, like "labels" or "customfield_10010".
+	SelectedActions []string `json:"selectedActions"`
+	// EditedFieldsInput contains the new values of the selected fields, grouped by field type,
+	// like {"labelsFields": [{"fieldId": "labels", "bulkEditMultiSelectFieldOption": "ADD", "labels": [{"name": "triaged"}]}]}.
+	EditedFieldsInput map[string]interface{} `json:"editedFieldsInput"`
+	// SendBulkNotification defines whether a single notification is sent. Jira defaults to true.
+	SendBulkNotification *bool `json:"sendBulkNotification,omitempty"`
+}
+
+// BulkTransitionRequest transitions up to 1000 issues.
+type BulkTransitionRequest struct {
+	BulkTransitionInputs []BulkTransitionInput `json:"bulkTransitionInputs"`
+	// SendBulkNotification defines whether a single notification is sent. Jira defaults to true.
+	SendBulkNotification *bool `json:"sendBulkNotification,omitempty"`
+}
+
+// BulkTransitionInput transitions issues sharing a workflow via the same transition.
+type BulkTransitionInput struct {
+	SelectedIssueIdsOrKeys []string `json:"selectedIssueIdsOrKeys"`
+	TransitionID           string   `json:"transitionId"`
+}
+
+// BulkMoveRequest moves up to 1000 issues to other projects or issue types.
+type BulkMoveRequest struct {
+	// TargetToSourcesMapping maps the target of the move to the issues moved there.
+	// The keys are "<project ID or key>,<issue type ID>" or, for sub-tasks, "<project ID or key>,<issue type ID>,<parent ID or key>".
+	TargetToSourcesMapping map[string]BulkMoveTarget `json:"targetToSourcesMapping"`
+	// SendBulkNotification defines whether a single notification is sent. Jira defaults to true.
+	SendBulkNotification *bool `json:"sendBulkNotification,omitempty"`
+}
+
+// BulkMoveTarget contains the issues moved to a target and how their values are migrated.
+type BulkMoveTarget struct {
+	IssueIdsOrKeys              []string `json:"issueIdsOrKeys"`
+	InferClassificationDefaults bool     `json:"inferClassificationDefaults"`
+	InferFieldDefaults          bool     `json:"inferFieldDefaults"`
+	InferStatusDefaults         bool     `json:"inferStatusDefaults"`
+	InferSubtaskTypeDefault     bool     `json:"inferSubtaskTypeDefault"`
+	// TargetStatus maps the statuses of the target workflow to the statuses of the moved issues.
+	// It is required unless InferStatusDefaults is set.
+	TargetStatus []BulkMoveStatusMapping `json:"targetStatus,omitempty"`
+	// TargetMandatoryFields contains values for the required fields of the target.
+	// It is required unless InferFieldDefaults is set.
+	TargetMandatoryFields []BulkMoveMandatoryFields `json:"targetMandatoryFields,omitempty"`
+}
+
+// BulkMoveStatusMapping maps the ID of a status of the target workflow to the IDs of the statuses migrated to it.
+type BulkMoveStatusMapping struct {
+	Statuses map[string][]string `json:"statuses"`
+}
+
+// BulkMoveMandatoryFields contains values for the required fields of a move target, by field ID.
+type BulkMoveMandatoryFields struct {
+	Fields map[string]interface{} `json:"fields"`
+}
+
+// bulkIssues is the payload of BulkService.Watch and BulkService.Unwatch.
+type bulkIssues struct {
+	SelectedIssueIdsOrKeys []string `json:"selectedIssueIdsOrKeys"`
+}
+
+// bulkSubmission is the response of all bulk operations.
+type bulkSubmission struct {
+	TaskID string `json:"taskId"`
+}
+
+// BulkTask is the progress of a bulk operation.
+type BulkTask struct {
+	TaskID string `json:"taskId"`
+	// Status is one of the BulkTaskStatus constants.
+	Status                          string `json:"status"`
+	ProgressPercent                 int    `json:"progressPercent"`
+	TotalIssueCount                 int    `json:"totalIssueCount"`
+	InvalidOrInaccessibleIssueCount int    `json:"invalidOrInaccessibleIssueCount"`
+	// ProcessedAccessibleIssues are the IDs of the issues processed successfully.
+	ProcessedAccessibleIssues []int64 `json:"processedAccessibleIssues,omitempty"`
+	// FailedAccessibleIssues contains the error messages of the issues that couldn't be processed, by issue ID.
+	FailedAccessibleIssues map[string][]string `json:"failedAccessibleIssues,omitempty"`
+	SubmittedBy            *User               `json:"submittedBy,omitempty"`
+}
+
+// Done reports whether the task ended, successfully or not.
+func (t *BulkTask) Done() bool {
+	switch t.Status {
+	case BulkTaskStatusComplete, BulkTaskStatusFailed, BulkTaskStatusCancelled, BulkTaskStatusDead:
+		return true
+	}
+	return false
+}
+
+// Edit submits a bulk edit of fields and returns the ID of its task.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-bulk-operations/#api-rest-api-3-bulk-issues-fields-post
+func (s *BulkService) Edit(ctx context.Context, edit *BulkEditRequest) (string, *Response, error) {
+	return s.submit(ctx, "rest/api/3/bulk/issues/fields", edit)
+}
+
+// Transition submits a bulk transition and returns the ID of its task.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-bulk-operations/#api-rest-api-3-bulk-issues-transition-post
+func (s *BulkService) Transition(ctx context.Context, transition *BulkTransitionRequest) (string, *Response, error) {
+	return s.submit(ctx, "rest/api/3/bulk/issues/transition", transition)
+}
+
+// Move submits a bulk move to other projects or issue types and returns the ID of its task.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-bulk-operations/#api-rest-api-3-bulk-issues-move-post
+func (s *BulkService) Move(ctx context.Context, move *BulkMoveRequest) (string, *Response, error) {
+	return s.submit(ctx, "rest/api/3/bulk/issues/move", move)
+}
+
+// Watch submits adding the current user as watcher of the issues issueIDsOrKeys and returns the ID of its task.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-bulk-operations/#api-rest-api-3-bulk-issues-watch-post
+func (s *BulkService) Watch(ctx context.Context, issueIDsOrKeys []string) (string, *Response, error) {
+	return s.submit(ctx, "rest/api/3/bulk/issues/watch", &bulkIssues{SelectedIssueIdsOrKeys: issueIDsOrKeys})
+}
+
+// Unwatch submits removing the current user as watcher of the issues issueIDsOrKeys and returns the ID of its task.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-bulk-operations/#api-rest-api-3-bulk-issues-unwatch-post
+func (s *BulkService) Unwatch(ctx context.Context, issueIDsOrKeys []string) (string, *Response, error) {
+	return s.submit(ctx, "rest/api/3/bulk/issues/unwatch", &bulkIssues{SelectedIssueIdsOrKeys: issueIDsOrKeys})
+}
+
+// submit posts a bulk operation to apiEndpoint and returns the ID of its task.
+func (s *BulkService) submit(ctx context.Context, apiEndpoint string, body interface{}) (string, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, body)
+	if err != nil {
+		return "", nil, err
+	}
+
+	submission := new(bulkSubmission)
+	resp, err := s.client.Do(req, submission)
+	if err != nil {
+		return "", resp, NewJiraError(resp, err)
+	}
+	return submission.TaskID, resp, nil
+}
+
+// GetTask returns the progress of the bulk operation task taskID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-bulk-operations/#api-rest-api-3-bulk-queue-taskid-get
+func (s *BulkService) GetTask(ctx context.Context, taskID string) (*BulkTask, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/bulk/queue/%s", url.PathEscape(taskID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(BulkTask)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return task, resp, nil
+}
+
+// WaitForTask requests the progress of the bulk operation task taskID every interval until the task is done
+// or ctx is done. An interval of zero or less polls every second.
+//
+// The task is returned for every final status, so callers have to check BulkTask.Status
+// and BulkTask.FailedAccessibleIssues to find out whether all issues were processed.
+func (s *BulkService) WaitForTask(ctx context.Context, taskID string, interval time.Duration) (*BulkTask, *Response, error) {
+	if interval <= 0 {
+		interval = defaultBulkPollInterval
+	}
+
+	for {
+		task, resp, err := s.GetTask(ctx, taskID)
+		if err != nil || task.Done() {
+			return task, resp, err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return task, resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBulkService_Edit(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/bulk/issues/fields"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if got := body["selectedIssueIdsOrKeys"]; !reflect.DeepEqual(got, []interface{}{"EX-1", "EX-2"}) {
+			t.Errorf("Expected the selected issues, got %v", got)
+		}
+		if got := body["selectedActions"]; !reflect.DeepEqual(got, []interface{}{"labels"}) {
+			t.Errorf("Expected the selected actions, got %v", got)
+		}
+		if got := body["sendBulkNotification"]; got != false {
+			t.Errorf("Expected sendBulkNotification false, got %v", got)
+		}
+		fmt.Fprint(w, `{"taskId":"10641"}`)
+	})
+
+	notify := false
+	taskID, _, err := testClient.Bulk.Edit(context.Background(), &BulkEditRequest{
+		SelectedIssueIdsOrKeys: []string{"EX-1", "EX-2"},
+		SelectedActions:        []string{"labels"},
+		EditedFieldsInput: map[string]interface{}{
+			"labelsFields": []interface{}{map[string]interface{}{"fieldId": "labels", "bulkEditMultiSelectFieldOption": "ADD", "labels": []interface{}{map[string]string{"name": "triaged"}}}},
+		},
+		SendBulkNotification: &notify,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if taskID != "10641" {
+		t.Errorf("Expected task ID 10641, got %s", taskID)
+	}
+}
+
+func TestBulkService_Transition(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/bulk/issues/transition"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var body BulkTransitionRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if len(body.BulkTransitionInputs) != 1 || body.BulkTransitionInputs[0].TransitionID != "31" || body.SendBulkNotification != nil {
+			t.Errorf("Unexpected body %+v", body)
+		}
+		fmt.Fprint(w, `{"taskId":"10642"}`)
+	})
+
+	taskID, _, err := testClient.Bulk.Transition(context.Background(), &BulkTransitionRequest{
+		BulkTransitionInputs: []BulkTransitionInput{{SelectedIssueIdsOrKeys: []string{"EX-1"}, TransitionID: "31"}},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if taskID != "10642" {
+		t.Errorf("Expected task ID 10642, got %s", taskID)
+	}
+}
+
+func TestBulkService_Move(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/bulk/issues/move"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var body BulkMoveRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		target, ok := body.TargetToSourcesMapping["NEW,10001"]
+		if !ok || !reflect.DeepEqual(target.IssueIdsOrKeys, []string{"EX-1"}) || !target.InferFieldDefaults {
+			t.Errorf("Unexpected body %+v", body)
+		}
+		if len(target.TargetStatus) != 1 || !reflect.DeepEqual(target.TargetStatus[0].Statuses["10000"], []string{"1", "3"}) {
+			t.Errorf("Unexpected status mapping %+v", target.TargetStatus)
+		}
+		fmt.Fprint(w, `{"taskId":"10643"}`)
+	})
+
+	taskID, _, err := testClient.Bulk.Move(context.Background(), &BulkMoveRequest{
+		TargetToSourcesMapping: map[string]BulkMoveTarget{
+			"NEW,10001": {
+				IssueIdsOrKeys:     []string{"EX-1"},
+				InferFieldDefaults: true,
+				TargetStatus:       []BulkMoveStatusMapping{{Statuses: map[string][]string{"10000": {"1", "3"}}}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if taskID != "10643" {
+		t.Errorf("Expected task ID 10643, got %s", taskID)
+	}
+}
+
+func TestBulkService_WatchAndUnwatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, endpoint := range []string{"/rest/api/3/bulk/issues/watch", "/rest/api/3/bulk/issues/unwatch"} {
+		endpoint := endpoint
+		testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			testRequestURL(t, r, endpoint)
+
+			var body map[string][]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(body["selectedIssueIdsOrKeys"], []string{"EX-1", "EX-2"}) {
+				t.Errorf("Unexpected body %v", body)
+			}
+			fmt.Fprint(w, `{"taskId":"10644"}`)
+		})
+	}
+
+	if _, _, err := testClient.Bulk.Watch(context.Background(), []string{"EX-1", "EX-2"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, _, err := testClient.Bulk.Unwatch(context.Background(), []string{"EX-1", "EX-2"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestBulkService_GetTask(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/bulk/queue/10641"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"taskId":"10641","status":"COMPLETE","progressPercent":100,"totalIssueCount":3,"invalidOrInaccessibleIssueCount":1,
+			"processedAccessibleIssues":[10001],"failedAccessibleIssues":{"10002":["Field labels is not on the edit screen"]},
+			"submittedBy":{"accountId":"5b10a2844c20165700ede21g"}}`)
+	})
+
+	task, _, err := testClient.Bulk.GetTask(context.Background(), "10641")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !task.Done() || task.ProgressPercent != 100 || task.TotalIssueCount != 3 || task.InvalidOrInaccessibleIssueCount != 1 {
+		t.Errorf("Unexpected task %+v", task)
+	}
+	if !reflect.DeepEqual(task.ProcessedAccessibleIssues, []int64{10001}) || len(task.FailedAccessibleIssues["10002"]) != 1 {
+		t.Errorf("Unexpected issues of task %+v", task)
+	}
+	if task.SubmittedBy == nil || task.SubmittedBy.AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Unexpected submitter %+v", task.SubmittedBy)
+	}
+}
+
+func TestBulkService_WaitForTask(t *testing.T) {
+	setup()
+	defer teardown()
+
+	statuses := []string{BulkTaskStatusEnqueued, BulkTaskStatusRunning, BulkTaskStatusComplete}
+	requests := 0
+	testMux.HandleFunc("/rest/api/3/bulk/queue/10641", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprintf(w, `{"taskId":"10641","status":"%s"}`, statuses[requests])
+		requests++
+	})
+
+	task, _, err := testClient.Bulk.WaitForTask(context.Background(), "10641", time.Millisecond)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if task.Status != BulkTaskStatusComplete {
+		t.Errorf("Expected status COMPLETE, got %s", task.Status)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+}
+
+func TestBulkService_WaitForTask_ContextDone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	requests := 0
+	testMux.HandleFunc("/rest/api/3/bulk/queue/10641", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"taskId":"10641","status":"RUNNING"}`)
+	})
+
+	task, _, err := testClient.Bulk.WaitForTask(ctx, "10641", time.Hour)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if task == nil || task.Status != BulkTaskStatusRunning {
+		t.Errorf("Expected the last progress of the task, got %+v", task)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+}
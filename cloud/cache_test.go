@@ -0,0 +1,88 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_Do_Cache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		if requests > 1 {
+			t.Errorf("Expected a conditional request. Got If-None-Match %q", r.Header.Get("If-None-Match"))
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `[{"id":"summary","name":"Summary"}]`)
+	})
+
+	client, _ := NewClient(testServer.URL, nil, WithCache(NewMemoryCache(10)))
+	for i := 0; i < 2; i++ {
+		fields, resp, err := client.Field.GetList(context.Background())
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if len(fields) != 1 || fields[0].Name != "Summary" {
+			t.Errorf("Unexpected fields %+v", fields)
+		}
+		if resp.FromCache != (i == 1) {
+			t.Errorf("Request %d: FromCache = %t", i+1, resp.FromCache)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests. Got %d", requests)
+	}
+}
+
+func TestClient_Do_CacheSkipsStreamedResponses(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/attachment/content/10000/", func(w http.ResponseWriter, r *http.Request) {
+		if inm := r.Header.Get("If-None-Match"); inm != "" {
+			t.Errorf("Expected no conditional request. Got If-None-Match %q", inm)
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, "binary content")
+	})
+
+	cache := NewMemoryCache(10)
+	client, _ := NewClient(testServer.URL, nil, WithCache(cache))
+	for i := 0; i < 2; i++ {
+		resp, err := client.Issue.DownloadAttachment(context.Background(), "10000")
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		resp.Body.Close()
+	}
+	if _, found := cache.Get(testServer.URL + "/rest/api/2/attachment/content/10000/"); found {
+		t.Error("Expected the attachment not to be cached")
+	}
+}
+
+func TestMemoryCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", CachedResponse{ETag: "1"})
+	cache.Set("b", CachedResponse{ETag: "2"})
+	cache.Get("a")
+	cache.Set("c", CachedResponse{ETag: "3"})
+
+	if _, found := cache.Get("b"); found {
+		t.Error("Expected b to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, found := cache.Get(key); !found {
+			t.Errorf("Expected %s to be cached", key)
+		}
+	}
+}
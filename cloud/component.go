@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // ComponentService represents project components.
@@ -93,12 +94,96 @@ func (s *ComponentService) Get(ctx context.Context, componentID string) (*Projec
 	return component, resp, nil
 }
 
-// TODO Add "Update component" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-put
+// Update updates the component componentID. Only the non-empty values of options are changed,
+// the project of a component can't be changed.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-put
+func (s *ComponentService) Update(ctx context.Context, componentID string, options *ComponentCreateOptions) (*ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/component/%s", url.PathEscape(componentID))
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	component := new(ProjectComponent)
+	resp, err := s.client.Do(req, component)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return component, resp, nil
+}
 
-// TODO Add "Delete component" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-delete
+// Delete deletes the component componentID.
+// If moveIssuesTo is set, the issues of the component are moved to the component moveIssuesTo,
+// otherwise the component is removed from them.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-delete
+func (s *ComponentService) Delete(ctx context.Context, componentID, moveIssuesTo string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/component/%s", url.PathEscape(componentID))
+	if moveIssuesTo != "" {
+		apiEndpoint += "?moveIssuesTo=" + url.QueryEscape(moveIssuesTo)
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
 
-// TODO Add "Get component issues count" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-relatedissuecounts-get
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
 
-// TODO Add "Get project components paginated" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-project-projectidorkey-component-get
+	return resp, nil
+}
+
+// ComponentIssueCount is the number of issues of a component.
+type ComponentIssueCount struct {
+	Self       string `json:"self,omitempty" structs:"self,omitempty"`
+	IssueCount int    `json:"issueCount" structs:"issueCount"`
+}
+
+// GetRelatedIssueCount returns the number of issues of the component componentID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-relatedissuecounts-get
+func (s *ComponentService) GetRelatedIssueCount(ctx context.Context, componentID string) (*ComponentIssueCount, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/component/%s/relatedIssueCounts", url.PathEscape(componentID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	count := new(ComponentIssueCount)
+	resp, err := s.client.Do(req, count)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return count, resp, nil
+}
+
+// ComponentListOptions specifies the optional parameters for ComponentService.GetProjectComponents.
+type ComponentListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// OrderBy sorts the components, like "name", "-issueCount", "lead" or "description".
+	OrderBy string `url:"orderBy,omitempty"`
+	// Query restricts the results to components with a name or description containing this string.
+	Query string `url:"query,omitempty"`
+}
+
+// GetProjectComponents returns one page of the components of the project projectIDOrKey.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-project-projectidorkey-component-get
+func (s *ComponentService) GetProjectComponents(ctx context.Context, projectIDOrKey string, options *ComponentListOptions) (*PagedList[ProjectComponent], *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/project/%s/component", url.PathEscape(projectIDOrKey))
+	u, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[ProjectComponent](ctx, s.client, u, agilePaging)
+}
 
 // TODO Add "Get project components" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-project-projectidorkey-components-get
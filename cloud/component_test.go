@@ -3,6 +3,7 @@ package cloud
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"testing"
@@ -79,3 +80,82 @@ func TestComponentService_Get_NoComponent(t *testing.T) {
 		t.Error("No error given. Expected one")
 	}
 }
+
+func TestComponentService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/component/10000"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint)
+		if b, _ := io.ReadAll(r.Body); string(b) != `{"name":"Backend","description":"Server side"}`+"\n" {
+			t.Errorf("Unexpected request body %s", b)
+		}
+		fmt.Fprint(w, `{"id":"10000","name":"Backend","description":"Server side","project":"EX","projectId":10000}`)
+	})
+
+	component, _, err := testClient.Component.Update(context.Background(), "10000", &ComponentCreateOptions{Name: "Backend", Description: "Server side"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if component.Name != "Backend" {
+		t.Errorf("Expected component Backend. Got %+v", component)
+	}
+}
+
+func TestComponentService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/component/10000"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testapiEndpoint+"?moveIssuesTo=10001")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Component.Delete(context.Background(), "10000", "10001"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestComponentService_GetRelatedIssueCount(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/component/10000/relatedIssueCounts"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/3/component/10000","issueCount":23}`)
+	})
+
+	count, _, err := testClient.Component.GetRelatedIssueCount(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if count.IssueCount != 23 {
+		t.Errorf("Expected 23 issues. Got %+v", count)
+	}
+}
+
+func TestComponentService_GetProjectComponents(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/project/EX/component"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?maxResults=50&orderBy=-issueCount")
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":2,"isLast":true,"values":[{"id":"10000","name":"Backend","issueCount":23},{"id":"10001","name":"Legacy","issueCount":0}]}`)
+	})
+
+	page, _, err := testClient.Component.GetProjectComponents(context.Background(), "EX", &ComponentListOptions{MaxResults: 50, OrderBy: "-issueCount"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 2 || page.Values[0].IssueCount != 23 || page.HasNext() {
+		t.Errorf("Unexpected page %+v", page)
+	}
+}
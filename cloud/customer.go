@@ -28,13 +28,7 @@ type CustomerListOptions struct {
 }
 
 // CustomerList is a page of customers.
-type CustomerList struct {
-	Values  []Customer `json:"values,omitempty" structs:"values,omitempty"`
-	Start   int        `json:"start,omitempty" structs:"start,omitempty"`
-	Limit   int        `json:"limit,omitempty" structs:"limit,omitempty"`
-	IsLast  bool       `json:"isLastPage,omitempty" structs:"isLastPage,omitempty"`
-	Expands []string   `json:"_expands,omitempty" structs:"_expands,omitempty"`
-}
+type CustomerList = PagedList[Customer]
 
 // Create creates a ServiceDesk customer.
 //
@@ -0,0 +1,56 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_Do_DebugDump(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "session-secret"})
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"key":"EX-1","token":"response-secret"}`)
+	})
+
+	var dump bytes.Buffer
+	client, _ := NewClient(testServer.URL, nil, WithDebugDump(&dump))
+	req, _ := client.NewRequest(context.Background(), http.MethodPost, "rest/api/2/issue?jwt=jwt-secret", map[string]string{"password": "body-secret", "summary": "Visible"})
+	req.Header.Set("Authorization", "Bearer header-secret")
+
+	resp, err := client.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "response-secret") {
+		t.Errorf("Expected the response body to stay readable. Got %s", body)
+	}
+
+	out := dump.String()
+	for _, secret := range []string{"jwt-secret", "body-secret", "header-secret", "session-secret", "response-secret"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Expected %s to be redacted. Got\n%s", secret, out)
+		}
+	}
+	for _, visible := range []string{"> POST ", "/rest/api/2/issue", `"summary":"Visible"`, "< HTTP/1.1 200 OK", `"key":"EX-1"`} {
+		if !strings.Contains(out, visible) {
+			t.Errorf("Expected %q in the dump. Got\n%s", visible, out)
+		}
+	}
+}
+
+func TestWriteBody_Truncates(t *testing.T) {
+	var msg bytes.Buffer
+	writeBody(&msg, bytes.Repeat([]byte("a"), maxDumpBody+1))
+	if !strings.Contains(msg.String(), "a...") || msg.Len() > maxDumpBody+10 {
+		t.Errorf("Expected the body to be truncated. Got %d bytes", msg.Len())
+	}
+}
@@ -0,0 +1,111 @@
+package cloud
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// dryRunBody is the body of the responses synthesized in dry-run mode.
+const dryRunBody = "{}"
+
+// DryRunRequest is a mutating request intercepted in dry-run mode.
+type DryRunRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
+}
+
+// DryRun collects the requests intercepted by a client created with WithDryRun.
+// It is safe for concurrent use.
+type DryRun struct {
+	mu       sync.Mutex
+	requests []DryRunRequest
+}
+
+// Requests returns all intercepted requests, in the order they were sent.
+func (d *DryRun) Requests() []DryRunRequest {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]DryRunRequest(nil), d.requests...)
+}
+
+// Reset forgets all intercepted requests.
+func (d *DryRun) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.requests = nil
+}
+
+// WithDryRun makes the client intercept all mutating requests (POST, PUT, PATCH and DELETE) instead of sending them.
+// Each intercepted request is recorded in d and answered with a synthesized 200 OK response with an empty JSON object,
+// so the called method succeeds and returns an empty result.
+// GET requests are sent as usual, which allows previewing a migration against real data:
+//
+//	dryRun := new(jira.DryRun)
+//	client, _ := jira.NewClient(baseURL, httpClient, jira.WithDryRun(dryRun))
+//	// ...
+//	for _, r := range dryRun.Requests() {
+//		fmt.Println(r.Method, r.URL)
+//	}
+//
+// Note that some read-only endpoints use POST as well, like the JQL search via POST.
+func WithDryRun(d *DryRun) ClientOption {
+	return func(c *Client) error {
+		if d == nil {
+			return errors.New("dry run must not be nil")
+		}
+		c.dryRun = d
+		return nil
+	}
+}
+
+// intercept records req and returns a synthesized response, if req is a mutating request.
+// It reports whether req was intercepted.
+func (d *DryRun) intercept(req *http.Request) (*http.Response, bool, error) {
+	if d == nil {
+		return nil, false, nil
+	}
+	switch req.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return nil, false, nil
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, true, err
+		}
+	}
+
+	d.mu.Lock()
+	d.requests = append(d.requests, DryRunRequest{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: req.Header.Clone(),
+		Body:   body,
+	})
+	d.mu.Unlock()
+
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Content-Type": []string{"application/json"},
+			"X-Dry-Run":    []string{"true"},
+		},
+		Body:          io.NopCloser(strings.NewReader(dryRunBody)),
+		ContentLength: int64(len(dryRunBody)),
+		Request:       req,
+	}, true, nil
+}
@@ -0,0 +1,57 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_Do_DryRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no request in dry-run mode. Got %s %s", r.Method, r.URL)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"key":"EX-1"}`)
+	})
+
+	dryRun := new(DryRun)
+	client, _ := NewClient(testServer.URL, nil, WithDryRun(dryRun))
+
+	issue, _, err := client.Issue.Get(context.Background(), "EX-1", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-1" {
+		t.Errorf("Expected reads to be sent. Got issue %+v", issue)
+	}
+
+	created, resp, err := client.Issue.Create(context.Background(), &Issue{Fields: &IssueFields{Summary: "Migrated"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if created == nil || resp.Header.Get("X-Dry-Run") != "true" {
+		t.Errorf("Expected a synthesized response. Got %+v", resp)
+	}
+
+	requests := dryRun.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("Expected 1 intercepted request. Got %d", len(requests))
+	}
+	if requests[0].Method != http.MethodPost || !strings.HasSuffix(requests[0].URL, "/rest/api/2/issue") {
+		t.Errorf("Unexpected request %s %s", requests[0].Method, requests[0].URL)
+	}
+	if !strings.Contains(string(requests[0].Body), `"summary":"Migrated"`) {
+		t.Errorf("Expected the request body to be recorded. Got %s", requests[0].Body)
+	}
+
+	dryRun.Reset()
+	if len(dryRun.Requests()) != 0 {
+		t.Error("Expected no requests after Reset")
+	}
+}
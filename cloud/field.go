@@ -2,6 +2,7 @@ package cloud
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 )
 
@@ -20,6 +21,21 @@ type Field struct {
 	Searchable  bool        `json:"searchable,omitempty" structs:"searchable,omitempty"`
 	ClauseNames []string    `json:"clauseNames,omitempty" structs:"clauseNames,omitempty"`
 	Schema      FieldSchema `json:"schema,omitempty" structs:"schema,omitempty"`
+
+	// The following attributes are only returned by FieldService.Search and FieldService.SearchTrashed.
+	Description   string         `json:"description,omitempty" structs:"description,omitempty"`
+	IsLocked      bool           `json:"isLocked,omitempty" structs:"isLocked,omitempty"`
+	SearcherKey   string         `json:"searcherKey,omitempty" structs:"searcherKey,omitempty"`
+	ScreensCount  int            `json:"screensCount,omitempty" structs:"screensCount,omitempty"`
+	ContextsCount int            `json:"contextsCount,omitempty" structs:"contextsCount,omitempty"`
+	LastUsed      *FieldLastUsed `json:"lastUsed,omitempty" structs:"lastUsed,omitempty"`
+}
+
+// FieldLastUsed represents when a field was last used.
+type FieldLastUsed struct {
+	// Type is "TRACKED", "NOT_TRACKED" or "NO_INFORMATION".
+	Type  string `json:"type" structs:"type"`
+	Value string `json:"value,omitempty" structs:"value,omitempty"`
 }
 
 // FieldSchema represents a schema of a Jira field.
@@ -52,3 +68,195 @@ func (s *FieldService) GetList(ctx context.Context) ([]Field, *Response, error)
 	}
 	return fieldList, resp, nil
 }
+
+// epicLinkSchema is the schema of the "Epic Link" custom field of company-managed projects.
+const epicLinkSchema = "com.pyxis.greenhopper.jira:gh-epic-link"
+
+// GetEpicLinkField returns the "Epic Link" custom field.
+// If the instance has no such field, like after the migration to the parent field, nil is returned.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-fields/#api-rest-api-2-field-get
+func (s *FieldService) GetEpicLinkField(ctx context.Context) (*Field, *Response, error) {
+	fields, resp, err := s.GetList(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	for i := range fields {
+		if fields[i].Schema.Custom == epicLinkSchema {
+			return &fields[i], resp, nil
+		}
+	}
+	return nil, resp, nil
+}
+
+// CustomFieldType is the type of a custom field, for CustomFieldPayload.Type.
+type CustomFieldType string
+
+// Types of the custom fields shipped with Jira
+const (
+	CustomFieldTypeCascadingSelect  CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:cascadingselect"
+	CustomFieldTypeDatePicker       CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:datepicker"
+	CustomFieldTypeDateTime         CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:datetime"
+	CustomFieldTypeFloat            CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:float"
+	CustomFieldTypeGroupPicker      CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:grouppicker"
+	CustomFieldTypeImportID         CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:importid"
+	CustomFieldTypeLabels           CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:labels"
+	CustomFieldTypeMultiCheckboxes  CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:multicheckboxes"
+	CustomFieldTypeMultiGroupPicker CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:multigrouppicker"
+	CustomFieldTypeMultiSelect      CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:multiselect"
+	CustomFieldTypeMultiUserPicker  CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:multiuserpicker"
+	CustomFieldTypeMultiVersion     CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:multiversion"
+	CustomFieldTypeProject          CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:project"
+	CustomFieldTypeRadioButtons     CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:radiobuttons"
+	CustomFieldTypeReadOnly         CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:readonlyfield"
+	CustomFieldTypeSelect           CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:select"
+	CustomFieldTypeTextArea         CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:textarea"
+	CustomFieldTypeTextField        CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:textfield"
+	CustomFieldTypeURL              CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:url"
+	CustomFieldTypeUserPicker       CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:userpicker"
+	CustomFieldTypeVersion          CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:version"
+)
+
+// CustomFieldSearcher is the searcher of a custom field, for CustomFieldPayload.SearcherKey.
+// The searcher has to match the type of the field.
+type CustomFieldSearcher string
+
+// Searchers of the custom fields shipped with Jira
+const (
+	CustomFieldSearcherCascadingSelect CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:cascadingselectsearcher"
+	CustomFieldSearcherDateRange       CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:daterange"
+	CustomFieldSearcherDateTimeRange   CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:datetimerange"
+	CustomFieldSearcherExactNumber     CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:exactnumber"
+	CustomFieldSearcherExactText       CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:exacttextsearcher"
+	CustomFieldSearcherGroupPicker     CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:grouppickersearcher"
+	CustomFieldSearcherLabel           CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:labelsearcher"
+	CustomFieldSearcherMultiSelect     CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:multiselectsearcher"
+	CustomFieldSearcherNumberRange     CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:numberrange"
+	CustomFieldSearcherProject         CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:projectsearcher"
+	CustomFieldSearcherText            CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:textsearcher"
+	CustomFieldSearcherUserPickerGroup CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:userpickergroupsearcher"
+	CustomFieldSearcherVersion         CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:versionsearcher"
+)
+
+// CustomFieldPayload is a custom field created by FieldService.Create.
+type CustomFieldPayload struct {
+	Name        string          `json:"name" structs:"name"`
+	Description string          `json:"description,omitempty" structs:"description,omitempty"`
+	Type        CustomFieldType `json:"type" structs:"type"`
+	// SearcherKey makes the field searchable via JQL. Without it, the field can't be searched.
+	SearcherKey CustomFieldSearcher `json:"searcherKey,omitempty" structs:"searcherKey,omitempty"`
+}
+
+// Create creates a custom field.
+// The field is not added to any screen yet.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-fields/#api-rest-api-2-field-post
+func (s *FieldService) Create(ctx context.Context, payload *CustomFieldPayload) (*Field, *Response, error) {
+	apiEndpoint := "rest/api/2/field"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	field := new(Field)
+	resp, err := s.client.Do(req, field)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return field, resp, nil
+}
+
+// FieldSearchOptions specifies the optional parameters for FieldService.Search and FieldService.SearchTrashed.
+type FieldSearchOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// Type restricts the results to "custom" or "system" fields.
+	// Not supported by FieldService.SearchTrashed.
+	Type []string `url:"type,omitempty"`
+	// ID restricts the results to the fields with these IDs.
+	ID []string `url:"id,omitempty"`
+	// Query restricts the results to fields with a name or description containing this string.
+	Query string `url:"query,omitempty"`
+	// OrderBy sorts the results, like "name", "-lastUsed" or "screensCount".
+	OrderBy string `url:"orderBy,omitempty"`
+	// Expand additional attributes, like "lastUsed", "screensCount", "contextsCount", "isLocked" or "searcherKey".
+	Expand string `url:"expand,omitempty"`
+}
+
+// Search returns one page of fields matching the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-fields/#api-rest-api-3-field-search-get
+func (s *FieldService) Search(ctx context.Context, options *FieldSearchOptions) (*PagedList[Field], *Response, error) {
+	apiEndpoint := "rest/api/3/field/search"
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[Field](ctx, s.client, url, agilePaging)
+}
+
+// SearchTrashed returns one page of custom fields in the trash matching the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-fields/#api-rest-api-3-field-search-trashed-get
+func (s *FieldService) SearchTrashed(ctx context.Context, options *FieldSearchOptions) (*PagedList[Field], *Response, error) {
+	apiEndpoint := "rest/api/3/field/search/trashed"
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[Field](ctx, s.client, url, agilePaging)
+}
+
+// Trash moves a custom field to the trash.
+// Trashed fields can be restored via Restore or are deleted permanently after 60 days.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-fields/#api-rest-api-3-field-id-trash-post
+func (s *FieldService) Trash(ctx context.Context, fieldID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/field/%s/trash", fieldID)
+	return s.post(ctx, apiEndpoint)
+}
+
+// Restore restores a custom field from the trash.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-fields/#api-rest-api-3-field-id-restore-post
+func (s *FieldService) Restore(ctx context.Context, fieldID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/field/%s/restore", fieldID)
+	return s.post(ctx, apiEndpoint)
+}
+
+// Delete deletes a custom field permanently.
+// The field has to be in the trash, see Trash.
+// The deletion runs asynchronously in Jira.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-custom-fields/#api-rest-api-3-field-id-delete
+func (s *FieldService) Delete(ctx context.Context, fieldID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/field/%s", fieldID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// post sends a POST request without a body to apiEndpoint.
+func (s *FieldService) post(ctx context.Context, apiEndpoint string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -3,6 +3,7 @@ package cloud
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"testing"
@@ -31,3 +32,112 @@ func TestFieldService_GetList(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestFieldService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/field/search"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?expand=lastUsed%2CscreensCount&orderBy=-lastUsed&type=custom")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"isLast":true,"values":[{"id":"customfield_10000","name":"Approvers","schema":{"type":"array","items":"user","custom":"com.atlassian.jira.plugin.system.customfieldtypes:multiuserpicker","customId":10000},"screensCount":0,"lastUsed":{"type":"TRACKED","value":"2021-01-28T07:37:40.000+0000"}}]}`)
+	})
+
+	fields, _, err := testClient.Field.Search(context.Background(), &FieldSearchOptions{
+		Type:    []string{"custom"},
+		OrderBy: "-lastUsed",
+		Expand:  "lastUsed,screensCount",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(fields.Values) != 1 {
+		t.Fatalf("Expected 1 field. Got %d", len(fields.Values))
+	}
+	if f := fields.Values[0]; f.LastUsed == nil || f.LastUsed.Type != "TRACKED" {
+		t.Errorf("Expected a tracked last usage. Got %+v", f.LastUsed)
+	}
+	if fields.HasNext() {
+		t.Error("Expected only one page")
+	}
+}
+
+func TestFieldService_SearchTrashed(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/field/search/trashed"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?query=Approvers")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"isLast":true,"values":[{"id":"customfield_10000","name":"Approvers"}]}`)
+	})
+
+	fields, _, err := testClient.Field.SearchTrashed(context.Background(), &FieldSearchOptions{Query: "Approvers"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(fields.Values) != 1 || fields.Values[0].ID != "customfield_10000" {
+		t.Errorf("Expected trashed field customfield_10000. Got %+v", fields.Values)
+	}
+}
+
+func TestFieldService_TrashRestoreDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, endpoint := range []struct{ path, method string }{
+		{"/rest/api/3/field/customfield_10000/trash", http.MethodPost},
+		{"/rest/api/3/field/customfield_10000/restore", http.MethodPost},
+		{"/rest/api/3/field/customfield_10000", http.MethodDelete},
+	} {
+		endpoint := endpoint
+		testMux.HandleFunc(endpoint.path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, endpoint.method)
+			testRequestURL(t, r, endpoint.path)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	if _, err := testClient.Field.Trash(context.Background(), "customfield_10000"); err != nil {
+		t.Errorf("Trash: Error given: %s", err)
+	}
+	if _, err := testClient.Field.Restore(context.Background(), "customfield_10000"); err != nil {
+		t.Errorf("Restore: Error given: %s", err)
+	}
+	if _, err := testClient.Field.Delete(context.Background(), "customfield_10000"); err != nil {
+		t.Errorf("Delete: Error given: %s", err)
+	}
+}
+
+func TestFieldService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/field"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testapiEndpoint)
+		body, _ := io.ReadAll(r.Body)
+		want := `{"name":"New custom field","description":"Custom field for picking groups","type":"com.atlassian.jira.plugin.system.customfieldtypes:grouppicker","searcherKey":"com.atlassian.jira.plugin.system.customfieldtypes:grouppickersearcher"}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body\ngot:  %s\nwant: %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"customfield_10101","key":"customfield_10101","name":"New custom field","custom":true,"navigable":true,"searchable":true,"clauseNames":["cf[10101]","New custom field"],"schema":{"type":"group","custom":"com.atlassian.jira.plugin.system.customfieldtypes:grouppicker","customId":10101}}`)
+	})
+
+	field, _, err := testClient.Field.Create(context.Background(), &CustomFieldPayload{
+		Name:        "New custom field",
+		Description: "Custom field for picking groups",
+		Type:        CustomFieldTypeGroupPicker,
+		SearcherKey: CustomFieldSearcherGroupPicker,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if field.ID != "customfield_10101" || field.Schema.CustomID != 10101 {
+		t.Errorf("Unexpected field %+v", field)
+	}
+}
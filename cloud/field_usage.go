@@ -0,0 +1,184 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+// FieldContext represents a context of a custom field.
+// A context defines for which projects and issue types a custom field is available.
+type FieldContext struct {
+	ID              string `json:"id" structs:"id"`
+	Name            string `json:"name" structs:"name"`
+	Description     string `json:"description,omitempty" structs:"description,omitempty"`
+	IsGlobalContext bool   `json:"isGlobalContext" structs:"isGlobalContext"`
+	IsAnyIssueType  bool   `json:"isAnyIssueType" structs:"isAnyIssueType"`
+}
+
+// FieldUsageReport describes where a custom field is available and visible.
+// It is the result of FieldService.GetUsageReport.
+type FieldUsageReport struct {
+	FieldID string
+	// Contexts are the contexts of the field.
+	Contexts []FieldContextUsage
+	// Screens are the screens containing the field.
+	Screens []FieldScreenUsage
+	// ProjectIDs are the projects in which the field is shown on at least one screen and has a context.
+	// Restrictions of contexts to issue types are not taken into account.
+	ProjectIDs []string
+}
+
+// FieldContextUsage describes a context of a field and the projects it applies to.
+type FieldContextUsage struct {
+	Context FieldContext
+	// ProjectIDs are the projects the context applies to.
+	// It is empty for a global context, which applies to all projects.
+	ProjectIDs []string
+}
+
+// FieldScreenUsage describes a screen containing a field and where the screen is used.
+type FieldScreenUsage struct {
+	Screen        Screen
+	ScreenSchemes []ScreenSchemeUsage
+}
+
+// ScreenSchemeUsage describes a screen scheme using a screen.
+type ScreenSchemeUsage struct {
+	ScreenScheme ScreenScheme
+	// Operations are the operations the screen is used for, like "create" or "default".
+	Operations             []string
+	IssueTypeScreenSchemes []IssueTypeScreenSchemeUsage
+}
+
+// IssueTypeScreenSchemeUsage describes an issue type screen scheme using a screen scheme.
+type IssueTypeScreenSchemeUsage struct {
+	IssueTypeScreenScheme IssueTypeScreenScheme
+	// IssueTypeIDs are the issue types the screen scheme is used for.
+	// "default" stands for all issue types without an own screen scheme.
+	IssueTypeIDs []string
+	// ProjectIDs are the projects using the issue type screen scheme.
+	ProjectIDs []string
+}
+
+// GetUsageReport collects where a custom field (by ID, like "customfield_10000") is available and visible:
+// Its contexts with their projects and the screens, screen schemes, issue type screen schemes and projects showing the field.
+// This replicates the "Where is my field" diagnostic of the Jira administration.
+//
+// The report requires many requests, because all screen schemes and issue type screen schemes of the instance are inspected.
+// It requires the "Administer Jira" global permission.
+func (s *FieldService) GetUsageReport(ctx context.Context, fieldID string) (*FieldUsageReport, error) {
+	report := &FieldUsageReport{FieldID: fieldID}
+	escapedID := url.PathEscape(fieldID)
+
+	contexts, err := allPages[FieldContext](ctx, s.client, fmt.Sprintf("rest/api/3/field/%s/context", escapedID), agilePaging)
+	if err != nil {
+		return nil, err
+	}
+	mappings, err := allPages[struct {
+		ContextID string `json:"contextId"`
+		ProjectID string `json:"projectId"`
+	}](ctx, s.client, fmt.Sprintf("rest/api/3/field/%s/context/projectmapping", escapedID), agilePaging)
+	if err != nil {
+		return nil, err
+	}
+	global := false
+	contextProjects := map[string]bool{}
+	for _, c := range contexts {
+		usage := FieldContextUsage{Context: c}
+		for _, m := range mappings {
+			if m.ContextID == c.ID && m.ProjectID != "" {
+				usage.ProjectIDs = append(usage.ProjectIDs, m.ProjectID)
+				contextProjects[m.ProjectID] = true
+			}
+		}
+		global = global || c.IsGlobalContext
+		report.Contexts = append(report.Contexts, usage)
+	}
+
+	screens, err := allPages[Screen](ctx, s.client, fmt.Sprintf("rest/api/3/field/%s/screens", escapedID), agilePaging)
+	if err != nil {
+		return nil, err
+	}
+	if len(screens) == 0 {
+		return report, nil
+	}
+
+	screenSchemes, err := allPages[ScreenScheme](ctx, s.client, "rest/api/3/screenscheme", agilePaging)
+	if err != nil {
+		return nil, err
+	}
+	schemeMappings, err := allPages[struct {
+		IssueTypeScreenSchemeID string `json:"issueTypeScreenSchemeId"`
+		IssueTypeID             string `json:"issueTypeId"`
+		ScreenSchemeID          string `json:"screenSchemeId"`
+	}](ctx, s.client, "rest/api/3/issuetypescreenscheme/mapping", agilePaging)
+	if err != nil {
+		return nil, err
+	}
+	issueTypeScreenSchemes, err := allPages[IssueTypeScreenScheme](ctx, s.client, "rest/api/3/issuetypescreenscheme", agilePaging)
+	if err != nil {
+		return nil, err
+	}
+	schemeProjects := map[string][]string{}
+
+	visibleProjects := map[string]bool{}
+	for _, screen := range screens {
+		screenUsage := FieldScreenUsage{Screen: screen}
+		for _, screenScheme := range screenSchemes {
+			schemeUsage := ScreenSchemeUsage{ScreenScheme: screenScheme}
+			for operation, screenID := range screenScheme.Screens {
+				if screenID == screen.ID {
+					schemeUsage.Operations = append(schemeUsage.Operations, operation)
+				}
+			}
+			if len(schemeUsage.Operations) == 0 {
+				continue
+			}
+			sort.Strings(schemeUsage.Operations)
+
+			screenSchemeID := strconv.FormatInt(screenScheme.ID, 10)
+			for _, issueTypeScreenScheme := range issueTypeScreenSchemes {
+				usage := IssueTypeScreenSchemeUsage{IssueTypeScreenScheme: issueTypeScreenScheme}
+				for _, m := range schemeMappings {
+					if m.IssueTypeScreenSchemeID == issueTypeScreenScheme.ID && m.ScreenSchemeID == screenSchemeID {
+						usage.IssueTypeIDs = append(usage.IssueTypeIDs, m.IssueTypeID)
+					}
+				}
+				if len(usage.IssueTypeIDs) == 0 {
+					continue
+				}
+
+				projectIDs, found := schemeProjects[issueTypeScreenScheme.ID]
+				if !found {
+					projects, err := allPages[Project](ctx, s.client, fmt.Sprintf("rest/api/3/issuetypescreenscheme/%s/project", url.PathEscape(issueTypeScreenScheme.ID)), agilePaging)
+					if err != nil {
+						return nil, err
+					}
+					for _, p := range projects {
+						projectIDs = append(projectIDs, p.ID)
+					}
+					schemeProjects[issueTypeScreenScheme.ID] = projectIDs
+				}
+				usage.ProjectIDs = projectIDs
+				for _, id := range projectIDs {
+					if global || contextProjects[id] {
+						visibleProjects[id] = true
+					}
+				}
+				schemeUsage.IssueTypeScreenSchemes = append(schemeUsage.IssueTypeScreenSchemes, usage)
+			}
+			screenUsage.ScreenSchemes = append(screenUsage.ScreenSchemes, schemeUsage)
+		}
+		report.Screens = append(report.Screens, screenUsage)
+	}
+
+	for id := range visibleProjects {
+		report.ProjectIDs = append(report.ProjectIDs, id)
+	}
+	sort.Strings(report.ProjectIDs)
+
+	return report, nil
+}
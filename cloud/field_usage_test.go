@@ -0,0 +1,64 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestFieldService_GetUsageReport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	responses := map[string]string{
+		"/rest/api/3/field/customfield_10000/context": `{"startAt":0,"maxResults":50,"total":1,"isLast":true,"values":[
+			{"id":"10025","name":"Bug fields context","isGlobalContext":false,"isAnyIssueType":true}]}`,
+		"/rest/api/3/field/customfield_10000/context/projectmapping": `{"startAt":0,"maxResults":50,"total":2,"isLast":true,"values":[
+			{"contextId":"10025","projectId":"10001"},{"contextId":"10025","projectId":"10002"}]}`,
+		"/rest/api/3/field/customfield_10000/screens": `{"startAt":0,"maxResults":50,"total":1,"isLast":true,"values":[
+			{"id":10001,"name":"Default Screen","tab":{"id":10000,"name":"Field Tab"}}]}`,
+		"/rest/api/3/screenscheme": `{"startAt":0,"maxResults":50,"total":2,"isLast":true,"values":[
+			{"id":10010,"name":"Employee screen scheme","screens":{"default":10017,"create":10001}},
+			{"id":10011,"name":"Other screen scheme","screens":{"default":10018}}]}`,
+		"/rest/api/3/issuetypescreenscheme/mapping": `{"startAt":0,"maxResults":50,"total":2,"isLast":true,"values":[
+			{"issueTypeScreenSchemeId":"10020","issueTypeId":"10000","screenSchemeId":"10010"},
+			{"issueTypeScreenSchemeId":"10020","issueTypeId":"default","screenSchemeId":"10011"}]}`,
+		"/rest/api/3/issuetypescreenscheme": `{"startAt":0,"maxResults":50,"total":1,"isLast":true,"values":[
+			{"id":"10020","name":"Scrum issue type screen scheme"}]}`,
+		"/rest/api/3/issuetypescreenscheme/10020/project": `{"startAt":0,"maxResults":50,"total":2,"isLast":true,"values":[
+			{"id":"10001","key":"EX"},{"id":"10003","key":"ABC"}]}`,
+	}
+	for path, body := range responses {
+		path, body := path, body
+		testMux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testRequestURL(t, r, path)
+			fmt.Fprint(w, body)
+		})
+	}
+
+	report, err := testClient.Field.GetUsageReport(context.Background(), "customfield_10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if len(report.Contexts) != 1 || !reflect.DeepEqual(report.Contexts[0].ProjectIDs, []string{"10001", "10002"}) {
+		t.Errorf("Expected a context for projects 10001 and 10002. Got %+v", report.Contexts)
+	}
+	if len(report.Screens) != 1 || len(report.Screens[0].ScreenSchemes) != 1 {
+		t.Fatalf("Expected the screen to be used by one screen scheme. Got %+v", report.Screens)
+	}
+	schemeUsage := report.Screens[0].ScreenSchemes[0]
+	if schemeUsage.ScreenScheme.ID != 10010 || !reflect.DeepEqual(schemeUsage.Operations, []string{"create"}) {
+		t.Errorf("Expected usage for create in screen scheme 10010. Got %+v", schemeUsage)
+	}
+	if len(schemeUsage.IssueTypeScreenSchemes) != 1 || !reflect.DeepEqual(schemeUsage.IssueTypeScreenSchemes[0].IssueTypeIDs, []string{"10000"}) {
+		t.Errorf("Expected usage for issue type 10000. Got %+v", schemeUsage.IssueTypeScreenSchemes)
+	}
+	// Project 10003 shows the screen, but the field has no context there
+	if !reflect.DeepEqual(report.ProjectIDs, []string{"10001"}) {
+		t.Errorf("Expected the field to be visible in project 10001. Got %v", report.ProjectIDs)
+	}
+}
@@ -0,0 +1,258 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// FieldConfigurationService handles field configurations and field configuration schemes
+// of company-managed projects for the Jira instance / API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/
+type FieldConfigurationService service
+
+// FieldConfiguration defines whether the fields of an issue are required or hidden, and how they are rendered.
+type FieldConfiguration struct {
+	ID          int64  `json:"id" structs:"id"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	IsDefault   bool   `json:"isDefault,omitempty" structs:"isDefault,omitempty"`
+}
+
+// FieldConfigurationItem is the behavior of a single field in a FieldConfiguration.
+// When updating, IsHidden and IsRequired are always set, an empty Description or Renderer is left unchanged.
+type FieldConfigurationItem struct {
+	// ID is the ID of the field, like "customfield_10010".
+	ID          string `json:"id" structs:"id"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	IsHidden    bool   `json:"isHidden" structs:"isHidden"`
+	IsRequired  bool   `json:"isRequired" structs:"isRequired"`
+	// Renderer is "wiki-renderer" or "text-renderer" for text fields,
+	// "frother-control-renderer" or "select-renderer" for multi-select fields.
+	Renderer string `json:"renderer,omitempty" structs:"renderer,omitempty"`
+}
+
+// FieldConfigurationScheme maps issue types to field configurations.
+type FieldConfigurationScheme struct {
+	ID          string `json:"id" structs:"id"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// FieldConfigurationMapping maps an issue type to a field configuration within a FieldConfigurationScheme.
+type FieldConfigurationMapping struct {
+	// FieldConfigurationSchemeID is only returned by FieldConfigurationService.GetSchemeMappings.
+	FieldConfigurationSchemeID string `json:"fieldConfigurationSchemeId,omitempty" structs:"fieldConfigurationSchemeId,omitempty"`
+	// IssueTypeID is the ID of the issue type, or "default" for all issue types without a mapping.
+	IssueTypeID          string `json:"issueTypeId" structs:"issueTypeId"`
+	FieldConfigurationID string `json:"fieldConfigurationId" structs:"fieldConfigurationId"`
+}
+
+// FieldConfigurationListOptions specifies the optional parameters for FieldConfigurationService.GetList.
+type FieldConfigurationListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// IDs restricts the results to the field configurations with these IDs.
+	IDs []int64 `url:"id,omitempty"`
+	// IsDefault restricts the results to the default field configuration.
+	IsDefault bool `url:"isDefault,omitempty"`
+	// Query restricts the results to field configurations with a name or description containing this string.
+	Query string `url:"query,omitempty"`
+}
+
+// GetList returns one page of the field configurations matching the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/#api-rest-api-2-fieldconfiguration-get
+func (s *FieldConfigurationService) GetList(ctx context.Context, options *FieldConfigurationListOptions) (*PagedList[FieldConfiguration], *Response, error) {
+	u, err := addOptions("rest/api/2/fieldconfiguration", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[FieldConfiguration](ctx, s.client, u, agilePaging)
+}
+
+// Create creates a field configuration, based on the default field configuration.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/#api-rest-api-2-fieldconfiguration-post
+func (s *FieldConfigurationService) Create(ctx context.Context, name, description string) (*FieldConfiguration, *Response, error) {
+	body := struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+	}{name, description}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "rest/api/2/fieldconfiguration", &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration := new(FieldConfiguration)
+	resp, err := s.client.Do(req, configuration)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return configuration, resp, nil
+}
+
+// Update changes the name and description of the field configuration id.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/#api-rest-api-2-fieldconfiguration-id-put
+func (s *FieldConfigurationService) Update(ctx context.Context, id int64, name, description string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/fieldconfiguration/%d", id)
+	body := struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+	}{name, description}
+	return s.send(ctx, http.MethodPut, apiEndpoint, &body)
+}
+
+// Delete deletes the field configuration id.
+// The default field configuration and field configurations used by a scheme can't be deleted.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/#api-rest-api-2-fieldconfiguration-id-delete
+func (s *FieldConfigurationService) Delete(ctx context.Context, id int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/fieldconfiguration/%d", id)
+	return s.send(ctx, http.MethodDelete, apiEndpoint, nil)
+}
+
+// FieldConfigurationItemOptions specifies the optional parameters for FieldConfigurationService.GetItems.
+type FieldConfigurationItemOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// GetItems returns one page of the fields of the field configuration id, with their behavior.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/#api-rest-api-2-fieldconfiguration-id-fields-get
+func (s *FieldConfigurationService) GetItems(ctx context.Context, id int64, options *FieldConfigurationItemOptions) (*PagedList[FieldConfigurationItem], *Response, error) {
+	u, err := addOptions(fmt.Sprintf("rest/api/2/fieldconfiguration/%d/fields", id), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[FieldConfigurationItem](ctx, s.client, u, agilePaging)
+}
+
+// UpdateItems changes the behavior of the fields items in the field configuration id.
+// Fields not in items are left unchanged.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/#api-rest-api-2-fieldconfiguration-id-fields-put
+func (s *FieldConfigurationService) UpdateItems(ctx context.Context, id int64, items []FieldConfigurationItem) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/fieldconfiguration/%d/fields", id)
+	body := struct {
+		FieldConfigurationItems []FieldConfigurationItem `json:"fieldConfigurationItems"`
+	}{items}
+	return s.send(ctx, http.MethodPut, apiEndpoint, &body)
+}
+
+// FieldConfigurationSchemeListOptions specifies the optional parameters for FieldConfigurationService.GetSchemes.
+type FieldConfigurationSchemeListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// IDs restricts the results to the field configuration schemes with these IDs.
+	IDs []int64 `url:"id,omitempty"`
+}
+
+// GetSchemes returns one page of the field configuration schemes matching the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/#api-rest-api-2-fieldconfigurationscheme-get
+func (s *FieldConfigurationService) GetSchemes(ctx context.Context, options *FieldConfigurationSchemeListOptions) (*PagedList[FieldConfigurationScheme], *Response, error) {
+	u, err := addOptions("rest/api/2/fieldconfigurationscheme", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[FieldConfigurationScheme](ctx, s.client, u, agilePaging)
+}
+
+// CreateScheme creates a field configuration scheme without mappings.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/#api-rest-api-2-fieldconfigurationscheme-post
+func (s *FieldConfigurationService) CreateScheme(ctx context.Context, name, description string) (*FieldConfigurationScheme, *Response, error) {
+	body := struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+	}{name, description}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "rest/api/2/fieldconfigurationscheme", &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(FieldConfigurationScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// UpdateScheme changes the name and description of the field configuration scheme schemeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/#api-rest-api-2-fieldconfigurationscheme-id-put
+func (s *FieldConfigurationService) UpdateScheme(ctx context.Context, schemeID, name, description string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/fieldconfigurationscheme/%s", url.PathEscape(schemeID))
+	body := struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+	}{name, description}
+	return s.send(ctx, http.MethodPut, apiEndpoint, &body)
+}
+
+// DeleteScheme deletes the field configuration scheme schemeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/#api-rest-api-2-fieldconfigurationscheme-id-delete
+func (s *FieldConfigurationService) DeleteScheme(ctx context.Context, schemeID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/fieldconfigurationscheme/%s", url.PathEscape(schemeID))
+	return s.send(ctx, http.MethodDelete, apiEndpoint, nil)
+}
+
+// FieldConfigurationMappingOptions specifies the optional parameters for FieldConfigurationService.GetSchemeMappings.
+type FieldConfigurationMappingOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// SchemeIDs restricts the results to the mappings of these field configuration schemes.
+	SchemeIDs []int64 `url:"fieldConfigurationSchemeId,omitempty"`
+}
+
+// GetSchemeMappings returns one page of the issue type to field configuration mappings of the schemes of the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/#api-rest-api-2-fieldconfigurationscheme-mapping-get
+func (s *FieldConfigurationService) GetSchemeMappings(ctx context.Context, options *FieldConfigurationMappingOptions) (*PagedList[FieldConfigurationMapping], *Response, error) {
+	u, err := addOptions("rest/api/2/fieldconfigurationscheme/mapping", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[FieldConfigurationMapping](ctx, s.client, u, agilePaging)
+}
+
+// SetSchemeMappings adds the mappings to the field configuration scheme schemeID,
+// replacing existing mappings of the same issue types.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/#api-rest-api-2-fieldconfigurationscheme-id-mapping-put
+func (s *FieldConfigurationService) SetSchemeMappings(ctx context.Context, schemeID string, mappings []FieldConfigurationMapping) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/fieldconfigurationscheme/%s/mapping", url.PathEscape(schemeID))
+	body := struct {
+		Mappings []FieldConfigurationMapping `json:"mappings"`
+	}{mappings}
+	return s.send(ctx, http.MethodPut, apiEndpoint, &body)
+}
+
+// send sends a request with the optional body to apiEndpoint, expecting no response body.
+func (s *FieldConfigurationService) send(ctx context.Context, method, apiEndpoint string, body interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,182 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestFieldConfigurationService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/fieldconfiguration"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?id=10000&id=10001")
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":2,"isLast":true,"values":[{"id":10000,"name":"Default Field Configuration","description":"The default field configuration description","isDefault":true},{"id":10001,"name":"My Field Configuration","description":"My field configuration description"}]}`)
+	})
+
+	page, _, err := testClient.FieldConfiguration.GetList(context.Background(), &FieldConfigurationListOptions{IDs: []int64{10000, 10001}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 2 || !page.Values[0].IsDefault || page.Values[1].ID != 10001 {
+		t.Errorf("Unexpected field configurations %+v", page.Values)
+	}
+}
+
+func TestFieldConfigurationService_CreateUpdateDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/fieldconfiguration", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"name":"My Field Configuration","description":"My field configuration description"}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		fmt.Fprint(w, `{"id":10001,"name":"My Field Configuration","description":"My field configuration description"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/fieldconfiguration/10001", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"name":"Renamed"}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+		case http.MethodDelete:
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	configuration, _, err := testClient.FieldConfiguration.Create(context.Background(), "My Field Configuration", "My field configuration description")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if configuration.ID != 10001 {
+		t.Errorf("Expected field configuration 10001, got %d", configuration.ID)
+	}
+	if _, err := testClient.FieldConfiguration.Update(context.Background(), configuration.ID, "Renamed", ""); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.FieldConfiguration.Delete(context.Background(), configuration.ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFieldConfigurationService_Items(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/fieldconfiguration/10000/fields"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, testapiEndpoint)
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":2,"isLast":true,"values":[{"id":"environment","description":"For example operating system, software platform and/or hardware specifications (include as appropriate for the issue).","isHidden":false,"isRequired":false},{"id":"description","isHidden":false,"isRequired":false,"renderer":"wiki-renderer"}]}`)
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			want := `{"fieldConfigurationItems":[{"id":"environment","isHidden":true,"isRequired":false},{"id":"description","isHidden":false,"isRequired":true,"renderer":"text-renderer"}]}` + "\n"
+			if got := string(body); got != want {
+				t.Errorf("Unexpected body\ngot:  %s\nwant: %s", got, want)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	page, _, err := testClient.FieldConfiguration.GetItems(context.Background(), 10000, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 2 || page.Values[1].Renderer != "wiki-renderer" {
+		t.Errorf("Unexpected field configuration items %+v", page.Values)
+	}
+
+	_, err = testClient.FieldConfiguration.UpdateItems(context.Background(), 10000, []FieldConfigurationItem{
+		{ID: "environment", IsHidden: true},
+		{ID: "description", IsRequired: true, Renderer: "text-renderer"},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFieldConfigurationService_Schemes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/fieldconfigurationscheme", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			testRequestURL(t, r, "/rest/api/2/fieldconfigurationscheme?id=10000")
+			fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":1,"isLast":true,"values":[{"id":"10000","name":"Field Configuration Scheme for Bugs","description":"This field configuration scheme is for bugs only."}]}`)
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"name":"Scheme for software"}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+			fmt.Fprint(w, `{"id":"10002","name":"Scheme for software"}`)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/fieldconfigurationscheme/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	page, _, err := testClient.FieldConfiguration.GetSchemes(context.Background(), &FieldConfigurationSchemeListOptions{IDs: []int64{10000}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 1 || page.Values[0].ID != "10000" {
+		t.Errorf("Unexpected field configuration schemes %+v", page.Values)
+	}
+
+	scheme, _, err := testClient.FieldConfiguration.CreateScheme(context.Background(), "Scheme for software", "")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, err := testClient.FieldConfiguration.DeleteScheme(context.Background(), scheme.ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFieldConfigurationService_SchemeMappings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/fieldconfigurationscheme/mapping", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/fieldconfigurationscheme/mapping?fieldConfigurationSchemeId=10020")
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":2,"isLast":true,"values":[{"fieldConfigurationSchemeId":"10020","issueTypeId":"10000","fieldConfigurationId":"10010"},{"fieldConfigurationSchemeId":"10020","issueTypeId":"default","fieldConfigurationId":"10000"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/fieldconfigurationscheme/10020/mapping", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"mappings":[{"issueTypeId":"10001","fieldConfigurationId":"10011"}]}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	page, _, err := testClient.FieldConfiguration.GetSchemeMappings(context.Background(), &FieldConfigurationMappingOptions{SchemeIDs: []int64{10020}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 2 || page.Values[1].IssueTypeID != "default" {
+		t.Errorf("Unexpected mappings %+v", page.Values)
+	}
+
+	_, err = testClient.FieldConfiguration.SetSchemeMappings(context.Background(), "10020", []FieldConfigurationMapping{{IssueTypeID: "10001", FieldConfigurationID: "10011"}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -23,10 +23,11 @@ type groupMembersResult struct {
 
 // Group represents a Jira group
 type Group struct {
-	Name   string       `json:"name,omitempty" structs:"name,omitempty"`
-	Self   string       `json:"self,omitempty" structs:"self,omitempty"`
-	Users  GroupMembers `json:"users,omitempty" structs:"users,omitempty"`
-	Expand string       `json:"expand,omitempty" structs:"expand,omitempty"`
+	Name    string       `json:"name,omitempty" structs:"name,omitempty"`
+	GroupID string       `json:"groupId,omitempty" structs:"groupId,omitempty"`
+	Self    string       `json:"self,omitempty" structs:"self,omitempty"`
+	Users   GroupMembers `json:"users,omitempty" structs:"users,omitempty"`
+	Expand  string       `json:"expand,omitempty" structs:"expand,omitempty"`
 }
 
 // GroupMembers represent members in a Jira group
@@ -95,14 +96,127 @@ func (s *GroupService) Get(ctx context.Context, name string, options *GroupSearc
 	return group.Members, resp, nil
 }
 
-// Add adds a user to a group.
+// AddUserByGroupName adds a user to the group groupName.
 //
 // The account ID of the user, which uniquely identifies the user across all Atlassian products.
 // For example, 5b10ac8d82e05b22cc7d4ef5.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-groups/#api-rest-api-3-group-user-post
 func (s *GroupService) AddUserByGroupName(ctx context.Context, groupName string, accountID string) (*Group, *Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/3/group/user?groupname=%s", groupName)
+	return s.addUser(ctx, url.Values{"groupname": {groupName}}, accountID)
+}
+
+// AddUserByGroupID adds a user to the group groupID.
+// Unlike group names, group IDs don't change when a group is renamed.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-groups/#api-rest-api-3-group-user-post
+func (s *GroupService) AddUserByGroupID(ctx context.Context, groupID string, accountID string) (*Group, *Response, error) {
+	return s.addUser(ctx, url.Values{"groupId": {groupID}}, accountID)
+}
+
+// RemoveUserByGroupName removes a user from the group groupName.
+//
+// The account ID of the user, which uniquely identifies the user across all Atlassian products.
+// For example, 5b10ac8d82e05b22cc7d4ef5.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-groups/#api-rest-api-3-group-user-delete
+// Caller must close resp.Body
+func (s *GroupService) RemoveUserByGroupName(ctx context.Context, groupName string, accountID string) (*Response, error) {
+	return s.removeUser(ctx, url.Values{"groupname": {groupName}}, accountID)
+}
+
+// RemoveUserByGroupID removes a user from the group groupID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-groups/#api-rest-api-3-group-user-delete
+func (s *GroupService) RemoveUserByGroupID(ctx context.Context, groupID string, accountID string) (*Response, error) {
+	return s.removeUser(ctx, url.Values{"groupId": {groupID}}, accountID)
+}
+
+// Create creates the group name and returns it.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-groups/#api-rest-api-3-group-post
+func (s *GroupService) Create(ctx context.Context, name string) (*Group, *Response, error) {
+	body := struct {
+		Name string `json:"name"`
+	}{name}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "/rest/api/3/group", &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(Group)
+	resp, err := s.client.Do(req, group)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return group, resp, nil
+}
+
+// DeleteByGroupName deletes the group groupName.
+// If swapGroupName is not empty, comments and worklogs restricted to the deleted group are restricted to the swap group instead.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-groups/#api-rest-api-3-group-delete
+func (s *GroupService) DeleteByGroupName(ctx context.Context, groupName, swapGroupName string) (*Response, error) {
+	query := url.Values{"groupname": {groupName}}
+	if swapGroupName != "" {
+		query.Set("swapGroup", swapGroupName)
+	}
+	return s.delete(ctx, query)
+}
+
+// DeleteByGroupID deletes the group groupID.
+// If swapGroupID is not empty, comments and worklogs restricted to the deleted group are restricted to the swap group instead.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-groups/#api-rest-api-3-group-delete
+func (s *GroupService) DeleteByGroupID(ctx context.Context, groupID, swapGroupID string) (*Response, error) {
+	query := url.Values{"groupId": {groupID}}
+	if swapGroupID != "" {
+		query.Set("swapGroupId", swapGroupID)
+	}
+	return s.delete(ctx, query)
+}
+
+func (s *GroupService) delete(ctx context.Context, query url.Values) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, "/rest/api/3/group?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GroupBulkOptions specifies the optional parameters for GroupService.GetBulk.
+// Without group IDs and names, all groups are returned.
+type GroupBulkOptions struct {
+	StartAt    int      `url:"startAt,omitempty"`
+	MaxResults int      `url:"maxResults,omitempty"`
+	GroupIDs   []string `url:"groupId,omitempty"`
+	GroupNames []string `url:"groupName,omitempty"`
+	// AccessType restricts the result to groups with the access to ApplicationKey, like "site-admin", "admin" or "user".
+	AccessType     string `url:"accessType,omitempty"`
+	ApplicationKey string `url:"applicationKey,omitempty"`
+}
+
+// GetBulk returns one page of the groups matching the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-groups/#api-rest-api-3-group-bulk-get
+func (s *GroupService) GetBulk(ctx context.Context, options *GroupBulkOptions) (*PagedList[Group], *Response, error) {
+	u, err := addOptions("/rest/api/3/group/bulk", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[Group](ctx, s.client, u, agilePaging)
+}
+
+// addUser adds the user accountID to the group identified by query.
+func (s *GroupService) addUser(ctx context.Context, query url.Values, accountID string) (*Group, *Response, error) {
+	apiEndpoint := "/rest/api/3/group/user?" + query.Encode()
 	var user struct {
 		AccountID string `json:"accountId"`
 	}
@@ -122,15 +236,9 @@ func (s *GroupService) AddUserByGroupName(ctx context.Context, groupName string,
 	return responseGroup, resp, nil
 }
 
-// Remove removes a user from a group.
-//
-// The account ID of the user, which uniquely identifies the user across all Atlassian products.
-// For example, 5b10ac8d82e05b22cc7d4ef5.
-//
-// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-groups/#api-rest-api-3-group-user-delete
-// Caller must close resp.Body
-func (s *GroupService) RemoveUserByGroupName(ctx context.Context, groupName string, accountID string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/3/group/user?groupname=%s&accountId=%s", groupName, accountID)
+// removeUser removes the user accountID from the group identified by query.
+func (s *GroupService) removeUser(ctx context.Context, query url.Values, accountID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/3/group/user?%s&accountId=%s", query.Encode(), url.QueryEscape(accountID))
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
 	if err != nil {
 		return nil, err
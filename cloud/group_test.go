@@ -3,6 +3,7 @@ package cloud
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 )
@@ -95,3 +96,130 @@ func TestGroupService_Remove(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestGroupService_AddUserByGroupID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/group/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, "/rest/api/3/group/user?groupId=276f955c-63d7-42c8-9520-92d01dca0625")
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"accountId":"5b10ac8d82e05b22cc7d4ef5"}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"jira-developers","groupId":"276f955c-63d7-42c8-9520-92d01dca0625","self":"https://your-domain.atlassian.net/rest/api/3/group?groupId=276f955c-63d7-42c8-9520-92d01dca0625"}`)
+	})
+
+	group, _, err := testClient.Group.AddUserByGroupID(context.Background(), "276f955c-63d7-42c8-9520-92d01dca0625", "5b10ac8d82e05b22cc7d4ef5")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if group.Name != "jira-developers" || group.GroupID != "276f955c-63d7-42c8-9520-92d01dca0625" {
+		t.Errorf("Unexpected group %+v", group)
+	}
+}
+
+func TestGroupService_RemoveUserByGroupID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/group/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/api/3/group/user?groupId=276f955c-63d7-42c8-9520-92d01dca0625&accountId=5b10ac8d82e05b22cc7d4ef5")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if _, err := testClient.Group.RemoveUserByGroupID(context.Background(), "276f955c-63d7-42c8-9520-92d01dca0625", "5b10ac8d82e05b22cc7d4ef5"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestGroupService_RemoveUserByGroupName_Escaping(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/group/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/api/3/group/user?groupname=R%26D+team&accountId=5b10ac8d82e05b22cc7d4ef5")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if _, err := testClient.Group.RemoveUserByGroupName(context.Background(), "R&D team", "5b10ac8d82e05b22cc7d4ef5"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestGroupService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/group", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"name":"power-users"}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"power-users","groupId":"276f955c-63d7-42c8-9520-92d01dca0625","self":"https://your-domain.atlassian.net/rest/api/3/group?groupId=276f955c-63d7-42c8-9520-92d01dca0625"}`)
+	})
+
+	group, _, err := testClient.Group.Create(context.Background(), "power-users")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if group.GroupID != "276f955c-63d7-42c8-9520-92d01dca0625" {
+		t.Errorf("Unexpected group %+v", group)
+	}
+}
+
+func TestGroupService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var want string
+	testMux.HandleFunc("/rest/api/3/group", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, want)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	want = "/rest/api/3/group?groupname=power-users&swapGroup=jira-users"
+	if _, err := testClient.Group.DeleteByGroupName(context.Background(), "power-users", "jira-users"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	want = "/rest/api/3/group?groupId=276f955c-63d7-42c8-9520-92d01dca0625"
+	if _, err := testClient.Group.DeleteByGroupID(context.Background(), "276f955c-63d7-42c8-9520-92d01dca0625", ""); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestGroupService_GetBulk(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/group/bulk", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			testRequestURL(t, r, "/rest/api/3/group/bulk?groupName=jira-users&groupName=power-users&maxResults=1")
+			fmt.Fprint(w, `{"isLast":false,"maxResults":1,"startAt":0,"total":2,"values":[{"name":"jira-users","groupId":"1d6d3bd1-0c64-4a38-9e9a-5efd16eaed1b"}]}`)
+		case "1":
+			fmt.Fprint(w, `{"isLast":true,"maxResults":1,"startAt":1,"total":2,"values":[{"name":"power-users","groupId":"276f955c-63d7-42c8-9520-92d01dca0625"}]}`)
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+
+	page, _, err := testClient.Group.GetBulk(context.Background(), &GroupBulkOptions{GroupNames: []string{"jira-users", "power-users"}, MaxResults: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 1 || page.Values[0].GroupID != "1d6d3bd1-0c64-4a38-9e9a-5efd16eaed1b" {
+		t.Fatalf("Unexpected groups %+v", page.Values)
+	}
+	page, _, err = page.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !page.IsLast || page.Values[0].Name != "power-users" {
+		t.Errorf("Unexpected groups %+v", page.Values)
+	}
+}
@@ -0,0 +1,117 @@
+package cloud
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestIssueBuilder_Build(t *testing.T) {
+	issue, err := NewIssueBuilder("PROJ", "Bug").
+		Summary("Login fails").
+		Description("Steps to reproduce").
+		Label("backend", "auth").
+		Component("API").
+		Priority("High").
+		Assignee("5b10ac8d82e05b22cc7d4ef5").
+		CustomField("customfield_10016", 5).
+		Build()
+	if err != nil {
+		t.Fatalf("Expected nil error. Got %s", err)
+	}
+
+	f := issue.Fields
+	if f.Project.Key != "PROJ" {
+		t.Errorf("Expected project key PROJ. Got %s", f.Project.Key)
+	}
+	if f.Type.Name != "Bug" {
+		t.Errorf("Expected issue type Bug. Got %s", f.Type.Name)
+	}
+	if f.Summary != "Login fails" || f.Description != "Steps to reproduce" {
+		t.Errorf("Unexpected summary or description: %q, %q", f.Summary, f.Description)
+	}
+	if len(f.Labels) != 2 {
+		t.Errorf("Expected 2 labels. Got %+v", f.Labels)
+	}
+	if len(f.Components) != 1 || f.Components[0].Name != "API" {
+		t.Errorf("Expected component API. Got %+v", f.Components)
+	}
+	if f.Priority == nil || f.Priority.Name != "High" {
+		t.Errorf("Expected priority High. Got %+v", f.Priority)
+	}
+	if f.Assignee == nil || f.Assignee.AccountID != "5b10ac8d82e05b22cc7d4ef5" {
+		t.Errorf("Expected assignee. Got %+v", f.Assignee)
+	}
+	if v, ok := f.Unknowns["customfield_10016"]; !ok || v != 5 {
+		t.Errorf("Expected customfield_10016 = 5. Got %+v", f.Unknowns)
+	}
+}
+
+func TestIssueBuilder_Build_ResolvesFieldNames(t *testing.T) {
+	m := new(MetaIssueType)
+	m.Fields = map[string]interface{}{
+		"customfield_10016": map[string]interface{}{
+			"required": false,
+			"name":     "Story Points",
+		},
+	}
+
+	issue, err := NewIssueBuilder("PROJ", "Story").
+		Summary("New feature").
+		CustomField("Story Points", 5).
+		Meta(m).
+		Build()
+	if err != nil {
+		t.Fatalf("Expected nil error. Got %s", err)
+	}
+	if v, ok := issue.Fields.Unknowns["customfield_10016"]; !ok || v != 5 {
+		t.Errorf("Expected customfield_10016 = 5. Got %+v", issue.Fields.Unknowns)
+	}
+}
+
+func TestIssueBuilder_Build_UnknownFieldName(t *testing.T) {
+	_, err := NewIssueBuilder("PROJ", "Story").
+		Summary("New feature").
+		CustomField("Story Points", 5).
+		Build()
+	if err == nil || !strings.Contains(err.Error(), "Meta") {
+		t.Errorf("Expected an error asking for the create metadata. Got %v", err)
+	}
+}
+
+func TestIssueBuilder_Build_ADF(t *testing.T) {
+	issue, err := NewIssueBuilder("PROJ", "Bug").
+		Summary("Login fails").
+		Description("Steps to reproduce\n\nExpected result").
+		ADF().
+		Build()
+	if err != nil {
+		t.Fatalf("Expected nil error. Got %s", err)
+	}
+
+	data, err := json.Marshal(issue.Fields)
+	if err != nil {
+		t.Fatalf("Expected nil error. Got %s", err)
+	}
+	var fields map[string]interface{}
+	_ = json.Unmarshal(data, &fields)
+	doc, ok := fields["description"].(map[string]interface{})
+	if !ok || doc["type"] != ADFTypeDoc || len(doc["content"].([]interface{})) != 2 {
+		t.Errorf("Expected an ADF description with two paragraphs. Got %s", data)
+	}
+
+	issue, _ = NewIssueBuilder("PROJ", "Bug").
+		Summary("Login fails").
+		DescriptionADF(ADFDoc(ADFParagraph(ADFText("Formatted", ADFStrong())))).
+		Build()
+	if doc, ok := issue.Fields.Unknowns["description"].(*ADFNode); !ok || doc.PlainText() != "Formatted" {
+		t.Errorf("Expected the ADF description. Got %+v", issue.Fields.Unknowns)
+	}
+}
+
+func TestIssueBuilder_Build_MissingSummary(t *testing.T) {
+	_, err := NewIssueBuilder("PROJ", "Bug").Build()
+	if err == nil {
+		t.Error("Expected an error for a missing summary. Got none")
+	}
+}
@@ -0,0 +1,79 @@
+package cloud
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// FieldChange describes the change of a single issue field between two snapshots of an issue.
+type FieldChange struct {
+	// Field is the field ID, like "summary" or "customfield_10016".
+	Field string
+	// From is the value in the first snapshot, nil if the field was not set.
+	From interface{}
+	// To is the value in the second snapshot, nil if the field is not set anymore.
+	To interface{}
+}
+
+// DiffIssues compares the fields of two snapshots of an issue, including custom fields.
+// Values are compared in their JSON representation, so a and b can be the result of
+// two independent API calls.
+// The changes are sorted by field ID.
+// A nil issue is treated like an issue without fields.
+func DiffIssues(a, b *Issue) ([]FieldChange, error) {
+	from, err := issueFieldValues(a)
+	if err != nil {
+		return nil, err
+	}
+	to, err := issueFieldValues(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []FieldChange
+	for field, fromValue := range from {
+		toValue, found := to[field]
+		if !found {
+			changes = append(changes, FieldChange{Field: field, From: fromValue})
+			continue
+		}
+		if !reflect.DeepEqual(fromValue, toValue) {
+			changes = append(changes, FieldChange{Field: field, From: fromValue, To: toValue})
+		}
+	}
+	for field, toValue := range to {
+		if _, found := from[field]; !found {
+			changes = append(changes, FieldChange{Field: field, To: toValue})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes, nil
+}
+
+// issueFieldValues returns the JSON representation of all fields of issue that are set.
+func issueFieldValues(issue *Issue) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if issue == nil || issue.Fields == nil {
+		return values, nil
+	}
+
+	data, err := json.Marshal(issue.Fields)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+
+	// Unset custom fields are returned as null by Jira
+	for field, value := range values {
+		if value == nil {
+			delete(values, field)
+		}
+	}
+	return values, nil
+}
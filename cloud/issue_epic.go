@@ -0,0 +1,48 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+)
+
+// EpicFields returns the fields that link an issue of the project projectKey to the epic epicKey.
+// Issues of team-managed projects reference their epic via the parent field.
+// Issues of company-managed projects reference it via the "Epic Link" custom field, as long as it exists.
+//
+// The fields can be set via IssueBuilder.CustomField on creation or via IssueService.UpdateIssue.
+func (s *IssueService) EpicFields(ctx context.Context, projectKey, epicKey string) (map[string]interface{}, error) {
+	project, _, err := s.client.Project.Get(ctx, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	if !project.IsTeamManaged() {
+		field, _, err := s.client.Field.GetEpicLinkField(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if field != nil {
+			return map[string]interface{}{field.ID: epicKey}, nil
+		}
+	}
+	return map[string]interface{}{"parent": map[string]interface{}{"key": epicKey}}, nil
+}
+
+// SetEpic links the issue issueKey to the epic epicKey,
+// using the mechanism of the project of the issue as described at IssueService.EpicFields.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-put
+// Caller must close resp.Body
+func (s *IssueService) SetEpic(ctx context.Context, issueKey, epicKey string) (*Response, error) {
+	issue, resp, err := s.Get(ctx, issueKey, &GetQueryOptions{Fields: "project"})
+	if err != nil {
+		return resp, err
+	}
+	if issue.Fields == nil {
+		return resp, fmt.Errorf("project of issue %s is unknown", issueKey)
+	}
+	fields, err := s.EpicFields(ctx, issue.Fields.Project.Key, epicKey)
+	if err != nil {
+		return nil, err
+	}
+	return s.UpdateIssue(ctx, issueKey, map[string]interface{}{"fields": fields})
+}
@@ -0,0 +1,78 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const epicTestFields = `[{"id":"summary","name":"Summary","schema":{"type":"string","system":"summary"}},
+	{"id":"customfield_10014","name":"Epic Link","custom":true,"schema":{"type":"any","custom":"com.pyxis.greenhopper.jira:gh-epic-link","customId":10014}}]`
+
+func TestIssueService_EpicFields(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/TEAM", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"key":"TEAM","style":"next-gen","simplified":true}`)
+	})
+	testMux.HandleFunc("/rest/api/2/project/CLASSIC", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"key":"CLASSIC","style":"classic","simplified":false}`)
+	})
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, epicTestFields)
+	})
+
+	fields, err := testClient.Issue.EpicFields(context.Background(), "TEAM", "TEAM-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := map[string]interface{}{"parent": map[string]interface{}{"key": "TEAM-1"}}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("Expected the parent field for a team-managed project, got %v", fields)
+	}
+
+	fields, err = testClient.Issue.EpicFields(context.Background(), "CLASSIC", "CLASSIC-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := map[string]interface{}{"customfield_10014": "CLASSIC-1"}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("Expected the epic link field for a company-managed project, got %v", fields)
+	}
+}
+
+func TestIssueService_SetEpic(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/CLASSIC-2", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			testRequestURL(t, r, "/rest/api/2/issue/CLASSIC-2?fields=project")
+			fmt.Fprint(w, `{"key":"CLASSIC-2","fields":{"project":{"key":"CLASSIC"}}}`)
+			return
+		}
+
+		testMethod(t, r, http.MethodPut)
+		var body map[string]map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if got := body["fields"]["customfield_10014"]; got != "CLASSIC-1" {
+			t.Errorf("Expected the epic link to be set, got %v", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/project/CLASSIC", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key":"CLASSIC","style":"classic"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, epicTestFields)
+	})
+
+	if _, err := testClient.Issue.SetEpic(context.Background(), "CLASSIC-2", "CLASSIC-1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,131 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/andygrunwald/go-jira/v2/jql"
+)
+
+// defaultIssueTreeFields are the fields returned for every issue of an IssueTree by default.
+var defaultIssueTreeFields = []string{"summary", "status", "issuetype", "parent"}
+
+// IssueTree is an issue together with its child issues,
+// like the sub-tasks of a story or the stories of an epic.
+type IssueTree struct {
+	Issue    Issue
+	Children []*IssueTree
+}
+
+// Walk calls f for the issue of the tree and all its descendants, depth-first.
+// depth is 0 for the root of the tree. Walk stops at the first error returned by f.
+func (t *IssueTree) Walk(f func(tree *IssueTree, depth int) error) error {
+	return t.walk(f, 0)
+}
+
+func (t *IssueTree) walk(f func(tree *IssueTree, depth int) error, depth int) error {
+	if err := f(t, depth); err != nil {
+		return err
+	}
+	for _, child := range t.Children {
+		if err := child.walk(f, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IssueTreeOptions specifies the optional parameters for IssueService.GetIssueTree.
+type IssueTreeOptions struct {
+	// MaxDepth limits the levels of children below the root. Zero means no limit.
+	MaxDepth int
+	// Fields is the list of fields to return for each issue.
+	// By default, summary, status, issuetype and parent are returned.
+	Fields []string
+}
+
+// CreateSubtask creates subtask as sub-task of the issue parentKey.
+// The issue type of subtask has to be a sub-task issue type.
+// subtask itself is not modified.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-post
+func (s *IssueService) CreateSubtask(ctx context.Context, parentKey string, subtask *Issue) (*Issue, *Response, error) {
+	if subtask == nil {
+		return nil, nil, errors.New("subtask must not be nil")
+	}
+	issue := *subtask
+	fields := IssueFields{}
+	if issue.Fields != nil {
+		fields = *issue.Fields
+	}
+	fields.Parent = &Parent{Key: parentKey}
+	issue.Fields = &fields
+	return s.Create(ctx, &issue)
+}
+
+// GetSubtasks returns all child issues of the issue parentKey.
+// For an epic, these are the issues of the epic, for any other issue its sub-tasks.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-jql-get
+func (s *IssueService) GetSubtasks(ctx context.Context, parentKey string) ([]Issue, error) {
+	return s.getChildren(ctx, parentKey, defaultIssueTreeFields)
+}
+
+// GetIssueTree returns the issue key with all its descendants,
+// following the parent field of the issues (epic → issue → sub-task).
+// The children of every issue are requested with one search each.
+func (s *IssueService) GetIssueTree(ctx context.Context, key string, options *IssueTreeOptions) (*IssueTree, error) {
+	opts := IssueTreeOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if len(opts.Fields) == 0 {
+		opts.Fields = defaultIssueTreeFields
+	}
+
+	root, _, err := s.Get(ctx, key, &GetQueryOptions{Fields: strings.Join(opts.Fields, ",")})
+	if err != nil {
+		return nil, err
+	}
+
+	tree := &IssueTree{Issue: *root}
+	visited := map[string]bool{root.Key: true}
+	if err := s.addChildren(ctx, tree, &opts, 1, visited); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// addChildren requests the children of tree.Issue and their descendants.
+// visited protects against cycles in the hierarchy.
+func (s *IssueService) addChildren(ctx context.Context, tree *IssueTree, opts *IssueTreeOptions, depth int, visited map[string]bool) error {
+	if opts.MaxDepth > 0 && depth > opts.MaxDepth {
+		return nil
+	}
+
+	children, err := s.getChildren(ctx, tree.Issue.Key, opts.Fields)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		if visited[child.Key] {
+			continue
+		}
+		visited[child.Key] = true
+
+		node := &IssueTree{Issue: child}
+		tree.Children = append(tree.Children, node)
+		if err := s.addChildren(ctx, node, opts, depth+1, visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getChildren returns all issues whose parent is the issue parentKey.
+func (s *IssueService) getChildren(ctx context.Context, parentKey string, fields []string) ([]Issue, error) {
+	query := fmt.Sprintf("parent = %s ORDER BY rank", jql.Format(parentKey))
+	return s.SearchJQLPager(query, &SearchJQLOptions{Fields: fields}).All(ctx)
+}
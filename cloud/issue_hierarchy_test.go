@@ -0,0 +1,106 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIssueService_CreateSubtask(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, "/rest/api/2/issue")
+
+		var issue Issue
+		if err := json.NewDecoder(r.Body).Decode(&issue); err != nil {
+			t.Fatal(err)
+		}
+		if issue.Fields.Parent == nil || issue.Fields.Parent.Key != "EX-1" || issue.Fields.Summary != "Write docs" {
+			t.Errorf("Unexpected sub-task %+v", issue.Fields)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10002","key":"EX-2","self":"https://example.atlassian.net/rest/api/2/issue/10002"}`)
+	})
+
+	subtask := &Issue{Fields: &IssueFields{Summary: "Write docs", Type: IssueType{Name: "Sub-task"}}}
+	issue, _, err := testClient.Issue.CreateSubtask(context.Background(), "EX-1", subtask)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-2" {
+		t.Errorf("Expected issue EX-2, got %s", issue.Key)
+	}
+	if subtask.Fields.Parent != nil {
+		t.Errorf("Expected the sub-task of the caller to be unchanged, got parent %+v", subtask.Fields.Parent)
+	}
+}
+
+func TestIssueService_GetIssueTree(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1?fields=summary%2Cstatus%2Cissuetype%2Cparent")
+		fmt.Fprint(w, `{"key":"EX-1","fields":{"summary":"Epic","issuetype":{"name":"Epic"}}}`)
+	})
+	children := map[string]string{
+		`parent = "EX-1" ORDER BY rank`: `{"issues":[{"key":"EX-2"},{"key":"EX-3"}],"isLast":true}`,
+		`parent = "EX-2" ORDER BY rank`: `{"issues":[{"key":"EX-4"}],"isLast":true}`,
+		`parent = "EX-3" ORDER BY rank`: `{"issues":[],"isLast":true}`,
+		`parent = "EX-4" ORDER BY rank`: `{"issues":[{"key":"EX-1"}],"isLast":true}`,
+	}
+	testMux.HandleFunc("/rest/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.URL.Query().Get("fields"); got != "summary,status,issuetype,parent" {
+			t.Errorf("Unexpected fields %s", got)
+		}
+		response, ok := children[r.URL.Query().Get("jql")]
+		if !ok {
+			t.Errorf("Unexpected JQL %s", r.URL.Query().Get("jql"))
+		}
+		fmt.Fprint(w, response)
+	})
+
+	tree, err := testClient.Issue.GetIssueTree(context.Background(), "EX-1", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	var got []string
+	_ = tree.Walk(func(node *IssueTree, depth int) error {
+		got = append(got, fmt.Sprintf("%d:%s", depth, node.Issue.Key))
+		return nil
+	})
+	if want := []string{"0:EX-1", "1:EX-2", "2:EX-4", "1:EX-3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected tree %v, want %v", got, want)
+	}
+}
+
+func TestIssueService_GetIssueTree_MaxDepth(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key":"EX-1"}`)
+	})
+	searches := 0
+	testMux.HandleFunc("/rest/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		searches++
+		fmt.Fprint(w, `{"issues":[{"key":"EX-2"}],"isLast":true}`)
+	})
+
+	tree, err := testClient.Issue.GetIssueTree(context.Background(), "EX-1", &IssueTreeOptions{MaxDepth: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(tree.Children) != 1 || len(tree.Children[0].Children) != 0 {
+		t.Errorf("Expected one level of children, got %+v", tree.Children)
+	}
+	if searches != 1 {
+		t.Errorf("Expected 1 search, got %d", searches)
+	}
+}
@@ -0,0 +1,71 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+)
+
+// IssuePickerOptions specifies the optional parameters for IssueService.GetPickerSuggestions.
+type IssuePickerOptions struct {
+	// Query is the text to match the keys and summaries of issues against.
+	Query string `url:"query,omitempty"`
+	// CurrentJQL limits the issues of the "Current Search" section to the ones matching the query.
+	CurrentJQL string `url:"currentJQL,omitempty"`
+	// CurrentIssueKey is the issue that is being edited, like the source of a new link. It is left out of the suggestions.
+	CurrentIssueKey string `url:"currentIssueKey,omitempty"`
+	// CurrentProjectID ranks issues of the project higher.
+	CurrentProjectID string `url:"currentProjectId,omitempty"`
+	// ShowSubTasks includes sub-tasks in the suggestions.
+	ShowSubTasks bool `url:"showSubTasks,omitempty"`
+	// ShowSubTaskParent includes the parent of CurrentIssueKey in the suggestions.
+	ShowSubTaskParent bool `url:"showSubTaskParent,omitempty"`
+}
+
+// IssuePickerSection is a group of suggested issues, like "History Search" or "Current Search".
+type IssuePickerSection struct {
+	ID    string `json:"id" structs:"id"`
+	Label string `json:"label" structs:"label"`
+	// Sub is the number of issues shown compared with all matching issues, like "Showing 5 of 30 matching issues".
+	Sub    string                  `json:"sub,omitempty" structs:"sub,omitempty"`
+	Msg    string                  `json:"msg,omitempty" structs:"msg,omitempty"`
+	Issues []IssuePickerSuggestion `json:"issues" structs:"issues"`
+}
+
+// IssuePickerSuggestion is an issue suggested by the issue picker.
+type IssuePickerSuggestion struct {
+	ID  int64  `json:"id,omitempty" structs:"id,omitempty"`
+	Key string `json:"key" structs:"key"`
+	// KeyHTML and SummaryHTML contain the key and summary with the matching parts highlighted by HTML bold tags.
+	KeyHTML     string `json:"keyHtml,omitempty" structs:"keyHtml,omitempty"`
+	SummaryHTML string `json:"summary,omitempty" structs:"summary,omitempty"`
+	SummaryText string `json:"summaryText,omitempty" structs:"summaryText,omitempty"`
+	// Img is the path of the icon of the issue type.
+	Img string `json:"img,omitempty" structs:"img,omitempty"`
+}
+
+// GetPickerSuggestions returns issues matching options.Query, grouped in sections like the recently viewed issues
+// and the issues of the current search. These are the same suggestions Jira shows in its own issue pickers,
+// for example when linking issues.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-issue-picker-get
+func (s *IssueService) GetPickerSuggestions(ctx context.Context, options *IssuePickerOptions) ([]IssuePickerSection, *Response, error) {
+	apiEndpoint := "rest/api/2/issue/picker"
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(struct {
+		Sections []IssuePickerSection `json:"sections"`
+	})
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Sections, resp, nil
+}
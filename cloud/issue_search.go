@@ -0,0 +1,88 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+)
+
+// SearchJQLOptions specifies the optional parameters for IssueService.SearchJQL.
+type SearchJQLOptions struct {
+	// NextPageToken is the token of the page to return, as returned by the previous page.
+	// Leave it empty for the first page.
+	NextPageToken string `url:"nextPageToken,omitempty"`
+	// MaxResults is the maximum number of issues per page.
+	MaxResults int `url:"maxResults,omitempty"`
+	// Fields is the list of fields to return for each issue, like "summary" or "*all".
+	// By default, only the issue IDs are returned.
+	Fields []string `url:"fields,comma,omitempty"`
+	// Expand expands specific sections in the returned issues, like "names" or "changelog".
+	Expand string `url:"expand,omitempty"`
+	// Properties is the list of issue properties to return for each issue.
+	Properties []string `url:"properties,comma,omitempty"`
+	// FieldsByKeys references fields by their key instead of their ID.
+	FieldsByKeys bool `url:"fieldsByKeys,omitempty"`
+	// FailFast makes the request fail early if a field can't be retrieved.
+	FailFast bool `url:"failFast,omitempty"`
+	// ReconcileIssues are the IDs of issues to include, even if the search index is not up to date yet (read after write consistency).
+	ReconcileIssues []int `url:"reconcileIssues,comma,omitempty"`
+}
+
+// SearchJQLResult is one page of issues returned by IssueService.SearchJQL.
+type SearchJQLResult struct {
+	Issues []Issue `json:"issues" structs:"issues"`
+	// NextPageToken is the token to request the following page.
+	// It is empty on the last page.
+	NextPageToken string `json:"nextPageToken,omitempty" structs:"nextPageToken,omitempty"`
+	IsLast        bool   `json:"isLast" structs:"isLast"`
+}
+
+// SearchJQL searches for issues matching jql, using the token based pagination of the enhanced search.
+// The offset based Search is deprecated by Jira Cloud in favor of this endpoint.
+// To request the following page, pass NextPageToken of the result via options.
+//
+// The endpoint of API version 2 is used, as version 3 returns rich text fields in the Atlassian Document Format,
+// which doesn't fit the Issue struct.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-jql-get
+func (s *IssueService) SearchJQL(ctx context.Context, jql string, options *SearchJQLOptions) (*SearchJQLResult, *Response, error) {
+	query := struct {
+		JQL string `url:"jql,omitempty"`
+		SearchJQLOptions
+	}{JQL: jql}
+	if options != nil {
+		query.SearchJQLOptions = *options
+	}
+	apiEndpoint, err := addOptions("rest/api/2/search/jql", query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(SearchJQLResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// SearchJQLPager returns a Pager over all issues matching jql, following the page tokens of SearchJQL.
+// options.NextPageToken is the token of the first page.
+func (s *IssueService) SearchJQLPager(jql string, options *SearchJQLOptions) *Pager[Issue] {
+	opts := SearchJQLOptions{}
+	if options != nil {
+		opts = *options
+	}
+	return newPager(func(ctx context.Context) ([]Issue, bool, *Response, error) {
+		result, resp, err := s.SearchJQL(ctx, jql, &opts)
+		if err != nil {
+			return nil, true, resp, err
+		}
+		opts.NextPageToken = result.NextPageToken
+		return result.Issues, result.IsLast || result.NextPageToken == "", resp, nil
+	})
+}
@@ -0,0 +1,57 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIssueService_SearchJQL(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/search/jql?fields=summary%2Cstatus&jql=project+%3D+EX&maxResults=2&reconcileIssues=10001%2C10002")
+		fmt.Fprint(w, `{"issues":[{"id":"10001","key":"EX-1","fields":{"summary":"First"}},{"id":"10002","key":"EX-2","fields":{"summary":"Second"}}],"nextPageToken":"CAEaAggD","isLast":false}`)
+	})
+
+	result, _, err := testClient.Issue.SearchJQL(context.Background(), "project = EX", &SearchJQLOptions{
+		MaxResults:      2,
+		Fields:          []string{"summary", "status"},
+		ReconcileIssues: []int{10001, 10002},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(result.Issues) != 2 || result.Issues[1].Fields.Summary != "Second" {
+		t.Errorf("Unexpected issues %+v", result.Issues)
+	}
+	if result.NextPageToken != "CAEaAggD" || result.IsLast {
+		t.Errorf("Unexpected pagination %q, %t", result.NextPageToken, result.IsLast)
+	}
+}
+
+func TestIssueService_SearchJQLPager(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("nextPageToken") {
+		case "":
+			fmt.Fprint(w, `{"issues":[{"key":"EX-1"},{"key":"EX-2"}],"nextPageToken":"page2"}`)
+		case "page2":
+			fmt.Fprint(w, `{"issues":[{"key":"EX-3"}],"isLast":true}`)
+		default:
+			t.Errorf("Unexpected token %q", r.URL.Query().Get("nextPageToken"))
+		}
+	})
+
+	issues, err := testClient.Issue.SearchJQLPager("project = EX", nil).All(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issues) != 3 || issues[2].Key != "EX-3" {
+		t.Errorf("Unexpected issues %+v", issues)
+	}
+}
@@ -3,6 +3,7 @@ package cloud
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -233,6 +234,54 @@ func TestIssueService_UpdateComment(t *testing.T) {
 	}
 }
 
+func TestIssueService_GetComments(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/issue/10000/comment?expand=renderedBody&maxResults=2&orderBy=-created&startAt=2")
+		fmt.Fprint(w, `{"startAt":2,"maxResults":2,"total":3,"comments":[{"id":"10001","body":"Lorem ipsum","visibility":{"type":"role","value":"Administrators"}}]}`)
+	})
+
+	comments, _, err := testClient.Issue.GetComments(context.Background(), "10000", &GetCommentsOptions{StartAt: 2, MaxResults: 2, OrderBy: "-created", Expand: "renderedBody"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if comments.StartAt != 2 || comments.Total != 3 || len(comments.Comments) != 1 {
+		t.Fatalf("Unexpected comments %+v", comments)
+	}
+	if v := comments.Comments[0].Visibility; v.Type != CommentVisibilityRole || v.Value != "Administrators" {
+		t.Errorf("Unexpected visibility %+v", v)
+	}
+}
+
+func TestIssueService_UpdateComment_Visibility(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/comment/10001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		visibility, ok := body["visibility"].(map[string]interface{})
+		if !ok || visibility["type"] != "group" || visibility["value"] != "jira-developers" {
+			t.Errorf("Expected the group visibility, got %v", body)
+		}
+		fmt.Fprint(w, `{"id":"10001","body":"Restricted","visibility":{"type":"group","value":"jira-developers"}}`)
+	})
+
+	c := &Comment{
+		ID:         "10001",
+		Body:       "Restricted",
+		Visibility: CommentVisibility{Type: CommentVisibilityGroup, Value: "jira-developers"},
+	}
+	if _, _, err := testClient.Issue.UpdateComment(context.Background(), "10000", c); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
 func TestIssueService_DeleteComment(t *testing.T) {
 	setup()
 	defer teardown()
@@ -294,6 +343,160 @@ func TestIssueService_UpdateWorklogRecord(t *testing.T) {
 	}
 }
 
+func TestIssueService_GetWorklogRecord(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/worklog/100028", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/issue/10000/worklog/100028?expand=properties")
+		fmt.Fprint(w, `{"comment":"I did some work here.","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10000"}`)
+	})
+
+	record, _, err := testClient.Issue.GetWorklogRecord(context.Background(), "10000", "100028", WithQueryOptions(&GetWorklogsQueryOptions{Expand: "properties"}))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if record.ID != "100028" || record.TimeSpentSeconds != 12000 {
+		t.Errorf("Unexpected worklog record %+v", record)
+	}
+}
+
+func TestIssueService_DeleteWorklogRecord(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/worklog/100028", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/api/2/issue/10000/worklog/100028?adjustEstimate=manual&increaseBy=2h")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Issue.DeleteWorklogRecord(context.Background(), "10000", "100028", WithQueryOptions(&DeleteWorklogQueryOptions{AdjustEstimate: "manual", IncreaseBy: "2h"}))
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_WorklogProperties(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issue/10002/worklog/100028/properties"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"keys":[{"self":"https://your-domain.atlassian.net/rest/api/2/issue/10002/worklog/100028/properties/billing","key":"billing"}]}`)
+	})
+	testMux.HandleFunc(testapiEndpoint+"/billing", func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, testapiEndpoint+"/billing")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"key":"billing","value":{"code":"ACME-42"}}`)
+		case http.MethodPut:
+			var value map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&value); err != nil {
+				t.Fatal(err)
+			}
+			if value["code"] != "ACME-42" {
+				t.Errorf("Expected billing code ACME-42. Got %+v", value)
+			}
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request method %s", r.Method)
+		}
+	})
+
+	keys, _, err := testClient.Issue.GetWorklogPropertyKeys(context.Background(), "10002", "100028")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(keys.Keys) != 1 || keys.Keys[0].Key != "billing" {
+		t.Errorf("Expected property key billing. Got %+v", keys.Keys)
+	}
+
+	if _, err := testClient.Issue.SetWorklogProperty(context.Background(), "10002", "100028", "billing", map[string]string{"code": "ACME-42"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	property, _, err := testClient.Issue.GetWorklogProperty(context.Background(), "10002", "100028", "billing")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if value, ok := property.Value.(map[string]interface{}); !ok || value["code"] != "ACME-42" {
+		t.Errorf("Expected billing code ACME-42. Got %+v", property.Value)
+	}
+
+	if _, err := testClient.Issue.DeleteWorklogProperty(context.Background(), "10002", "100028", "billing"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_Properties(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issue/EX-1/properties"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"keys":[{"self":"https://your-domain.atlassian.net/rest/api/2/issue/EX-1/properties/sync","key":"sync"}]}`)
+	})
+	testMux.HandleFunc(testapiEndpoint+"/sync", func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, testapiEndpoint+"/sync")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"key":"sync","value":{"externalId":"ACME-42","revision":3}}`)
+		case http.MethodPut:
+			var value map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&value); err != nil {
+				t.Fatal(err)
+			}
+			if value["externalId"] != "ACME-42" || value["revision"] != float64(3) {
+				t.Errorf("Unexpected property value %+v", value)
+			}
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request method %s", r.Method)
+		}
+	})
+
+	type syncState struct {
+		ExternalID string `json:"externalId"`
+		Revision   int    `json:"revision"`
+	}
+
+	keys, _, err := testClient.Issue.GetPropertyKeys(context.Background(), "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(keys.Keys) != 1 || keys.Keys[0].Key != "sync" {
+		t.Errorf("Expected property key sync. Got %+v", keys.Keys)
+	}
+
+	if _, err := testClient.Issue.SetProperty(context.Background(), "EX-1", "sync", syncState{ExternalID: "ACME-42", Revision: 3}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	property, _, err := testClient.Issue.GetProperty(context.Background(), "EX-1", "sync")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	var state syncState
+	if err := property.Decode(&state); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if state != (syncState{ExternalID: "ACME-42", Revision: 3}) {
+		t.Errorf("Expected the decoded sync state. Got %+v", state)
+	}
+
+	if _, err := testClient.Issue.DeleteProperty(context.Background(), "EX-1", "sync"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
 func TestIssueService_AddLink(t *testing.T) {
 	setup()
 	defer teardown()
@@ -372,7 +575,7 @@ func TestIssueService_Get_RenderedFields(t *testing.T) {
 		testMethod(t, r, http.MethodGet)
 		testRequestURL(t, r, "/rest/api/2/issue/10002")
 
-		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"labels":["test"],"watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"epic": {"id": 19415,"key": "EPIC-77","self": "https://example.atlassian.net/rest/agile/1.0/epic/19415","name": "Epic Name","summary": "Do it","color": {"key": "color_11"},"done": false},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","duedate":"2018-01-19","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{},"renderedFields":{"resolutiondate":"In 1 week","updated":"2 hours ago","comment":{"comments":[{"body":"This <strong>is</strong> HTML"}]}}}`)
+		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"labels":["test"],"watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"epic": {"id": 19415,"key": "EPIC-77","self": "https://example.atlassian.net/rest/agile/1.0/epic/19415","name": "Epic Name","summary": "Do it","color": {"key": "color_11"},"done": false},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","duedate":"2018-01-19","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{},"renderedFields":{"resolutiondate":"In 1 week","updated":"2 hours ago","comment":{"comments":[{"body":"This <strong>is</strong> HTML"}]},"description":"<p>example <em>bug</em> report</p>","timespent":"6 minutes","worklog":{"startAt":0,"maxResults":20,"total":1,"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","comment":"<p>I did some work here.</p>","created":"16/Mar/16 4:22 AM","updated":"16/Mar/16 4:22 AM","started":"16/Mar/16 4:22 AM","timeSpent":"3 hours, 20 minutes","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"customfield_10010":"<p>Rendered <b>text</b></p>","customfield_10011":null}}`)
 	})
 
 	issue, _, err := testClient.Issue.Get(context.Background(), "10002", nil)
@@ -394,6 +597,18 @@ func TestIssueService_Get_RenderedFields(t *testing.T) {
 	if comment.Body != "This <strong>is</strong> HTML" {
 		t.Errorf("Wrong comment body returned in RenderedField. Got %s", comment.Body)
 	}
+	if issue.RenderedFields.Description != "<p>example <em>bug</em> report</p>" || issue.RenderedFields.TimeSpent != "6 minutes" {
+		t.Errorf("Unexpected rendered fields %+v", issue.RenderedFields)
+	}
+	if w := issue.RenderedFields.Worklog; w == nil || len(w.Worklogs) != 1 || w.Worklogs[0].Started != "16/Mar/16 4:22 AM" || w.Worklogs[0].Comment != "<p>I did some work here.</p>" {
+		t.Errorf("Unexpected rendered worklog %+v", w)
+	}
+	if got := issue.RenderedFields.CustomField("customfield_10010"); got != "<p>Rendered <b>text</b></p>" {
+		t.Errorf("Unexpected rendered custom field %q", got)
+	}
+	if len(issue.RenderedFields.CustomFields) != 1 {
+		t.Errorf("Expected only the rendered custom field, got %v", issue.RenderedFields.CustomFields)
+	}
 }
 
 func TestIssueService_DownloadAttachment(t *testing.T) {
@@ -617,6 +832,30 @@ func TestIssueService_DeleteLink(t *testing.T) {
 	}
 }
 
+func TestIssueService_GetLink(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issueLink/10001"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},
+			"inwardIssue":{"id":"10004","key":"PR-3"},"outwardIssue":{"id":"10003","key":"PR-2"}}`)
+	})
+
+	link, _, err := testClient.Issue.GetLink(context.Background(), "10001")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if link.ID != "10001" || link.Type.Name != "Dependent" {
+		t.Errorf("Unexpected issue link %+v", link)
+	}
+	if link.InwardIssue == nil || link.InwardIssue.Key != "PR-3" || link.OutwardIssue == nil || link.OutwardIssue.Key != "PR-2" {
+		t.Errorf("Unexpected linked issues %+v, %+v", link.InwardIssue, link.OutwardIssue)
+	}
+}
+
 func TestIssueService_Search(t *testing.T) {
 	setup()
 	defer teardown()
@@ -774,6 +1013,64 @@ func TestIssueService_SearchPages_EmptyResult(t *testing.T) {
 
 }
 
+func TestIssueService_SearchPages_TotalDrift(t *testing.T) {
+	setup()
+	defer teardown()
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests++
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			// Jira caps the page size below the requested one
+			fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":5,"issues":[{"key":"EX-1"},{"key":"EX-2"}]}`)
+		case "2":
+			// Issues were deleted in the meantime
+			fmt.Fprint(w, `{"startAt":2,"maxResults":2,"total":3,"issues":[{"key":"EX-3"}]}`)
+		default:
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+	})
+
+	opt := &SearchOptions{MaxResults: 100}
+	var keys []string
+	err := testClient.Issue.SearchPages(context.Background(), "something", opt, func(issue Issue) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(keys) != 3 || requests != 2 {
+		t.Errorf("Expected 3 issues in 2 requests. Got %v in %d requests", keys, requests)
+	}
+	if opt.StartAt != 0 {
+		t.Errorf("Expected the options of the caller to stay untouched. Got StartAt %d", opt.StartAt)
+	}
+}
+
+func TestIssueService_SearchPages_ContextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":10,"issues":[{"key":"EX-1"}]}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := testClient.Issue.SearchPages(ctx, "something", &SearchOptions{MaxResults: 1}, func(issue Issue) error {
+		cancel()
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled. Got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected a single request. Got %d", requests)
+	}
+}
+
 func TestIssueService_GetCustomFields(t *testing.T) {
 	setup()
 	defer teardown()
@@ -884,6 +1181,208 @@ func TestIssueService_DoTransition(t *testing.T) {
 	}
 }
 
+func TestIssueService_TransitionToStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/123/transitions"
+
+	raw, err := os.ReadFile("../testing/mock-data/transitions.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, testAPIEndpoint)
+
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, string(raw))
+			return
+		}
+
+		testMethod(t, r, http.MethodPost)
+		var payload CreateTransitionPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload.Transition.ID != "2" {
+			t.Errorf("Expected transition 2 to be in payload, got %s instead", payload.Transition.ID)
+		}
+		if len(payload.Update.Comment) != 1 || payload.Update.Comment[0].Add.Body != "Working on it" {
+			t.Errorf("Expected comment in payload, got %+v instead", payload.Update)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err = testClient.Issue.TransitionToStatus(context.Background(), "123", "in progress", &TransitionOptions{Comment: "Working on it"})
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+}
+
+func TestIssueService_TransitionToStatus_NoMatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/123/transitions"
+
+	raw, err := os.ReadFile("../testing/mock-data/transitions.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, string(raw))
+	})
+
+	_, err = testClient.Issue.TransitionToStatus(context.Background(), "123", "Done", nil)
+
+	var transitionErr *NoTransitionError
+	if !errors.As(err, &transitionErr) {
+		t.Fatalf("Expected a NoTransitionError. Got %v", err)
+	}
+	if len(transitionErr.Available) != 2 {
+		t.Errorf("Expected 2 available transitions. Got %d", len(transitionErr.Available))
+	}
+	if !strings.Contains(err.Error(), "Close Issue") {
+		t.Errorf("Expected available transitions in error message. Got %s", err.Error())
+	}
+}
+
+func TestIssueService_TransitionToStatus_FieldsAndUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/123/transitions"
+
+	raw, err := os.ReadFile("../testing/mock-data/transitions.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, string(raw))
+			return
+		}
+
+		testMethod(t, r, http.MethodPost)
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `{"fields":{"customfield_10001":"v1.2","resolution":{"self":"","id":"","description":"","name":"Fixed"}},` +
+			`"transition":{"id":"2"},` +
+			`"update":{"comment":[{"add":{"body":"Done"}}],"labels":[{"add":"released"},{"remove":"wip"}]}}`
+		var got, expected interface{}
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal([]byte(want), &expected); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Unexpected payload\ngot:  %s\nwant: %s", body, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err = testClient.Issue.TransitionToStatus(context.Background(), "123", "In Progress", &TransitionOptions{
+		Comment:    "Done",
+		Resolution: &Resolution{Name: "Fixed"},
+		Fields:     map[string]interface{}{"customfield_10001": "v1.2"},
+		Update: map[string][]map[string]interface{}{
+			"labels": {{"add": "released"}, {"remove": "wip"}},
+		},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_DoTransitionWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/123/transitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("Error given: %s", err)
+			return
+		}
+		want := `{"fields":{"resolution":{"self":"","id":"","description":"","name":"Won't Do"}},` +
+			`"transition":{"id":"31"},` +
+			`"update":{"comment":[{"add":{"body":"Duplicate"}}],"labels":[{"add":"duplicate"}]}}`
+		var got, expected interface{}
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Errorf("Error given: %s", err)
+			return
+		}
+		if err := json.Unmarshal([]byte(want), &expected); err != nil {
+			t.Errorf("Error given: %s", err)
+			return
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Unexpected payload\ngot:  %s\nwant: %s", body, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Issue.DoTransitionWithOptions(context.Background(), "123", "31", &TransitionOptions{
+		Comment:    "Duplicate",
+		Resolution: &Resolution{Name: "Won't Do"},
+		Update:     map[string][]map[string]interface{}{"labels": {{"add": "duplicate"}}},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_DoTransitionToStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	raw, err := os.ReadFile("../testing/mock-data/transitions.json")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/transitions", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, string(raw))
+			return
+		}
+
+		testMethod(t, r, http.MethodPost)
+		var payload CreateTransitionPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		if payload.Transition.ID != "2" {
+			t.Errorf("Expected transition 2 to be in payload, got %s instead", payload.Transition.ID)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.DoTransitionToStatus(context.Background(), "EX-1", "In Progress"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestCreateTransitionPayload_MarshalJSON(t *testing.T) {
+	payload := CreateTransitionPayload{Transition: TransitionPayload{ID: "22"}}
+
+	got, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := `{"update":{},"transition":{"id":"22"},"fields":{}}`; string(got) != want {
+		t.Errorf("Unexpected payload\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
 func TestIssueService_DoTransitionWithPayload(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1664,6 +2163,97 @@ func TestIssueService_DeprecatedGetWatchers(t *testing.T) {
 	}
 }
 
+func TestIssueService_GetWatches(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/watchers")
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":true,"watchCount":1,"watchers":[{"accountId":"5b10a2844c20165700ede21g","displayName":"Fred F. User","active":true}]}`)
+	})
+
+	watches, _, err := testClient.Issue.GetWatches(context.Background(), "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if watches.WatchCount != 1 || !watches.IsWatching || len(watches.Watchers) != 1 {
+		t.Fatalf("Unexpected watches %+v", watches)
+	}
+	if watches.Watchers[0].DisplayName != "Fred F. User" {
+		t.Errorf("Unexpected watcher %+v", watches.Watchers[0])
+	}
+}
+
+func TestIssueService_AddWatcher(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/watchers")
+
+		var body string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body != "5b10a2844c20165700ede21g" {
+			t.Errorf("Expected watcher 5b10a2844c20165700ede21g as JSON string, got %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.AddWatcher(context.Background(), "EX-1", "5b10a2844c20165700ede21g"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_RemoveWatcher(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/watchers?accountId=5b10a2844c20165700ede21g")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.RemoveWatcher(context.Background(), "EX-1", "5b10a2844c20165700ede21g"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_Votes(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issue/EX-1/votes"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, testapiEndpoint)
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/issue/EX-1/votes","votes":2,"hasVoted":true,
+				"voters":[{"accountId":"5b10a2844c20165700ede21g","displayName":"Fred F. User","active":true}]}`)
+		case http.MethodPost, http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request method %s", r.Method)
+		}
+	})
+
+	votes, _, err := testClient.Issue.GetVotes(context.Background(), "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if votes.Votes != 2 || !votes.HasVoted || len(votes.Voters) != 1 || votes.Voters[0].DisplayName != "Fred F. User" {
+		t.Errorf("Unexpected votes %+v", votes)
+	}
+
+	if _, err := testClient.Issue.AddVote(context.Background(), "EX-1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Issue.RemoveVote(context.Background(), "EX-1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
 func TestIssueService_UpdateAssignee(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1931,3 +2521,48 @@ func TestTime_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueExpands(t *testing.T) {
+	if got, want := IssueExpands(IssueExpandRenderedFields, IssueExpandNames), "renderedFields,names"; got != want {
+		t.Errorf("IssueExpands() = %q, want %q", got, want)
+	}
+	if IssueExpand("everything").IsValid() {
+		t.Error("Expected expand value everything to be invalid")
+	}
+}
+
+func TestIssue_UnmarshalJSON_KeepsExtraAttributes(t *testing.T) {
+	data := []byte(`{"id":"10002","key":"EX-1","properties":{"flag":true},"operations":{"linkGroups":[]},"fields":{"summary":"Test","customfield_10000":"value"}}`)
+
+	issue := new(Issue)
+	if err := json.Unmarshal(data, issue); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if issue.Key != "EX-1" || issue.Fields.Summary != "Test" {
+		t.Errorf("Expected the modelled attributes to be set. Got %+v", issue)
+	}
+	if len(issue.Extra) != 2 {
+		t.Fatalf("Expected 2 extra attributes. Got %+v", issue.Extra)
+	}
+	if got, want := string(issue.Extra["properties"]), `{"flag":true}`; got != want {
+		t.Errorf("Extra[properties] = %s, want %s", got, want)
+	}
+	if _, found := issue.Fields.Unknowns["customfield_10000"]; !found {
+		t.Errorf("Expected customfield_10000 in unknown fields. Got %+v", issue.Fields.Unknowns)
+	}
+
+	out, err := json.Marshal(issue)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	roundTrip := map[string]json.RawMessage{}
+	if err := json.Unmarshal(out, &roundTrip); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	for _, key := range []string{"id", "key", "properties", "operations", "fields"} {
+		if _, found := roundTrip[key]; !found {
+			t.Errorf("Expected %s to survive the round trip. Got %s", key, out)
+		}
+	}
+}
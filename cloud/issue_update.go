@@ -0,0 +1,289 @@
+package cloud
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// updateOperationFields are the array fields that are changed via "add" and "remove" operations.
+// Changing them this way doesn't overwrite concurrent changes of other values in the same field.
+var updateOperationFields = map[string]bool{
+	"labels":      true,
+	"components":  true,
+	"fixVersions": true,
+	"versions":    true,
+}
+
+// readOnlyFields are system fields that are maintained by Jira and can't be set via an edit.
+var readOnlyFields = map[string]bool{
+	"aggregateprogress":             true,
+	"aggregatetimeestimate":         true,
+	"aggregatetimeoriginalestimate": true,
+	"aggregatetimespent":            true,
+	"attachment":                    true,
+	"comment":                       true,
+	"created":                       true,
+	"Creator":                       true,
+	"creator":                       true,
+	"issuelinks":                    true,
+	"lastViewed":                    true,
+	"progress":                      true,
+	"resolutiondate":                true,
+	"status":                        true,
+	"statuscategorychangedate":      true,
+	"subtasks":                      true,
+	"timeestimate":                  true,
+	"timespent":                     true,
+	"updated":                       true,
+	"votes":                         true,
+	"watches":                       true,
+	"workratio":                     true,
+	"worklog":                       true,
+}
+
+// NewUpdatePayload generates the minimal edit payload that turns original into modified.
+// Changed values are put into the "fields" notation.
+// Labels, components, fix versions and affected versions are changed via "add" and "remove" operations
+// in the "update" notation, so that values added by others in the meantime are kept.
+// Fields that are maintained by Jira, like "created" or "status", are ignored.
+//
+// The payload can be sent with IssueService.UpdateIssue.
+// If nothing changed, an empty map is returned.
+func NewUpdatePayload(original, modified *Issue) (map[string]interface{}, error) {
+	changes, err := DiffIssues(original, modified)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]interface{}{}
+	update := map[string]interface{}{}
+	for _, change := range changes {
+		switch {
+		case readOnlyFields[change.Field]:
+			continue
+		case updateOperationFields[change.Field]:
+			operations, err := arrayOperations(change)
+			if err != nil {
+				return nil, err
+			}
+			if len(operations) > 0 {
+				update[change.Field] = operations
+			}
+		default:
+			fields[change.Field] = change.To
+		}
+	}
+
+	payload := map[string]interface{}{}
+	if len(fields) > 0 {
+		payload["fields"] = fields
+	}
+	if len(update) > 0 {
+		payload["update"] = update
+	}
+	return payload, nil
+}
+
+// arrayOperations returns the "add" and "remove" operations that turn change.From into change.To.
+func arrayOperations(change FieldChange) ([]map[string]interface{}, error) {
+	from, err := arrayValues(change.Field, change.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := arrayValues(change.Field, change.To)
+	if err != nil {
+		return nil, err
+	}
+
+	var operations []map[string]interface{}
+	for _, v := range from {
+		if !containsValue(to, v) {
+			operations = append(operations, map[string]interface{}{"remove": operationValue(v)})
+		}
+	}
+	for _, v := range to {
+		if !containsValue(from, v) {
+			operations = append(operations, map[string]interface{}{"add": operationValue(v)})
+		}
+	}
+	return operations, nil
+}
+
+// arrayValues returns the elements of an array field value.
+func arrayValues(field string, value interface{}) ([]interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	values, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("field %s is expected to be an array, got %T", field, value)
+	}
+	return values, nil
+}
+
+// operationValue reduces an object value to the attribute that identifies it.
+// Objects like components or versions are referenced by ID if known, otherwise by name.
+func operationValue(v interface{}) interface{} {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	for _, key := range []string{"id", "name"} {
+		if value, found := obj[key]; found {
+			return map[string]interface{}{key: value}
+		}
+	}
+	return obj
+}
+
+// containsValue reports whether values contains v.
+// Objects are equal if they share the same ID or name.
+func containsValue(values []interface{}, v interface{}) bool {
+	needle := operationValue(v)
+	for _, value := range values {
+		if reflect.DeepEqual(operationValue(value), needle) {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateBuilder assembles an edit payload for IssueService.UpdateIssue out of update operations.
+// Operations like "add" and "remove" change single values of array fields,
+// so that values changed by others in the meantime are kept.
+//
+// Example:
+//
+//	payload, err := jira.NewUpdateBuilder().
+//		AddLabel("triaged").
+//		RemoveLabel("needs-info").
+//		Set("summary", "Login fails on Safari").
+//		Build()
+type UpdateBuilder struct {
+	update map[string][]map[string]interface{}
+	fields map[string]interface{}
+	err    error
+}
+
+// NewUpdateBuilder returns an empty UpdateBuilder.
+func NewUpdateBuilder() *UpdateBuilder {
+	return &UpdateBuilder{
+		update: map[string][]map[string]interface{}{},
+		fields: map[string]interface{}{},
+	}
+}
+
+// Add adds every value to the array field.
+func (b *UpdateBuilder) Add(field string, values ...interface{}) *UpdateBuilder {
+	for _, v := range values {
+		b.operation(field, "add", v)
+	}
+	return b
+}
+
+// Remove removes every value from the array field.
+func (b *UpdateBuilder) Remove(field string, values ...interface{}) *UpdateBuilder {
+	for _, v := range values {
+		b.operation(field, "remove", v)
+	}
+	return b
+}
+
+// Set replaces the value of the field via the "set" operation.
+func (b *UpdateBuilder) Set(field string, value interface{}) *UpdateBuilder {
+	return b.operation(field, "set", value)
+}
+
+// Edit changes the value of the field via the "edit" operation, like the estimates of "timetracking".
+func (b *UpdateBuilder) Edit(field string, value interface{}) *UpdateBuilder {
+	return b.operation(field, "edit", value)
+}
+
+// AddLabel adds labels to the issue.
+func (b *UpdateBuilder) AddLabel(labels ...string) *UpdateBuilder {
+	for _, label := range labels {
+		b.operation("labels", "add", label)
+	}
+	return b
+}
+
+// RemoveLabel removes labels from the issue.
+func (b *UpdateBuilder) RemoveLabel(labels ...string) *UpdateBuilder {
+	for _, label := range labels {
+		b.operation("labels", "remove", label)
+	}
+	return b
+}
+
+// AddComponent adds components (by name) to the issue.
+func (b *UpdateBuilder) AddComponent(names ...string) *UpdateBuilder {
+	for _, name := range names {
+		b.operation("components", "add", map[string]interface{}{"name": name})
+	}
+	return b
+}
+
+// RemoveComponent removes components (by name) from the issue.
+func (b *UpdateBuilder) RemoveComponent(names ...string) *UpdateBuilder {
+	for _, name := range names {
+		b.operation("components", "remove", map[string]interface{}{"name": name})
+	}
+	return b
+}
+
+// Field sets the value of the field in the "fields" notation, replacing the whole value.
+func (b *UpdateBuilder) Field(field string, value interface{}) *UpdateBuilder {
+	if field == "" {
+		b.fail(errors.New("field of the update must not be empty"))
+		return b
+	}
+	b.fields[field] = value
+	return b
+}
+
+// operation appends the operation op with value to the operations of field.
+func (b *UpdateBuilder) operation(field, op string, value interface{}) *UpdateBuilder {
+	if field == "" {
+		b.fail(fmt.Errorf("field of the %q operation must not be empty", op))
+		return b
+	}
+	b.update[field] = append(b.update[field], map[string]interface{}{op: value})
+	return b
+}
+
+// fail keeps the first error, which is returned by Build.
+func (b *UpdateBuilder) fail(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// Operations returns the update operations by field ID,
+// like they are expected by TransitionOptions.Update.
+func (b *UpdateBuilder) Operations() map[string][]map[string]interface{} {
+	return b.update
+}
+
+// Build returns the payload for IssueService.UpdateIssue.
+// Jira rejects edits that change a field in both the "fields" and the "update" notation,
+// so an error is returned for those as well as for operations without a field.
+func (b *UpdateBuilder) Build() (map[string]interface{}, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	for field := range b.fields {
+		if _, found := b.update[field]; found {
+			return nil, fmt.Errorf("field %s is changed via both the fields and the update notation", field)
+		}
+	}
+
+	payload := map[string]interface{}{}
+	if len(b.fields) > 0 {
+		payload["fields"] = b.fields
+	}
+	if len(b.update) > 0 {
+		payload["update"] = b.update
+	}
+	return payload, nil
+}
@@ -0,0 +1,202 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// IssueSecuritySchemeService handles issue security schemes for the Jira instance / API.
+// Issue security schemes define the security levels restricting who can see an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/
+type IssueSecuritySchemeService service
+
+// IssueSecurityScheme is an issue security scheme with its security levels.
+type IssueSecurityScheme struct {
+	Self                   string          `json:"self,omitempty" structs:"self,omitempty"`
+	ID                     int64           `json:"id" structs:"id"`
+	Name                   string          `json:"name" structs:"name"`
+	Description            string          `json:"description,omitempty" structs:"description,omitempty"`
+	DefaultSecurityLevelID int64           `json:"defaultSecurityLevelId,omitempty" structs:"defaultSecurityLevelId,omitempty"`
+	Levels                 []SecurityLevel `json:"levels,omitempty" structs:"levels,omitempty"`
+}
+
+// IssueSecurityLevelMember is a user, group or role that can see the issues of a security level.
+type IssueSecurityLevelMember struct {
+	ID                    string `json:"id" structs:"id"`
+	IssueSecurityLevelID  string `json:"issueSecurityLevelId" structs:"issueSecurityLevelId"`
+	IssueSecuritySchemeID string `json:"issueSecuritySchemeId" structs:"issueSecuritySchemeId"`
+	Holder                Holder `json:"holder" structs:"holder"`
+}
+
+// SecurityLevelOptions specifies the optional parameters for IssueSecuritySchemeService.GetLevels.
+type SecurityLevelOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// IDs restricts the results to the security levels with these IDs.
+	IDs []string `url:"id,omitempty"`
+	// SchemeIDs restricts the results to the security levels of these schemes.
+	SchemeIDs []string `url:"schemeId,omitempty"`
+	// OnlyDefault restricts the results to the default security levels of the schemes.
+	OnlyDefault bool `url:"onlyDefault,omitempty"`
+}
+
+// SecurityLevelMemberOptions specifies the optional parameters for IssueSecuritySchemeService.GetLevelMembers.
+type SecurityLevelMemberOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// SchemeIDs restricts the results to the members of the security levels of these schemes.
+	SchemeIDs []string `url:"schemeId,omitempty"`
+	// LevelIDs restricts the results to the members of these security levels.
+	LevelIDs []string `url:"levelId,omitempty"`
+	// Expand additional attributes of the holders, like "all", "field", "group", "projectRole" or "user".
+	Expand string `url:"expand,omitempty"`
+}
+
+// IssueSecuritySchemeAssignment is used for associating an issue security scheme with a project
+// via IssueSecuritySchemeService.AssignToProject.
+type IssueSecuritySchemeAssignment struct {
+	// IssueSecuritySchemeID is the ID of the scheme. Use "-1" to remove the scheme from the project.
+	IssueSecuritySchemeID string `json:"issueSecuritySchemeId"`
+	ProjectID             string `json:"projectId"`
+	// OldToNewSecurityLevelMappings replace the security levels of the issues of the project.
+	// It is required if issues have a security level of the former scheme.
+	OldToNewSecurityLevelMappings []SecurityLevelMapping `json:"oldToNewSecurityLevelMappings,omitempty"`
+}
+
+// SecurityLevelMapping replaces the security level OldLevelID with NewLevelID.
+// An empty NewLevelID removes the security level from the issues.
+type SecurityLevelMapping struct {
+	OldLevelID string `json:"oldLevelId"`
+	NewLevelID string `json:"newLevelId"`
+}
+
+// GetList returns all issue security schemes.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/#api-rest-api-2-issuesecurityschemes-get
+func (s *IssueSecuritySchemeService) GetList(ctx context.Context) ([]IssueSecurityScheme, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "rest/api/2/issuesecurityschemes", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schemes := new(struct {
+		IssueSecuritySchemes []IssueSecurityScheme `json:"issueSecuritySchemes"`
+	})
+	resp, err := s.client.Do(req, schemes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return schemes.IssueSecuritySchemes, resp, nil
+}
+
+// Get returns the issue security scheme schemeID with its security levels.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/#api-rest-api-2-issuesecurityschemes-id-get
+func (s *IssueSecuritySchemeService) Get(ctx context.Context, schemeID string) (*IssueSecurityScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuesecurityschemes/%s", url.PathEscape(schemeID))
+	return s.getScheme(ctx, apiEndpoint)
+}
+
+// GetForProject returns the issue security scheme of the project projectIDOrKey with its security levels.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-permission-schemes/#api-rest-api-2-project-projectkeyorid-issuesecuritylevelscheme-get
+func (s *IssueSecuritySchemeService) GetForProject(ctx context.Context, projectIDOrKey string) (*IssueSecurityScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/issuesecuritylevelscheme", url.PathEscape(projectIDOrKey))
+	return s.getScheme(ctx, apiEndpoint)
+}
+
+func (s *IssueSecuritySchemeService) getScheme(ctx context.Context, apiEndpoint string) (*IssueSecurityScheme, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(IssueSecurityScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// GetLevel returns the security level levelID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-level/#api-rest-api-2-securitylevel-id-get
+func (s *IssueSecuritySchemeService) GetLevel(ctx context.Context, levelID string) (*SecurityLevel, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/securitylevel/%s", url.PathEscape(levelID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	level := new(SecurityLevel)
+	resp, err := s.client.Do(req, level)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return level, resp, nil
+}
+
+// GetLevels returns one page of the security levels matching the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/#api-rest-api-2-issuesecurityschemes-level-get
+func (s *IssueSecuritySchemeService) GetLevels(ctx context.Context, options *SecurityLevelOptions) (*PagedList[SecurityLevel], *Response, error) {
+	u, err := addOptions("rest/api/2/issuesecurityschemes/level", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[SecurityLevel](ctx, s.client, u, agilePaging)
+}
+
+// GetLevelMembers returns one page of the members of the security levels matching the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/#api-rest-api-2-issuesecurityschemes-level-member-get
+func (s *IssueSecuritySchemeService) GetLevelMembers(ctx context.Context, options *SecurityLevelMemberOptions) (*PagedList[IssueSecurityLevelMember], *Response, error) {
+	u, err := addOptions("rest/api/2/issuesecurityschemes/level/member", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[IssueSecurityLevelMember](ctx, s.client, u, agilePaging)
+}
+
+// AssignToProject associates an issue security scheme with a project.
+// The security levels of the issues are updated by a background task,
+// whose progress can be followed via TaskService.Get or TaskService.Wait.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/#api-rest-api-2-issuesecurityschemes-project-put
+func (s *IssueSecuritySchemeService) AssignToProject(ctx context.Context, assignment *IssueSecuritySchemeAssignment) (*Task, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPut, "rest/api/2/issuesecurityschemes/project", assignment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Jira responds with "303 See Other" to the task, which is followed by the http.Client.
+	task := new(Task)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return task, resp, nil
+}
+
+// SetSecurityLevel sets the security level levelID of the issue issueIDOrKey.
+// The level has to be part of the issue security scheme of the project.
+// An empty levelID removes the security level, making the issue visible to everyone who can browse the project.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-put
+func (s *IssueService) SetSecurityLevel(ctx context.Context, issueIDOrKey, levelID string) (*Response, error) {
+	var security interface{}
+	if levelID != "" {
+		security = map[string]interface{}{"id": levelID}
+	}
+	return s.UpdateIssue(ctx, url.PathEscape(issueIDOrKey), map[string]interface{}{
+		"fields": map[string]interface{}{"security": security},
+	})
+}
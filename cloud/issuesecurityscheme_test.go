@@ -0,0 +1,187 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestIssueSecuritySchemeService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuesecurityschemes"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"issueSecuritySchemes":[{"self":"https://your-domain.atlassian.net/rest/api/2/issuesecurityschemes/10000","id":10000,"name":"Default Issue Security Scheme","description":"Description for the default issue security scheme","defaultSecurityLevelId":10021}]}`)
+	})
+
+	schemes, _, err := testClient.IssueSecurityScheme.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(schemes) != 1 || schemes[0].ID != 10000 || schemes[0].DefaultSecurityLevelID != 10021 {
+		t.Errorf("Unexpected issue security schemes %+v", schemes)
+	}
+}
+
+func TestIssueSecuritySchemeService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuesecurityschemes/10000"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"id":10000,"name":"Default Issue Security Scheme","defaultSecurityLevelId":10021,"levels":[{"self":"https://your-domain.atlassian.net/rest/api/2/securitylevel/10021","id":"10021","description":"Only the reporter and internal staff can see this issue.","name":"Reporter Only"}]}`)
+	})
+
+	scheme, _, err := testClient.IssueSecurityScheme.Get(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(scheme.Levels) != 1 || scheme.Levels[0].Name != "Reporter Only" {
+		t.Errorf("Unexpected security levels %+v", scheme.Levels)
+	}
+}
+
+func TestIssueSecuritySchemeService_GetLevel(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/securitylevel/10021"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"id":"10021","name":"Reporter Only","isDefault":true,"issueSecuritySchemeId":"10000"}`)
+	})
+
+	level, _, err := testClient.IssueSecurityScheme.GetLevel(context.Background(), "10021")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !level.IsDefault || level.IssueSecuritySchemeID != "10000" {
+		t.Errorf("Unexpected security level %+v", level)
+	}
+}
+
+func TestIssueSecuritySchemeService_GetLevels(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuesecurityschemes/level"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?onlyDefault=true&schemeId=10000")
+		fmt.Fprint(w, `{"isLast":true,"maxResults":50,"startAt":0,"total":1,"values":[{"id":"10021","name":"Reporter Only","isDefault":true,"issueSecuritySchemeId":"10000"}]}`)
+	})
+
+	page, _, err := testClient.IssueSecurityScheme.GetLevels(context.Background(), &SecurityLevelOptions{SchemeIDs: []string{"10000"}, OnlyDefault: true})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 1 || page.Values[0].ID != "10021" {
+		t.Errorf("Unexpected security levels %+v", page.Values)
+	}
+}
+
+func TestIssueSecuritySchemeService_GetLevelMembers(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuesecurityschemes/level/member"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?levelId=10021&schemeId=10000")
+		fmt.Fprint(w, `{"isLast":true,"maxResults":50,"startAt":0,"total":2,"values":[{"id":"10000","issueSecurityLevelId":"10021","issueSecuritySchemeId":"10000","holder":{"type":"reporter"}},{"id":"10001","issueSecurityLevelId":"10021","issueSecuritySchemeId":"10000","holder":{"type":"group","parameter":"legal"}}]}`)
+	})
+
+	page, _, err := testClient.IssueSecurityScheme.GetLevelMembers(context.Background(), &SecurityLevelMemberOptions{SchemeIDs: []string{"10000"}, LevelIDs: []string{"10021"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 2 || page.Values[1].Holder.Type != "group" || page.Values[1].Holder.Parameter != "legal" {
+		t.Errorf("Unexpected security level members %+v", page.Values)
+	}
+}
+
+func TestIssueSecuritySchemeService_AssignToProject(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuesecurityschemes/project"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint)
+		want := `{"issueSecuritySchemeId":"10001","projectId":"10000","oldToNewSecurityLevelMappings":[{"oldLevelId":"10021","newLevelId":"10030"}]}` + "\n"
+		if b, _ := io.ReadAll(r.Body); string(b) != want {
+			t.Errorf("Unexpected request body\ngot:  %s\nwant: %s", b, want)
+		}
+		http.Redirect(w, r, "/rest/api/2/task/10050", http.StatusSeeOther)
+	})
+	testMux.HandleFunc("/rest/api/2/task/10050", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":"10050","status":"RUNNING","progress":10}`)
+	})
+
+	task, _, err := testClient.IssueSecurityScheme.AssignToProject(context.Background(), &IssueSecuritySchemeAssignment{
+		IssueSecuritySchemeID:         "10001",
+		ProjectID:                     "10000",
+		OldToNewSecurityLevelMappings: []SecurityLevelMapping{{OldLevelID: "10021", NewLevelID: "10030"}},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if task.ID != "10050" || task.Status != TaskStatusRunning {
+		t.Errorf("Unexpected task %+v", task)
+	}
+}
+
+func TestIssueSecuritySchemeService_GetForProject(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/project/EX/issuesecuritylevelscheme"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"id":10000,"name":"Legal Hold","defaultSecurityLevelId":10021,"levels":[{"id":"10021","name":"Legal"}]}`)
+	})
+
+	scheme, _, err := testClient.IssueSecurityScheme.GetForProject(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if scheme.Name != "Legal Hold" || len(scheme.Levels) != 1 {
+		t.Errorf("Unexpected issue security scheme %+v", scheme)
+	}
+}
+
+func TestIssueService_SetSecurityLevel(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issue/EX-1"
+
+	bodies := []string{}
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.SetSecurityLevel(context.Background(), "EX-1", "10021"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Issue.SetSecurityLevel(context.Background(), "EX-1", ""); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	want := []string{`{"fields":{"security":{"id":"10021"}}}` + "\n", `{"fields":{"security":null}}` + "\n"}
+	if len(bodies) != 2 || bodies[0] != want[0] || bodies[1] != want[1] {
+		t.Errorf("Unexpected request bodies\ngot:  %q\nwant: %q", bodies, want)
+	}
+}
@@ -0,0 +1,152 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// IssueTypeService handles issue types and issue type schemes for the Jira instance / API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-types/
+type IssueTypeService service
+
+// Hierarchy levels of issue types, for IssueTypeCreatePayload.HierarchyLevel
+const (
+	IssueTypeHierarchyLevelSubtask  = -1
+	IssueTypeHierarchyLevelStandard = 0
+)
+
+// IssueTypeCreatePayload is an issue type created by IssueTypeService.Create.
+type IssueTypeCreatePayload struct {
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// HierarchyLevel is one of the IssueTypeHierarchyLevel constants.
+	HierarchyLevel int `json:"hierarchyLevel" structs:"hierarchyLevel"`
+}
+
+// IssueTypeUpdatePayload are the changes of IssueTypeService.Update. Empty attributes are left unchanged.
+type IssueTypeUpdatePayload struct {
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	AvatarID    int    `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+}
+
+// GetList returns all issue types visible to the user.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-types/#api-rest-api-2-issuetype-get
+func (s *IssueTypeService) GetList(ctx context.Context) ([]IssueType, *Response, error) {
+	issueTypes := []IssueType{}
+	resp, err := s.do(ctx, http.MethodGet, "rest/api/2/issuetype", nil, &issueTypes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issueTypes, resp, nil
+}
+
+// Get returns the issue type issueTypeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-types/#api-rest-api-2-issuetype-id-get
+func (s *IssueTypeService) Get(ctx context.Context, issueTypeID string) (*IssueType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s", url.PathEscape(issueTypeID))
+	issueType := new(IssueType)
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, issueType)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issueType, resp, nil
+}
+
+// Create creates an issue type and adds it to the default issue type scheme.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-types/#api-rest-api-2-issuetype-post
+func (s *IssueTypeService) Create(ctx context.Context, payload *IssueTypeCreatePayload) (*IssueType, *Response, error) {
+	issueType := new(IssueType)
+	resp, err := s.do(ctx, http.MethodPost, "rest/api/2/issuetype", payload, issueType)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issueType, resp, nil
+}
+
+// Update changes the issue type issueTypeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-types/#api-rest-api-2-issuetype-id-put
+func (s *IssueTypeService) Update(ctx context.Context, issueTypeID string, payload *IssueTypeUpdatePayload) (*IssueType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s", url.PathEscape(issueTypeID))
+	issueType := new(IssueType)
+	resp, err := s.do(ctx, http.MethodPut, apiEndpoint, payload, issueType)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issueType, resp, nil
+}
+
+// Delete deletes the issue type issueTypeID.
+// If issues of the type exist, they are moved to the issue type alternativeID,
+// see GetAlternatives for the issue types allowed. Otherwise alternativeID can be empty.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-types/#api-rest-api-2-issuetype-id-delete
+func (s *IssueTypeService) Delete(ctx context.Context, issueTypeID, alternativeID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s", url.PathEscape(issueTypeID))
+	if alternativeID != "" {
+		apiEndpoint += "?alternativeIssueTypeId=" + url.QueryEscape(alternativeID)
+	}
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// GetAlternatives returns the issue types the issues of the issue type issueTypeID can be moved to when it is deleted.
+// These are the issue types using the same workflow, field configuration and screen scheme in all projects.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-types/#api-rest-api-2-issuetype-id-alternatives-get
+func (s *IssueTypeService) GetAlternatives(ctx context.Context, issueTypeID string) ([]IssueType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s/alternatives", url.PathEscape(issueTypeID))
+	issueTypes := []IssueType{}
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, &issueTypes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issueTypes, resp, nil
+}
+
+// LoadAvatar uploads image as custom avatar of the issue type issueTypeID.
+// contentType is the media type of image, like "image/png".
+// The avatar is not selected automatically, use Update with its ID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-types/#api-rest-api-2-issuetype-id-avatar2-post
+func (s *IssueTypeService) LoadAvatar(ctx context.Context, issueTypeID, contentType string, image io.Reader, options *AvatarUploadOptions) (*Avatar, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s/avatar2", url.PathEscape(issueTypeID))
+	u, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRawRequest(ctx, http.MethodPost, u, image)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Atlassian-Token", "nocheck")
+
+	avatar := new(Avatar)
+	resp, err := s.client.Do(req, avatar)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return avatar, resp, nil
+}
+
+// do sends a request with the optional body to apiEndpoint and decodes the response into v, if not nil.
+func (s *IssueTypeService) do(ctx context.Context, method, apiEndpoint string, body, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,171 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// IssueTypeScheme defines the issue types available in the projects using it.
+type IssueTypeScheme struct {
+	ID                 string `json:"id" structs:"id"`
+	Name               string `json:"name" structs:"name"`
+	Description        string `json:"description,omitempty" structs:"description,omitempty"`
+	DefaultIssueTypeID string `json:"defaultIssueTypeId,omitempty" structs:"defaultIssueTypeId,omitempty"`
+	IsDefault          bool   `json:"isDefault,omitempty" structs:"isDefault,omitempty"`
+}
+
+// IssueTypeSchemePayload is an issue type scheme created by IssueTypeService.CreateScheme.
+type IssueTypeSchemePayload struct {
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// DefaultIssueTypeID has to be one of IssueTypeIDs.
+	DefaultIssueTypeID string `json:"defaultIssueTypeId,omitempty" structs:"defaultIssueTypeId,omitempty"`
+	// IssueTypeIDs are the issue types of the scheme, in their order. At least one standard issue type is required.
+	IssueTypeIDs []string `json:"issueTypeIds" structs:"issueTypeIds"`
+}
+
+// IssueTypeSchemeUpdatePayload are the changes of IssueTypeService.UpdateScheme. Empty attributes are left unchanged.
+type IssueTypeSchemeUpdatePayload struct {
+	Name               string `json:"name,omitempty" structs:"name,omitempty"`
+	Description        string `json:"description,omitempty" structs:"description,omitempty"`
+	DefaultIssueTypeID string `json:"defaultIssueTypeId,omitempty" structs:"defaultIssueTypeId,omitempty"`
+}
+
+// IssueTypeSchemeItem is an issue type of an issue type scheme.
+type IssueTypeSchemeItem struct {
+	IssueTypeSchemeID string `json:"issueTypeSchemeId" structs:"issueTypeSchemeId"`
+	IssueTypeID       string `json:"issueTypeId" structs:"issueTypeId"`
+}
+
+// IssueTypeSchemeListOptions specifies the optional parameters for IssueTypeService.GetSchemes.
+type IssueTypeSchemeListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// IDs restricts the results to the issue type schemes with these IDs.
+	IDs []int64 `url:"id,omitempty"`
+	// QueryString restricts the results to issue type schemes with a name containing this string.
+	QueryString string `url:"queryString,omitempty"`
+	// OrderBy sorts the issue type schemes, like "name" or "-id".
+	OrderBy string `url:"orderBy,omitempty"`
+	// Expand additional attributes, like "projects" or "issueTypes".
+	Expand string `url:"expand,omitempty"`
+}
+
+// GetSchemes returns one page of the issue type schemes matching the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-schemes/#api-rest-api-2-issuetypescheme-get
+func (s *IssueTypeService) GetSchemes(ctx context.Context, options *IssueTypeSchemeListOptions) (*PagedList[IssueTypeScheme], *Response, error) {
+	u, err := addOptions("rest/api/2/issuetypescheme", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[IssueTypeScheme](ctx, s.client, u, agilePaging)
+}
+
+// CreateScheme creates an issue type scheme.
+// It can be associated with projects via ProjectService.SetIssueTypeScheme.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-schemes/#api-rest-api-2-issuetypescheme-post
+func (s *IssueTypeService) CreateScheme(ctx context.Context, payload *IssueTypeSchemePayload) (*IssueTypeScheme, *Response, error) {
+	result := struct {
+		IssueTypeSchemeID string `json:"issueTypeSchemeId"`
+	}{}
+	resp, err := s.do(ctx, http.MethodPost, "rest/api/2/issuetypescheme", payload, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	scheme := &IssueTypeScheme{
+		ID:                 result.IssueTypeSchemeID,
+		Name:               payload.Name,
+		Description:        payload.Description,
+		DefaultIssueTypeID: payload.DefaultIssueTypeID,
+	}
+	return scheme, resp, nil
+}
+
+// UpdateScheme changes the issue type scheme schemeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-schemes/#api-rest-api-2-issuetypescheme-issuetypeschemeid-put
+func (s *IssueTypeService) UpdateScheme(ctx context.Context, schemeID string, payload *IssueTypeSchemeUpdatePayload) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetypescheme/%s", url.PathEscape(schemeID))
+	return s.do(ctx, http.MethodPut, apiEndpoint, payload, nil)
+}
+
+// DeleteScheme deletes the issue type scheme schemeID.
+// Projects using it are associated with the default issue type scheme.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-schemes/#api-rest-api-2-issuetypescheme-issuetypeschemeid-delete
+func (s *IssueTypeService) DeleteScheme(ctx context.Context, schemeID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetypescheme/%s", url.PathEscape(schemeID))
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// IssueTypeSchemeItemOptions specifies the optional parameters for IssueTypeService.GetSchemeItems.
+type IssueTypeSchemeItemOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// SchemeIDs restricts the results to the issue types of these issue type schemes.
+	SchemeIDs []int64 `url:"issueTypeSchemeId,omitempty"`
+}
+
+// GetSchemeItems returns one page of the issue types of the issue type schemes of the options, in their order.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-schemes/#api-rest-api-2-issuetypescheme-mapping-get
+func (s *IssueTypeService) GetSchemeItems(ctx context.Context, options *IssueTypeSchemeItemOptions) (*PagedList[IssueTypeSchemeItem], *Response, error) {
+	u, err := addOptions("rest/api/2/issuetypescheme/mapping", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[IssueTypeSchemeItem](ctx, s.client, u, agilePaging)
+}
+
+// AddSchemeIssueTypes adds the issue types to the end of the issue type scheme schemeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-schemes/#api-rest-api-2-issuetypescheme-issuetypeschemeid-issuetype-put
+func (s *IssueTypeService) AddSchemeIssueTypes(ctx context.Context, schemeID string, issueTypeIDs []string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetypescheme/%s/issuetype", url.PathEscape(schemeID))
+	body := struct {
+		IssueTypeIDs []string `json:"issueTypeIds"`
+	}{issueTypeIDs}
+	return s.do(ctx, http.MethodPut, apiEndpoint, &body, nil)
+}
+
+// RemoveSchemeIssueType removes the issue type issueTypeID from the issue type scheme schemeID.
+// The default issue type of the scheme and issue types used by issues of its projects can't be removed.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-schemes/#api-rest-api-2-issuetypescheme-issuetypeschemeid-issuetype-issuetypeid-delete
+func (s *IssueTypeService) RemoveSchemeIssueType(ctx context.Context, schemeID, issueTypeID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetypescheme/%s/issuetype/%s", url.PathEscape(schemeID), url.PathEscape(issueTypeID))
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// Positions of issue types in an issue type scheme, for IssueTypeSchemeMove.Position
+const (
+	IssueTypeSchemePositionFirst = "First"
+	IssueTypeSchemePositionLast  = "Last"
+)
+
+// IssueTypeSchemeMove describes where IssueTypeService.MoveSchemeIssueTypes moves issue types to.
+// Either After or Position has to be set.
+type IssueTypeSchemeMove struct {
+	// IssueTypeIDs are the issue types to move, in their new order.
+	IssueTypeIDs []string `json:"issueTypeIds" structs:"issueTypeIds"`
+	// After is the ID of the issue type the issue types are placed after.
+	After string `json:"after,omitempty" structs:"after,omitempty"`
+	// Position is one of the IssueTypeSchemePosition constants.
+	Position string `json:"position,omitempty" structs:"position,omitempty"`
+}
+
+// MoveSchemeIssueTypes changes the order of the issue types in the issue type scheme schemeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-schemes/#api-rest-api-2-issuetypescheme-issuetypeschemeid-issuetype-move-put
+func (s *IssueTypeService) MoveSchemeIssueTypes(ctx context.Context, schemeID string, move *IssueTypeSchemeMove) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetypescheme/%s/issuetype/move", url.PathEscape(schemeID))
+	return s.do(ctx, http.MethodPut, apiEndpoint, move, nil)
+}
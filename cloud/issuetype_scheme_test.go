@@ -0,0 +1,125 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestIssueTypeService_GetSchemes(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuetypescheme"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?id=10000&id=10001")
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":2,"isLast":true,"values":[{"id":"10000","name":"Default Issue Type Scheme","description":"Default issue type scheme is the list of global issue types.","isDefault":true},{"id":"10001","name":"SUP: Kanban Issue Type Scheme","defaultIssueTypeId":"10003"}]}`)
+	})
+
+	page, _, err := testClient.IssueType.GetSchemes(context.Background(), &IssueTypeSchemeListOptions{IDs: []int64{10000, 10001}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 2 || !page.Values[0].IsDefault || page.Values[1].DefaultIssueTypeID != "10003" {
+		t.Errorf("Unexpected issue type schemes %+v", page.Values)
+	}
+}
+
+func TestIssueTypeService_CreateUpdateDeleteScheme(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issuetypescheme", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		want := `{"name":"Kanban Issue Type Scheme","defaultIssueTypeId":"10002","issueTypeIds":["10001","10002"]}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"issueTypeSchemeId":"10010"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issuetypescheme/10010", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"defaultIssueTypeId":"10001"}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+		case http.MethodDelete:
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	scheme, _, err := testClient.IssueType.CreateScheme(context.Background(), &IssueTypeSchemePayload{
+		Name:               "Kanban Issue Type Scheme",
+		DefaultIssueTypeID: "10002",
+		IssueTypeIDs:       []string{"10001", "10002"},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if scheme.ID != "10010" || scheme.Name != "Kanban Issue Type Scheme" {
+		t.Errorf("Unexpected issue type scheme %+v", scheme)
+	}
+	if _, err := testClient.IssueType.UpdateScheme(context.Background(), scheme.ID, &IssueTypeSchemeUpdatePayload{DefaultIssueTypeID: "10001"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.IssueType.DeleteScheme(context.Background(), scheme.ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueTypeService_SchemeItems(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issuetypescheme/mapping", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/issuetypescheme/mapping?issueTypeSchemeId=10010")
+		fmt.Fprint(w, `{"maxResults":100,"startAt":0,"total":2,"isLast":true,"values":[{"issueTypeSchemeId":"10010","issueTypeId":"10001"},{"issueTypeSchemeId":"10010","issueTypeId":"10002"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issuetypescheme/10010/issuetype", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"issueTypeIds":["10003"]}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/issuetypescheme/10010/issuetype/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"issueTypeIds":["10003"],"position":"First"}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/issuetypescheme/10010/issuetype/10001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	page, _, err := testClient.IssueType.GetSchemeItems(context.Background(), &IssueTypeSchemeItemOptions{SchemeIDs: []int64{10010}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 2 || page.Values[1].IssueTypeID != "10002" {
+		t.Errorf("Unexpected items %+v", page.Values)
+	}
+	if _, err := testClient.IssueType.AddSchemeIssueTypes(context.Background(), "10010", []string{"10003"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	move := &IssueTypeSchemeMove{IssueTypeIDs: []string{"10003"}, Position: IssueTypeSchemePositionFirst}
+	if _, err := testClient.IssueType.MoveSchemeIssueTypes(context.Background(), "10010", move); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.IssueType.RemoveSchemeIssueType(context.Background(), "10010", "10001"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
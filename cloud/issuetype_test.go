@@ -0,0 +1,126 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestIssueTypeService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuetype"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `[{"self":"https://your-domain.atlassian.net/rest/api/2/issueType/3","id":"3","description":"A task that needs to be done.","iconUrl":"https://your-domain.atlassian.net/secure/viewavatar?size=xsmall&avatarId=10299&avatarType=issuetype","name":"Task","subtask":false,"avatarId":1},{"self":"https://your-domain.atlassian.net/rest/api/2/issueType/1","id":"1","description":"A problem with the software.","name":"Bug","subtask":false,"avatarId":10002}]`)
+	})
+
+	issueTypes, _, err := testClient.IssueType.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issueTypes) != 2 || issueTypes[1].Name != "Bug" {
+		t.Errorf("Unexpected issue types %+v", issueTypes)
+	}
+}
+
+func TestIssueTypeService_CreateUpdateDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issuetype", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"name":"Procurement","description":"A purchase request","hierarchyLevel":0}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10010","name":"Procurement","description":"A purchase request","subtask":false,"avatarId":10318}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issuetype/10010", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"avatarId":10400}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+			fmt.Fprint(w, `{"id":"10010","name":"Procurement","avatarId":10400}`)
+		case http.MethodDelete:
+			testRequestURL(t, r, "/rest/api/2/issuetype/10010?alternativeIssueTypeId=3")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	issueType, _, err := testClient.IssueType.Create(context.Background(), &IssueTypeCreatePayload{Name: "Procurement", Description: "A purchase request", HierarchyLevel: IssueTypeHierarchyLevelStandard})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	issueType, _, err = testClient.IssueType.Update(context.Background(), issueType.ID, &IssueTypeUpdatePayload{AvatarID: 10400})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issueType.AvatarID != 10400 {
+		t.Errorf("Expected avatar 10400, got %d", issueType.AvatarID)
+	}
+	if _, err := testClient.IssueType.Delete(context.Background(), issueType.ID, "3"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueTypeService_GetAlternatives(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuetype/10010/alternatives"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `[{"id":"3","name":"Task","subtask":false}]`)
+	})
+
+	issueTypes, _, err := testClient.IssueType.GetAlternatives(context.Background(), "10010")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issueTypes) != 1 || issueTypes[0].ID != "3" {
+		t.Errorf("Unexpected alternatives %+v", issueTypes)
+	}
+}
+
+func TestIssueTypeService_LoadAvatar(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuetype/10010/avatar2"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testapiEndpoint+"?size=32")
+		if got := r.Header.Get("Content-Type"); got != "image/png" {
+			t.Errorf("Expected content type image/png, got %s", got)
+		}
+		if got := r.Header.Get("X-Atlassian-Token"); got != "nocheck" {
+			t.Errorf("Expected X-Atlassian-Token nocheck, got %s", got)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "png" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10400","isSystemAvatar":false,"isSelected":false,"isDeletable":true}`)
+	})
+
+	avatar, _, err := testClient.IssueType.LoadAvatar(context.Background(), "10010", "image/png", bytes.NewBufferString("png"), &AvatarUploadOptions{Size: 32})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if avatar.ID != "10400" || !avatar.IsDeletable {
+		t.Errorf("Unexpected avatar %+v", avatar)
+	}
+}
@@ -3,6 +3,7 @@ package cloud
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -280,6 +281,54 @@ func TestClient_Do(t *testing.T) {
 	}
 }
 
+func TestDo_Typed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	type foo struct {
+		A string
+	}
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	body, resp, err := Do[foo](testClient, req)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Response code = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+
+	want := &foo{"a"}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("Response body = %v, want %v", body, want)
+	}
+}
+
+func TestDo_TypedHTTPError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	body, resp, err := Do[struct{}](testClient, req)
+	if err == nil {
+		t.Error("Expected HTTP 400 error.")
+	}
+	if body != nil {
+		t.Errorf("Expected no body. Got %v", body)
+	}
+	if resp == nil || resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected the response to be returned. Got %v", resp)
+	}
+}
+
 func TestClient_Do_HTTPResponse(t *testing.T) {
 	setup()
 	defer teardown()
@@ -338,3 +387,208 @@ func TestClient_Do_RedirectLoop(t *testing.T) {
 		t.Errorf("Expected a URL error; got %+v.", err)
 	}
 }
+
+func TestNewClient_WithRequestTimeout_Negative(t *testing.T) {
+	c, err := NewClient(testJiraInstanceURL, nil, WithRequestTimeout(-1*time.Second))
+	if err == nil {
+		t.Error("Expected an error. Got none")
+	}
+	if c != nil {
+		t.Errorf("Expected no client. Got %+v", c)
+	}
+}
+
+func TestClient_Do_RequestTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	c, err := NewClient(testServer.URL, nil, WithRequestTimeout(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("An error occurred. Expected nil. Got %+v.", err)
+	}
+
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	_, err = c.Do(req, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected a deadline exceeded error. Got %+v.", err)
+	}
+}
+
+func TestClient_Do_PerCallTimeoutOverridesDefault(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	c, err := NewClient(testServer.URL, nil, WithRequestTimeout(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("An error occurred. Expected nil. Got %+v.", err)
+	}
+
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, "/", nil, Timeout(5*time.Second))
+	resp, err := c.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Expected no error. Got %+v.", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Errorf("Error on reading HTTP Response = %v", err)
+	}
+	if got, want := string(body), `{"A":"a"}`; got != want {
+		t.Errorf("Response body = %v, want %v", got, want)
+	}
+}
+
+func TestClient_Do_KeepRawBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	type foo struct {
+		A string
+	}
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":"a","B":"b"}`)
+	})
+
+	c, err := NewClient(testServer.URL, nil, KeepRawBody())
+	if err != nil {
+		t.Fatalf("An error occurred. Expected nil. Got %+v.", err)
+	}
+
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	body := new(foo)
+	resp, err := c.Do(req, body)
+	if err != nil {
+		t.Fatalf("Expected no error. Got %+v.", err)
+	}
+
+	if want := (&foo{"a"}); !reflect.DeepEqual(body, want) {
+		t.Errorf("Response body = %v, want %v", body, want)
+	}
+	if got, want := string(resp.RawBody), `{"A":"a","B":"b"}`; got != want {
+		t.Errorf("Response raw body = %v, want %v", got, want)
+	}
+}
+
+func TestClient_Do_NoRawBodyByDefault(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	resp, _ := testClient.Do(req, &struct{ A string }{})
+	if resp.RawBody != nil {
+		t.Errorf("Expected no raw body. Got %s", resp.RawBody)
+	}
+}
+
+func TestNewClient_WithHTTPClientOption(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Minute}
+
+	c, err := NewClient(testJiraInstanceURL, nil, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if c.client != httpClient {
+		t.Errorf("Expected the injected HTTP client. Got %+v", c.client)
+	}
+
+	if _, err := NewClient(testJiraInstanceURL, nil, WithHTTPClient(nil)); err == nil {
+		t.Error("Expected an error for a nil HTTP client. Got none")
+	}
+}
+
+func TestClient_NewRequest_UserAgentAndHeaders(t *testing.T) {
+	c, _ := NewClient(testJiraInstanceURL, nil, WithUserAgent("my-sync/1.0"), WithHeader("X-Team", "platform"), WithHeader("Content-Type", "text/plain"))
+
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, "rest/api/2/myself", nil)
+	if got := req.Header.Get("User-Agent"); got != "my-sync/1.0" {
+		t.Errorf("User-Agent = %q, want %q", got, "my-sync/1.0")
+	}
+	if got := req.Header.Get("X-Team"); got != "platform" {
+		t.Errorf("X-Team = %q, want %q", got, "platform")
+	}
+	if got := req.Header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Expected the Content-Type of the library to win. Got %q", got)
+	}
+}
+
+func TestClient_NewRequest_DefaultUserAgent(t *testing.T) {
+	c, _ := NewClient(testJiraInstanceURL, nil)
+
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, "rest/api/2/myself", nil)
+	if got := req.Header.Get("User-Agent"); got != defaultUserAgent {
+		t.Errorf("User-Agent = %q, want %q", got, defaultUserAgent)
+	}
+}
+
+func TestClient_NewRequest_APIVersion(t *testing.T) {
+	c, _ := NewClient(testJiraInstanceURL, nil, WithAPIVersion("latest"))
+
+	tests := map[string]string{
+		"rest/api/2/issue/EX-1?fields=summary": testJiraInstanceURL + "rest/api/latest/issue/EX-1?fields=summary",
+		"/rest/api/2/group/member":             testJiraInstanceURL + "rest/api/latest/group/member",
+		"rest/agile/1.0/board":                 testJiraInstanceURL + "rest/agile/1.0/board",
+	}
+	for in, want := range tests {
+		req, _ := c.NewRequest(context.Background(), http.MethodGet, in, nil)
+		if got := req.URL.String(); got != want {
+			t.Errorf("NewRequest(%q) URL = %q, want %q", in, got, want)
+		}
+	}
+
+	if _, err := NewClient(testJiraInstanceURL, nil, WithAPIVersion("v2")); err == nil {
+		t.Error("Expected an error for an invalid API version. Got none")
+	}
+}
+
+func TestClient_Do_MaxResponseBytes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":"aaaaaaaaaaaaaaaaaaaa"}`)
+	})
+
+	c, _ := NewClient(testServer.URL, nil, WithMaxResponseBytes(10))
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	_, err := c.Do(req, &struct{ A string }{})
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Errorf("Expected ErrResponseTooLarge. Got %v", err)
+	}
+
+	c, _ = NewClient(testServer.URL, nil, WithMaxResponseBytes(1024))
+	req, _ = c.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	if _, err := c.Do(req, &struct{ A string }{}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	// Bodies read by the caller, like attachment downloads, are not limited
+	c, _ = NewClient(testServer.URL, nil, WithMaxResponseBytes(10))
+	req, _ = c.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	resp, err := c.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	defer resp.Body.Close()
+	if body, err := io.ReadAll(resp.Body); err != nil || string(body) != `{"A":"aaaaaaaaaaaaaaaaaaaa"}` {
+		t.Errorf("Expected the complete body. Got %q, %v", body, err)
+	}
+}
@@ -0,0 +1,219 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+// JQLService handles the Jira Query Language (JQL) for the Jira instance / API,
+// like validating queries before running a search.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-jql/
+type JQLService service
+
+// Validation modes of JQLService.Parse
+const (
+	// JQLValidationStrict reports syntax errors as well as unknown fields, functions and values.
+	JQLValidationStrict = "strict"
+	// JQLValidationWarn reports syntax errors, and unknown fields, functions and values as warnings.
+	JQLValidationWarn = "warn"
+	// JQLValidationNone only reports syntax errors.
+	JQLValidationNone = "none"
+)
+
+// ParsedJQLQuery is the result of parsing a single query.
+type ParsedJQLQuery struct {
+	Query string `json:"query" structs:"query"`
+	// Structure is the abstract syntax tree of the query. It is nil if the query has syntax errors.
+	Structure *JQLQuery `json:"structure,omitempty" structs:"structure,omitempty"`
+	Errors    []string  `json:"errors,omitempty" structs:"errors,omitempty"`
+	Warnings  []string  `json:"warnings,omitempty" structs:"warnings,omitempty"`
+}
+
+// JQLQuery is the structure of a parsed query.
+type JQLQuery struct {
+	Where   *JQLQueryClause        `json:"where,omitempty" structs:"where,omitempty"`
+	OrderBy *JQLQueryOrderByClause `json:"orderBy,omitempty" structs:"orderBy,omitempty"`
+}
+
+// JQLQueryClause is a clause of a parsed query.
+// A compound clause has an Operator like "and", "or" or "not" and Clauses.
+// A field clause has a Field, an Operator like "=" or "in", and an Operand.
+// "was" and "changed" clauses may have Predicates like "by" or "after".
+type JQLQueryClause struct {
+	Operator   string              `json:"operator,omitempty" structs:"operator,omitempty"`
+	Clauses    []JQLQueryClause    `json:"clauses,omitempty" structs:"clauses,omitempty"`
+	Field      *JQLQueryField      `json:"field,omitempty" structs:"field,omitempty"`
+	Operand    *JQLQueryOperand    `json:"operand,omitempty" structs:"operand,omitempty"`
+	Predicates []JQLQueryPredicate `json:"predicates,omitempty" structs:"predicates,omitempty"`
+}
+
+// JQLQueryField is a field referenced in a parsed query.
+type JQLQueryField struct {
+	Name     string                  `json:"name" structs:"name"`
+	Property []JQLQueryFieldProperty `json:"property,omitempty" structs:"property,omitempty"`
+}
+
+// JQLQueryFieldProperty is an entity property referenced in a parsed query, like issue.property[prop].path.
+type JQLQueryFieldProperty struct {
+	Entity string `json:"entity" structs:"entity"`
+	Key    string `json:"key" structs:"key"`
+	Path   string `json:"path" structs:"path"`
+	Type   string `json:"type,omitempty" structs:"type,omitempty"`
+}
+
+// JQLQueryOperand is the operand of a field clause.
+// It is either a Value, a list of Values, a Function with Arguments or a Keyword like "empty".
+type JQLQueryOperand struct {
+	Value     string            `json:"value,omitempty" structs:"value,omitempty"`
+	Values    []JQLQueryOperand `json:"values,omitempty" structs:"values,omitempty"`
+	Function  string            `json:"function,omitempty" structs:"function,omitempty"`
+	Arguments []string          `json:"arguments,omitempty" structs:"arguments,omitempty"`
+	Keyword   string            `json:"keyword,omitempty" structs:"keyword,omitempty"`
+	// EncodedValue and EncodedOperand are the operand as written in JQL, including quotes.
+	EncodedValue   string `json:"encodedValue,omitempty" structs:"encodedValue,omitempty"`
+	EncodedOperand string `json:"encodedOperand,omitempty" structs:"encodedOperand,omitempty"`
+}
+
+// JQLQueryPredicate is a predicate of a "was" or "changed" clause, like "by currentUser()".
+type JQLQueryPredicate struct {
+	Operator string           `json:"operator" structs:"operator"`
+	Operand  *JQLQueryOperand `json:"operand,omitempty" structs:"operand,omitempty"`
+}
+
+// JQLQueryOrderByClause is the sort order of a parsed query.
+type JQLQueryOrderByClause struct {
+	Fields []JQLQueryOrderByField `json:"fields" structs:"fields"`
+}
+
+// JQLQueryOrderByField is a field of an ORDER BY clause.
+type JQLQueryOrderByField struct {
+	Field     JQLQueryField `json:"field" structs:"field"`
+	Direction string        `json:"direction,omitempty" structs:"direction,omitempty"`
+}
+
+// JQLSyntaxError is an error of a parsed query together with its position, if Jira reported one.
+type JQLSyntaxError struct {
+	Message string
+	// Line and Character are the 1-based position of the error, or 0 if unknown.
+	Line      int
+	Character int
+}
+
+// jqlErrorPosition matches the position Jira appends to syntax errors, like "(line 1, character 23)".
+var jqlErrorPosition = regexp.MustCompile(`\(line (\d+), character (\d+)\)`)
+
+// SyntaxErrors returns the errors of the query together with their positions.
+func (q *ParsedJQLQuery) SyntaxErrors() []JQLSyntaxError {
+	errs := make([]JQLSyntaxError, 0, len(q.Errors))
+	for _, msg := range q.Errors {
+		e := JQLSyntaxError{Message: msg}
+		if m := jqlErrorPosition.FindStringSubmatch(msg); m != nil {
+			e.Line, _ = strconv.Atoi(m[1])
+			e.Character, _ = strconv.Atoi(m[2])
+		}
+		errs = append(errs, e)
+	}
+	return errs
+}
+
+// Parse parses and validates queries, without running them.
+// validation is one of the JQLValidation constants. Jira defaults to JQLValidationStrict.
+// The result contains a ParsedJQLQuery for every query, in the same order.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-jql/#api-rest-api-3-jql-parse-post
+func (s *JQLService) Parse(ctx context.Context, validation string, queries ...string) ([]ParsedJQLQuery, *Response, error) {
+	apiEndpoint := "rest/api/3/jql/parse"
+	if validation != "" {
+		apiEndpoint += "?validation=" + url.QueryEscape(validation)
+	}
+	body := struct {
+		Queries []string `json:"queries"`
+	}{Queries: queries}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(struct {
+		Queries []ParsedJQLQuery `json:"queries"`
+	})
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Queries, resp, nil
+}
+
+// JQLAutocompleteData contains the fields, functions and reserved words that can be used in a query.
+// Suggestions for the values of a field are returned by FieldService.GetValueSuggestions.
+type JQLAutocompleteData struct {
+	VisibleFieldNames    []JQLFieldReference    `json:"visibleFieldNames" structs:"visibleFieldNames"`
+	VisibleFunctionNames []JQLFunctionReference `json:"visibleFunctionNames" structs:"visibleFunctionNames"`
+	JQLReservedWords     []string               `json:"jqlReservedWords" structs:"jqlReservedWords"`
+}
+
+// JQLFieldReference is a field that can be used in a query.
+type JQLFieldReference struct {
+	// Value is the name of the field as used in a query, like "status" or "cf[10010]".
+	Value       string `json:"value" structs:"value"`
+	DisplayName string `json:"displayName" structs:"displayName"`
+	Orderable   string `json:"orderable,omitempty" structs:"orderable,omitempty"`
+	Searchable  string `json:"searchable,omitempty" structs:"searchable,omitempty"`
+	// Auto is "true" if Jira offers value suggestions for the field.
+	Auto string `json:"auto,omitempty" structs:"auto,omitempty"`
+	// CFID is the ID of a custom field, like "cf[10010]".
+	CFID      string   `json:"cfid,omitempty" structs:"cfid,omitempty"`
+	Operators []string `json:"operators,omitempty" structs:"operators,omitempty"`
+	Types     []string `json:"types,omitempty" structs:"types,omitempty"`
+	// Deprecated and DeprecatedSearcherKey mark fields that will be removed from JQL.
+	Deprecated            string `json:"deprecated,omitempty" structs:"deprecated,omitempty"`
+	DeprecatedSearcherKey string `json:"deprecatedSearcherKey,omitempty" structs:"deprecatedSearcherKey,omitempty"`
+}
+
+// JQLFunctionReference is a function that can be used in a query.
+type JQLFunctionReference struct {
+	// Value is the function as used in a query, like "currentUser()".
+	Value       string `json:"value" structs:"value"`
+	DisplayName string `json:"displayName" structs:"displayName"`
+	// IsList is "true" if the function returns a list of values.
+	IsList                              string   `json:"isList,omitempty" structs:"isList,omitempty"`
+	SupportsListAndSingleValueOperators string   `json:"supportsListAndSingleValueOperators,omitempty" structs:"supportsListAndSingleValueOperators,omitempty"`
+	Types                               []string `json:"types,omitempty" structs:"types,omitempty"`
+}
+
+// JQLAutocompleteDataOptions restricts the autocomplete data returned by JQLService.GetAutocompleteData.
+type JQLAutocompleteDataOptions struct {
+	// ProjectIDs restricts the fields to the ones of the projects.
+	ProjectIDs []int64 `json:"projectIds,omitempty"`
+	// IncludeCollapsedFields includes fields with the same name, but a different type, separately.
+	IncludeCollapsedFields bool `json:"includeCollapsedFields,omitempty"`
+}
+
+// GetAutocompleteData returns the fields, functions and reserved words to build a query with,
+// for example to offer completions in a JQL editor.
+// If options are given, the fields are restricted accordingly.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-jql/#api-rest-api-3-jql-autocompletedata-post
+func (s *JQLService) GetAutocompleteData(ctx context.Context, options *JQLAutocompleteDataOptions) (*JQLAutocompleteData, *Response, error) {
+	apiEndpoint := "rest/api/3/jql/autocompletedata"
+	method := http.MethodGet
+	var body interface{}
+	if options != nil {
+		method, body = http.MethodPost, options
+	}
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := new(JQLAutocompleteData)
+	resp, err := s.client.Do(req, data)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return data, resp, nil
+}
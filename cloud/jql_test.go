@@ -0,0 +1,108 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestJQLService_Parse(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/jql/parse"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint+"?validation=strict")
+
+		var body map[string][]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(body["queries"], []string{`project = EX AND status in (Open, "In Progress") ORDER BY created DESC`, "project = "}) {
+			t.Errorf("Unexpected body %v", body)
+		}
+		fmt.Fprint(w, `{"queries":[
+			{"query":"project = EX AND status in (Open, \"In Progress\") ORDER BY created DESC","structure":{
+				"where":{"clauses":[
+					{"field":{"name":"project"},"operator":"=","operand":{"value":"EX","encodedValue":"EX"}},
+					{"field":{"name":"status"},"operator":"in","operand":{"values":[{"value":"Open"},{"value":"In Progress","encodedValue":"\"In Progress\""}],"encodedOperand":"(Open, \"In Progress\")"}}
+				],"operator":"and"},
+				"orderBy":{"fields":[{"field":{"name":"created"},"direction":"desc"}]}}},
+			{"query":"project = ","errors":["Error in the JQL Query: Expecting either a value, list or function but got end of query. (line 1, character 10)"]}
+		]}`)
+	})
+
+	queries, _, err := testClient.JQL.Parse(context.Background(), JQLValidationStrict, `project = EX AND status in (Open, "In Progress") ORDER BY created DESC`, "project = ")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(queries) != 2 {
+		t.Fatalf("Expected 2 parsed queries, got %d", len(queries))
+	}
+
+	where := queries[0].Structure.Where
+	if where.Operator != "and" || len(where.Clauses) != 2 {
+		t.Fatalf("Unexpected where clause %+v", where)
+	}
+	if status := where.Clauses[1]; status.Field.Name != "status" || len(status.Operand.Values) != 2 || status.Operand.Values[1].Value != "In Progress" {
+		t.Errorf("Unexpected status clause %+v", status)
+	}
+	if order := queries[0].Structure.OrderBy.Fields; len(order) != 1 || order[0].Field.Name != "created" || order[0].Direction != "desc" {
+		t.Errorf("Unexpected order %+v", order)
+	}
+
+	if queries[1].Structure != nil {
+		t.Errorf("Expected no structure of an invalid query, got %+v", queries[1].Structure)
+	}
+	errs := queries[1].SyntaxErrors()
+	if len(errs) != 1 || errs[0].Line != 1 || errs[0].Character != 10 {
+		t.Errorf("Unexpected syntax errors %+v", errs)
+	}
+}
+
+func TestJQLService_GetAutocompleteData(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/jql/autocompletedata"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, testAPIEndpoint)
+		if r.Method == http.MethodPost {
+			var body JQLAutocompleteDataOptions
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(body.ProjectIDs, []int64{10000}) {
+				t.Errorf("Unexpected body %+v", body)
+			}
+		} else {
+			testMethod(t, r, http.MethodGet)
+		}
+		fmt.Fprint(w, `{"visibleFieldNames":[{"value":"status","displayName":"Status","orderable":"true","searchable":"true","auto":"true","operators":["=","!=","in","not in"],"types":["com.atlassian.jira.issue.status.Status"]},
+			{"value":"cf[10010]","displayName":"Team - cf[10010]","cfid":"cf[10010]","operators":["="],"types":["java.lang.String"]}],
+			"visibleFunctionNames":[{"value":"currentUser()","displayName":"currentUser()","types":["com.atlassian.jira.user.ApplicationUser"]},{"value":"openSprints()","displayName":"openSprints()","isList":"true","types":["com.atlassian.greenhopper.service.sprint.Sprint"]}],
+			"jqlReservedWords":["empty","and","or","in"]}`)
+	})
+
+	data, _, err := testClient.JQL.GetAutocompleteData(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(data.VisibleFieldNames) != 2 || data.VisibleFieldNames[0].Auto != "true" || data.VisibleFieldNames[1].CFID != "cf[10010]" {
+		t.Errorf("Unexpected fields %+v", data.VisibleFieldNames)
+	}
+	if len(data.VisibleFunctionNames) != 2 || data.VisibleFunctionNames[1].IsList != "true" {
+		t.Errorf("Unexpected functions %+v", data.VisibleFunctionNames)
+	}
+	if len(data.JQLReservedWords) != 4 {
+		t.Errorf("Unexpected reserved words %v", data.JQLReservedWords)
+	}
+
+	if _, _, err := testClient.JQL.GetAutocompleteData(context.Background(), &JQLAutocompleteDataOptions{ProjectIDs: []int64{10000}}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,63 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+)
+
+// RequestLog describes a single attempt of a request sent by the client.
+// It never contains headers or bodies, so credentials don't end up in logs.
+type RequestLog struct {
+	Method string
+	// URL is the request URL without user info and with the "jwt" query parameter redacted.
+	URL string
+	// StatusCode is the HTTP status code of the response, or 0 if no response was received.
+	StatusCode int
+	// Duration is the time until the response headers were received.
+	Duration time.Duration
+	// Attempt is the number of the attempt, starting with 1.
+	Attempt int
+	// Retry reports whether the request is retried after RetryDelay.
+	Retry      bool
+	RetryDelay time.Duration
+	// Err is the network error of the attempt, if any.
+	Err error
+}
+
+// Logger is called by the client after every attempt of a request.
+// Implementations have to be safe for concurrent use.
+type Logger interface {
+	LogRequest(ctx context.Context, entry RequestLog)
+}
+
+// LoggerFunc is an adapter to use an ordinary function as Logger.
+type LoggerFunc func(ctx context.Context, entry RequestLog)
+
+// LogRequest calls f(ctx, entry).
+func (f LoggerFunc) LogRequest(ctx context.Context, entry RequestLog) {
+	f(ctx, entry)
+}
+
+// WithLogger makes the client report every attempt of a request to l.
+func WithLogger(l Logger) ClientOption {
+	return func(c *Client) error {
+		if l == nil {
+			return errors.New("logger must not be nil")
+		}
+		c.logger = l
+		return nil
+	}
+}
+
+// redactURL removes credentials from u.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	if q := redacted.Query(); q.Has("jwt") {
+		q.Set("jwt", "REDACTED")
+		redacted.RawQuery = q.Encode()
+	}
+	return redacted.String()
+}
@@ -2,10 +2,13 @@ package cloud
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 )
 
 func TestIssueService_GetEditMeta_Success(t *testing.T) {
@@ -72,6 +75,14 @@ func TestIssueService_GetEditMeta_Success(t *testing.T) {
 	if attachment["required"] != false {
 		t.Error("Expected attachment to not be required")
 	}
+
+	fieldsMeta, err := editMeta.FieldsMeta()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !fieldsMeta["summary"].Required || fieldsMeta["summary"].Schema.System != "summary" || fieldsMeta["attachment"].Required {
+		t.Errorf("Unexpected typed field meta: %+v", fieldsMeta)
+	}
 }
 
 func TestIssueService_GetEditMeta_Fail(t *testing.T) {
@@ -705,3 +716,237 @@ func TestCreateMetaInfo_GetProjectWithKey_NilForNonExistent(t *testing.T) {
 		t.Errorf("Expected nil, received value")
 	}
 }
+
+func TestIssueService_ValidateCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/issue/createmeta/PROJ/issuetypes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests++
+		fmt.Fprint(w, `{"startAt": 0, "maxResults": 50, "total": 2, "issueTypes": [{"id": "1", "name": "Bug"}, {"id": "2", "name": "Task"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/createmeta/PROJ/issuetypes/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests++
+		// The fields are split across two pages
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"startAt": 0, "maxResults": 3, "total": 4, "fields": [
+				{"fieldId": "project", "key": "project", "required": true, "name": "Project", "hasDefaultValue": false},
+				{"fieldId": "issuetype", "key": "issuetype", "required": true, "name": "Issue Type", "hasDefaultValue": false},
+				{"fieldId": "summary", "key": "summary", "required": true, "name": "Summary", "hasDefaultValue": false}
+			]}`)
+		case "3":
+			fmt.Fprint(w, `{"startAt": 3, "maxResults": 3, "total": 4, "fields": [{
+				"fieldId": "priority",
+				"key": "priority",
+				"required": false,
+				"name": "Priority",
+				"hasDefaultValue": true,
+				"allowedValues": [{"id": "1", "name": "High"}, {"id": "2", "name": "Low"}]
+			}]}`)
+		default:
+			t.Errorf("Unexpected startAt %q", r.URL.Query().Get("startAt"))
+		}
+	})
+
+	issue := &Issue{
+		Fields: &IssueFields{
+			Project:  Project{Key: "PROJ"},
+			Type:     IssueType{Name: "Bug"},
+			Priority: &Priority{Name: "Urgent"},
+			Unknowns: map[string]interface{}{"customfield_99": "value"},
+		},
+	}
+
+	err := testClient.Issue.ValidateCreate(context.Background(), issue)
+
+	var validationErr *CreateValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected a CreateValidationError. Got %v", err)
+	}
+	if len(validationErr.Problems) != 3 {
+		t.Fatalf("Expected 3 problems. Got %+v", validationErr.Problems)
+	}
+	for i, field := range []string{"customfield_99", "priority", "summary"} {
+		if got := validationErr.Problems[i].Field; got != field {
+			t.Errorf("Expected problem %d for field %s. Got %s", i, field, got)
+		}
+	}
+
+	issue.Fields.Summary = "Login fails"
+	issue.Fields.Priority = &Priority{Name: "High"}
+	issue.Fields.Unknowns = nil
+	if err := testClient.Issue.ValidateCreate(context.Background(), issue); err != nil {
+		t.Errorf("Expected a valid issue. Got %v", err)
+	}
+
+	// One request for the issue types and two for the pages of fields
+	if requests != 3 {
+		t.Errorf("Expected the create meta information to be requested once. Got %d requests", requests)
+	}
+
+	testClient.Issue.InvalidateCreateMeta()
+	if err := testClient.Issue.ValidateCreate(context.Background(), issue); err != nil {
+		t.Errorf("Expected a valid issue. Got %v", err)
+	}
+	if requests != 6 {
+		t.Errorf("Expected the create meta information to be requested again after InvalidateCreateMeta. Got %d requests", requests)
+	}
+}
+
+func TestIssueService_ValidateCreate_TTL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/issue/createmeta/PROJ/issuetypes", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"startAt": 0, "maxResults": 50, "total": 1, "issueTypes": [{"id": "1", "name": "Bug"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/createmeta/PROJ/issuetypes/1", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"startAt": 0, "maxResults": 50, "total": 3, "fields": [{"key": "project", "required": true}, {"key": "issuetype", "required": true}, {"key": "summary", "required": true}]}`)
+	})
+
+	client, err := NewClient(testServer.URL, nil, WithCreateMetaTTL(time.Nanosecond))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	issue := &Issue{Fields: &IssueFields{Project: Project{Key: "PROJ"}, Type: IssueType{Name: "Bug"}, Summary: "Login fails"}}
+	for i := 0; i < 2; i++ {
+		if err := client.Issue.ValidateCreate(context.Background(), issue); err != nil {
+			t.Errorf("Expected a valid issue. Got %v", err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if requests != 4 {
+		t.Errorf("Expected the expired create meta information to be requested again. Got %d requests", requests)
+	}
+
+	if _, err := NewClient(testServer.URL, nil, WithCreateMetaTTL(0)); err == nil {
+		t.Error("Expected an error for a TTL of 0")
+	}
+}
+
+func TestMetaIssueType_FieldsMeta(t *testing.T) {
+	data := `{
+		"id": "10001",
+		"name": "Bug",
+		"fields": {
+			"summary": {"required": true, "schema": {"type": "string", "system": "summary"}, "name": "Summary", "operations": ["set"]},
+			"customfield_10010": {
+				"required": false,
+				"schema": {"type": "option-with-child", "custom": "com.atlassian.jira.plugin.system.customfieldtypes:cascadingselect", "customId": 10010},
+				"name": "Location",
+				"allowedValues": [{"id": "1", "value": "Europe", "children": [{"id": "2", "value": "Berlin"}]}]
+			},
+			"priority": {"required": false, "name": "Priority", "hasDefaultValue": true, "allowedValues": [{"id": "3", "name": "Medium"}]}
+		}
+	}`
+	issueType := new(MetaIssueType)
+	if err := json.Unmarshal([]byte(data), issueType); err != nil {
+		t.Fatal(err)
+	}
+
+	fields, err := issueType.FieldsMeta()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(fields) != 3 {
+		t.Fatalf("Expected 3 fields, got %d", len(fields))
+	}
+
+	summary := fields["summary"]
+	if summary.Key != "summary" || !summary.Required || summary.Schema.System != "summary" || len(summary.Operations) != 1 {
+		t.Errorf("Unexpected summary meta: %+v", summary)
+	}
+
+	location := fields["customfield_10010"]
+	if location.Required || location.Schema.CustomID != 10010 {
+		t.Errorf("Unexpected location meta: %+v", location)
+	}
+	if len(location.AllowedValues) != 1 || location.AllowedValues[0].Label() != "Europe" || location.AllowedValues[0].Children[0].Label() != "Berlin" {
+		t.Errorf("Unexpected location allowed values: %+v", location.AllowedValues)
+	}
+
+	priority := fields["priority"]
+	if !priority.HasDefaultValue || len(priority.AllowedValues) != 1 || priority.AllowedValues[0].Label() != "Medium" {
+		t.Errorf("Unexpected priority meta: %+v", priority)
+	}
+}
+
+func TestIssueService_GetCreateMetaIssueTypes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/createmeta/EX/issuetypes"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint+"?maxResults=2&startAt=1")
+		fmt.Fprint(w, `{"startAt":1,"maxResults":2,"total":3,"issueTypes":[{"id":"10001","name":"Bug","subtask":false},{"id":"10002","name":"Sub-task","subtask":true}]}`)
+	})
+
+	page, _, err := testClient.Issue.GetCreateMetaIssueTypes(context.Background(), "EX", &CreateMetaOptions{StartAt: 1, MaxResults: 2})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if page.StartAt != 1 || page.Total != 3 || len(page.IssueTypes) != 2 {
+		t.Fatalf("Unexpected page: %+v", page)
+	}
+	if page.IssueTypes[1].Name != "Sub-task" || !page.IssueTypes[1].Subtasks {
+		t.Errorf("Unexpected issue type: %+v", page.IssueTypes[1])
+	}
+}
+
+func TestIssueService_GetCreateMetaFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/createmeta/EX/issuetypes/10001"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":2,"fields":[
+			{"fieldId":"summary","key":"summary","name":"Summary","required":true,"schema":{"type":"string","system":"summary"}},
+			{"fieldId":"components","key":"components","name":"Components","required":false,"schema":{"type":"array","items":"component","system":"components"},
+			 "allowedValues":[{"self":"https://example.atlassian.net/rest/api/2/component/10000","id":"10000","name":"Backend"}]}
+		]}`)
+	})
+
+	page, _, err := testClient.Issue.GetCreateMetaFields(context.Background(), "EX", "10001", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if page.Total != 2 || len(page.Fields) != 2 {
+		t.Fatalf("Unexpected page: %+v", page)
+	}
+	if f := page.Fields[0]; f.FieldID != "summary" || !f.Required {
+		t.Errorf("Unexpected field: %+v", f)
+	}
+	if f := page.Fields[1]; f.Schema.Items != "component" || len(f.AllowedValues) != 1 || f.AllowedValues[0].Name != "Backend" {
+		t.Errorf("Unexpected field: %+v", f)
+	}
+}
+
+func TestIssueService_GetCreateMetaFields_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/createmeta/EX/issuetypes/99", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages":["Issue type with id 99 not found"],"errors":{}}`)
+	})
+
+	_, _, err := testClient.Issue.GetCreateMetaFields(context.Background(), "EX", "99", nil)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	var jerr *Error
+	if !errors.As(err, &jerr) || jerr.ErrorMessages[0] != "Issue type with id 99 not found" {
+		t.Errorf("Expected Error with the error message, got %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package cloud
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RequestMetrics describes a single attempt of a request for monitoring.
+// All values have a limited cardinality, so they can be used as metric labels.
+type RequestMetrics struct {
+	// Service is the resource addressed by the request, like "issue", "search" or "board".
+	Service string
+	Method  string
+	// Endpoint is the path of the request with IDs and keys replaced by "{id}", like "/rest/api/2/issue/{id}".
+	Endpoint string
+	// StatusCode is the HTTP status code of the response, or 0 if no response was received.
+	StatusCode int
+	// Duration is the time until the response headers were received.
+	Duration time.Duration
+	// Attempt is the number of the attempt, starting with 1.
+	Attempt int
+	// Retry reports whether the request is retried.
+	Retry bool
+	// RateLimited reports whether Jira rejected the request because of rate limiting (429).
+	RateLimited bool
+}
+
+// Metrics receives the metrics of every request attempt sent by the client.
+// A typical implementation for Prometheus increments a request counter (by service and status code),
+// observes a latency histogram and counts rate limited and retried requests.
+// Implementations have to be safe for concurrent use.
+type Metrics interface {
+	ObserveRequest(m RequestMetrics)
+}
+
+// MetricsFunc is an adapter to use an ordinary function as Metrics.
+type MetricsFunc func(m RequestMetrics)
+
+// ObserveRequest calls f(m).
+func (f MetricsFunc) ObserveRequest(m RequestMetrics) {
+	f(m)
+}
+
+// WithMetrics makes the client report the metrics of every attempt of a request to m.
+func WithMetrics(m Metrics) ClientOption {
+	return func(c *Client) error {
+		if m == nil {
+			return errors.New("metrics must not be nil")
+		}
+		c.metrics = m
+		return nil
+	}
+}
+
+// observe reports an attempt of req to the metrics of the client.
+func (c *Client) observe(req *http.Request, resp *http.Response, duration time.Duration, attempt int, retry bool) {
+	if c.metrics == nil {
+		return
+	}
+
+	m := RequestMetrics{
+		Service:  serviceName(req.URL),
+		Method:   req.Method,
+		Endpoint: urlTemplate(req.URL),
+		Duration: duration,
+		Attempt:  attempt,
+		Retry:    retry,
+	}
+	if resp != nil {
+		m.StatusCode = resp.StatusCode
+		m.RateLimited = resp.StatusCode == http.StatusTooManyRequests
+	}
+	c.metrics.ObserveRequest(m)
+}
+
+// serviceName returns the resource addressed by u.
+// It is the first path segment following "rest/<api>/<version>", like "issue" for "rest/api/2/issue/EX-1".
+func serviceName(u *url.URL) string {
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i, segment := range segments {
+		if segment != "rest" {
+			continue
+		}
+		rest := segments[i+1:]
+		if len(rest) > 1 && apiVersionRegex.MatchString(rest[1]) {
+			rest = rest[2:]
+		} else if len(rest) > 0 {
+			rest = rest[1:]
+		}
+		if len(rest) > 0 {
+			return strings.ToLower(rest[0])
+		}
+		break
+	}
+	return ""
+}
@@ -0,0 +1,161 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// GetPreference returns the value of a preference of the current user.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-myself/#api-rest-api-3-mypreferences-get
+func (s *UserService) GetPreference(ctx context.Context, key string) (string, *Response, error) {
+	apiEndpoint := "rest/api/3/mypreferences?key=" + url.QueryEscape(key)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return "", resp, NewJiraError(resp, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", resp, err
+	}
+
+	// Depending on the preference, the value is returned as JSON string or as plain text
+	var value string
+	if err := json.Unmarshal(body, &value); err != nil {
+		value = string(body)
+	}
+	return value, resp, nil
+}
+
+// SetPreference sets the value of a preference of the current user.
+// The preferences "jira.user.locale" and "jira.user.timezone" can't be set this way.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-myself/#api-rest-api-3-mypreferences-put
+func (s *UserService) SetPreference(ctx context.Context, key, value string) (*Response, error) {
+	apiEndpoint := "rest/api/3/mypreferences?key=" + url.QueryEscape(key)
+	req, err := s.client.NewRawRequest(ctx, http.MethodPut, apiEndpoint, strings.NewReader(value))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeletePreference deletes a preference of the current user, which restores the default value.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-myself/#api-rest-api-3-mypreferences-delete
+func (s *UserService) DeletePreference(ctx context.Context, key string) (*Response, error) {
+	apiEndpoint := "rest/api/3/mypreferences?key=" + url.QueryEscape(key)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GetLocale returns the locale of the current user, like "en_US".
+// If the user has no locale set, the default locale of the instance is returned.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-myself/#api-rest-api-3-mypreferences-locale-get
+func (s *UserService) GetLocale(ctx context.Context) (string, *Response, error) {
+	const apiEndpoint = "rest/api/3/mypreferences/locale"
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	locale := new(struct {
+		Locale string `json:"locale"`
+	})
+	resp, err := s.client.Do(req, locale)
+	if err != nil {
+		return "", resp, NewJiraError(resp, err)
+	}
+	return locale.Locale, resp, nil
+}
+
+// SetLocale sets the locale of the current user, like "de_DE".
+// Jira Cloud deprecated this endpoint, the locale is managed in the Atlassian account of the user.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-myself/#api-rest-api-3-mypreferences-locale-put
+func (s *UserService) SetLocale(ctx context.Context, locale string) (*Response, error) {
+	const apiEndpoint = "rest/api/3/mypreferences/locale"
+	body := struct {
+		Locale string `json:"locale"`
+	}{locale}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteLocale resets the locale of the current user to the default locale of the instance.
+// Jira Cloud deprecated this endpoint, the locale is managed in the Atlassian account of the user.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-myself/#api-rest-api-3-mypreferences-locale-delete
+func (s *UserService) DeleteLocale(ctx context.Context) (*Response, error) {
+	const apiEndpoint = "rest/api/3/mypreferences/locale"
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GetTimeZone returns the time zone of a user (by account ID).
+// If accountID is empty, the time zone of the current user is returned.
+func (s *UserService) GetTimeZone(ctx context.Context, accountID string) (*time.Location, *Response, error) {
+	var user *User
+	var resp *Response
+	var err error
+	if accountID == "" {
+		user, resp, err = s.GetCurrentUser(ctx)
+	} else {
+		user, resp, err = s.Get(ctx, accountID)
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+	if user.TimeZone == "" {
+		return nil, resp, fmt.Errorf("jira: no time zone available for user %q", user.AccountID)
+	}
+
+	location, err := time.LoadLocation(user.TimeZone)
+	if err != nil {
+		return nil, resp, err
+	}
+	return location, resp, nil
+}
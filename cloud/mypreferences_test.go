@@ -0,0 +1,152 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestUserService_GetPreference(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/mypreferences"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?key=user.notifications.mimetype")
+		fmt.Fprint(w, `html`)
+	})
+
+	value, _, err := testClient.User.GetPreference(context.Background(), "user.notifications.mimetype")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if value != "html" {
+		t.Errorf("Expected preference value html. Got %q", value)
+	}
+}
+
+func TestUserService_SetPreference(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/mypreferences"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint+"?key=user.notifications.mimetype")
+		if body, _ := io.ReadAll(r.Body); string(body) != "text" {
+			t.Errorf("Expected the raw value as body. Got %q", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.User.SetPreference(context.Background(), "user.notifications.mimetype", "text"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestUserService_DeletePreference(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/mypreferences"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testapiEndpoint+"?key=user.notifications.mimetype")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.User.DeletePreference(context.Background(), "user.notifications.mimetype"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestUserService_GetLocale(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/mypreferences/locale"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"locale":"en_US"}`)
+	})
+
+	locale, _, err := testClient.User.GetLocale(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if locale != "en_US" {
+		t.Errorf("Expected locale en_US. Got %q", locale)
+	}
+}
+
+func TestUserService_GetTimeZone(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/myself", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"accountId":"5b10ac8d82e05b22cc7d4ef5","timeZone":"UTC"}`)
+	})
+
+	location, _, err := testClient.User.GetTimeZone(context.Background(), "")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if location.String() != "UTC" {
+		t.Errorf("Expected time zone UTC. Got %s", location)
+	}
+}
+
+func TestUserService_SetLocale(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/mypreferences/locale"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		if body, _ := io.ReadAll(r.Body); string(body) != `{"locale":"de_DE"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.User.SetLocale(context.Background(), "de_DE"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestUserService_DeleteLocale(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/mypreferences/locale"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.User.DeleteLocale(context.Background()); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestUserService_GetCurrentUser_Expand(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/myself", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/3/myself?expand=groups%2CapplicationRoles")
+		fmt.Fprint(w, `{"accountId":"5b10ac8d82e05b22cc7d4ef5","timeZone":"Europe/Berlin","locale":"de_DE","groups":{"size":1,"items":[{"name":"jira-software-users"}]},"applicationRoles":{"size":1,"items":[{"key":"jira-software"}]}}`)
+	})
+
+	user, _, err := testClient.User.GetCurrentUser(context.Background(), "groups", "applicationRoles")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.Groups.Size != 1 || user.ApplicationRoles.Items[0].Key != "jira-software" {
+		t.Errorf("Unexpected user %+v", user)
+	}
+}
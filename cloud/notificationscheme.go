@@ -0,0 +1,198 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// NotificationSchemeService handles notification schemes for the Jira instance / API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-notification-schemes/
+type NotificationSchemeService service
+
+// NotificationScheme defines who is notified about which events of the issues of a project.
+type NotificationScheme struct {
+	Expand                   string                    `json:"expand,omitempty" structs:"expand,omitempty"`
+	ID                       int64                     `json:"id" structs:"id"`
+	Self                     string                    `json:"self,omitempty" structs:"self,omitempty"`
+	Name                     string                    `json:"name" structs:"name"`
+	Description              string                    `json:"description,omitempty" structs:"description,omitempty"`
+	NotificationSchemeEvents []NotificationSchemeEvent `json:"notificationSchemeEvents,omitempty" structs:"notificationSchemeEvents,omitempty"`
+}
+
+// NotificationSchemeEvent is an event of a notification scheme together with its recipients.
+type NotificationSchemeEvent struct {
+	Event         NotificationEvent   `json:"event" structs:"event"`
+	Notifications []EventNotification `json:"notifications,omitempty" structs:"notifications,omitempty"`
+}
+
+// NotificationEvent is an event notifications are sent for, like "Issue created".
+type NotificationEvent struct {
+	ID          int64  `json:"id" structs:"id"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// EventNotification is a recipient of the notifications of an event.
+// Depending on NotificationType, like "CurrentAssignee", "Group" or "User", one of the other fields is set.
+type EventNotification struct {
+	Expand           string `json:"expand,omitempty" structs:"expand,omitempty"`
+	ID               int64  `json:"id" structs:"id"`
+	NotificationType string `json:"notificationType" structs:"notificationType"`
+	// Parameter identifies the recipient of the notification type, like the name of a group.
+	Parameter    string `json:"parameter,omitempty" structs:"parameter,omitempty"`
+	EmailAddress string `json:"emailAddress,omitempty" structs:"emailAddress,omitempty"`
+	Group        *Group `json:"group,omitempty" structs:"group,omitempty"`
+	Field        *Field `json:"field,omitempty" structs:"field,omitempty"`
+	ProjectRole  *Role  `json:"projectRole,omitempty" structs:"projectRole,omitempty"`
+	User         *User  `json:"user,omitempty" structs:"user,omitempty"`
+}
+
+// NotificationSchemeSearchOptions specifies the optional parameters for NotificationSchemeService.Search.
+type NotificationSchemeSearchOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// IDs restricts the results to the notification schemes with these IDs.
+	IDs []string `url:"id,omitempty"`
+	// ProjectIDs restricts the results to the notification schemes of these projects.
+	ProjectIDs []string `url:"projectId,omitempty"`
+	// OnlyDefault restricts the results to the default notification scheme.
+	OnlyDefault bool `url:"onlyDefault,omitempty"`
+	// Expand is a comma separated list of "all", "field", "group", "notificationSchemeEvents", "projectRole" and "user".
+	// Use "notificationSchemeEvents" to return the events and "all" to return their recipients, too.
+	Expand string `url:"expand,omitempty"`
+}
+
+// NotificationSchemeEventPayload is an event and its recipients added to a notification scheme.
+type NotificationSchemeEventPayload struct {
+	Event         NotificationEventID   `json:"event" structs:"event"`
+	Notifications []NotificationPayload `json:"notifications" structs:"notifications"`
+}
+
+// NotificationEventID references an event of NotificationSchemeEventPayload, like "1" for "Issue created".
+type NotificationEventID struct {
+	ID string `json:"id" structs:"id"`
+}
+
+// NotificationPayload is a recipient added to an event of a notification scheme.
+type NotificationPayload struct {
+	// NotificationType is the type of the recipient, like "CurrentAssignee", "Group" or "User".
+	NotificationType string `json:"notificationType" structs:"notificationType"`
+	// Parameter identifies the recipient of the notification type, like the ID of a group or the account ID of a user.
+	Parameter string `json:"parameter,omitempty" structs:"parameter,omitempty"`
+}
+
+// NotificationSchemePayload is a notification scheme created by NotificationSchemeService.Create.
+type NotificationSchemePayload struct {
+	Name                     string                           `json:"name" structs:"name"`
+	Description              string                           `json:"description,omitempty" structs:"description,omitempty"`
+	NotificationSchemeEvents []NotificationSchemeEventPayload `json:"notificationSchemeEvents,omitempty" structs:"notificationSchemeEvents,omitempty"`
+}
+
+// NotificationSchemeUpdatePayload are the changes of NotificationSchemeService.Update.
+// Empty attributes are left unchanged.
+type NotificationSchemeUpdatePayload struct {
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// Search returns one page of the notification schemes matching the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-notification-schemes/#api-rest-api-2-notificationscheme-get
+func (s *NotificationSchemeService) Search(ctx context.Context, options *NotificationSchemeSearchOptions) (*PagedList[NotificationScheme], *Response, error) {
+	u, err := addOptions("rest/api/2/notificationscheme", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[NotificationScheme](ctx, s.client, u, agilePaging)
+}
+
+// Get returns the notification scheme schemeID.
+// expand is a comma separated list as described in NotificationSchemeSearchOptions.Expand.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-notification-schemes/#api-rest-api-2-notificationscheme-id-get
+func (s *NotificationSchemeService) Get(ctx context.Context, schemeID int64, expand string) (*NotificationScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/notificationscheme/%d", schemeID)
+	if expand != "" {
+		apiEndpoint += "?expand=" + url.QueryEscape(expand)
+	}
+	scheme := new(NotificationScheme)
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, scheme)
+	if err != nil {
+		return nil, resp, err
+	}
+	return scheme, resp, nil
+}
+
+// Create creates a notification scheme.
+// It can be associated with projects via ProjectService.SetNotificationScheme.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-notification-schemes/#api-rest-api-2-notificationscheme-post
+func (s *NotificationSchemeService) Create(ctx context.Context, payload *NotificationSchemePayload) (*NotificationScheme, *Response, error) {
+	result := struct {
+		ID int64 `json:"id,string"`
+	}{}
+	resp, err := s.do(ctx, http.MethodPost, "rest/api/2/notificationscheme", payload, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	scheme := &NotificationScheme{
+		ID:          result.ID,
+		Name:        payload.Name,
+		Description: payload.Description,
+	}
+	return scheme, resp, nil
+}
+
+// Update changes the name and description of the notification scheme schemeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-notification-schemes/#api-rest-api-2-notificationscheme-id-put
+func (s *NotificationSchemeService) Update(ctx context.Context, schemeID int64, payload *NotificationSchemeUpdatePayload) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/notificationscheme/%d", schemeID)
+	return s.do(ctx, http.MethodPut, apiEndpoint, payload, nil)
+}
+
+// Delete deletes the notification scheme schemeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-notification-schemes/#api-rest-api-2-notificationscheme-notificationschemeid-delete
+func (s *NotificationSchemeService) Delete(ctx context.Context, schemeID int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/notificationscheme/%d", schemeID)
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// AddNotifications adds recipients to events of the notification scheme schemeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-notification-schemes/#api-rest-api-2-notificationscheme-id-notification-put
+func (s *NotificationSchemeService) AddNotifications(ctx context.Context, schemeID int64, events []NotificationSchemeEventPayload) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/notificationscheme/%d/notification", schemeID)
+	body := struct {
+		NotificationSchemeEvents []NotificationSchemeEventPayload `json:"notificationSchemeEvents"`
+	}{events}
+	return s.do(ctx, http.MethodPut, apiEndpoint, &body, nil)
+}
+
+// RemoveNotification removes the recipient notificationID, see EventNotification.ID, from the notification scheme schemeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-notification-schemes/#api-rest-api-2-notificationscheme-notificationschemeid-notification-notificationid-delete
+func (s *NotificationSchemeService) RemoveNotification(ctx context.Context, schemeID, notificationID int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/notificationscheme/%d/notification/%d", schemeID, notificationID)
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// do sends a request with the optional body to apiEndpoint and decodes the response into v, if not nil.
+func (s *NotificationSchemeService) do(ctx context.Context, method, apiEndpoint string, body, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
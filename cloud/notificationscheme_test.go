@@ -0,0 +1,149 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestNotificationSchemeService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/notificationscheme"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?expand=all&maxResults=10&projectId=10000")
+		fmt.Fprint(w, `{"maxResults":10,"startAt":0,"total":1,"isLast":true,"values":[{"expand":"notificationSchemeEvents,user,group,projectRole,field,all","id":10100,"self":"https://your-domain.atlassian.net/rest/api/2/notificationscheme/10100","name":"Standard","description":"The standard notification scheme","notificationSchemeEvents":[{"event":{"id":1,"name":"Issue created","description":"This is the issue created event."},"notifications":[{"id":1,"notificationType":"Group","parameter":"276f955c-63d7-42c8-9520-92d01dca0625","group":{"name":"jira-administrators"}},{"id":2,"notificationType":"CurrentAssignee"}]}]}]}`)
+	})
+
+	page, _, err := testClient.NotificationScheme.Search(context.Background(), &NotificationSchemeSearchOptions{
+		MaxResults: 10,
+		ProjectIDs: []string{"10000"},
+		Expand:     "all",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 1 || page.Values[0].ID != 10100 {
+		t.Fatalf("Unexpected notification schemes %+v", page.Values)
+	}
+	events := page.Values[0].NotificationSchemeEvents
+	if len(events) != 1 || events[0].Event.ID != 1 || len(events[0].Notifications) != 2 {
+		t.Errorf("Unexpected events %+v", events)
+	}
+	if group := events[0].Notifications[0].Group; group == nil || group.Name != "jira-administrators" {
+		t.Errorf("Unexpected group %+v", group)
+	}
+}
+
+func TestNotificationSchemeService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/notificationscheme/10100"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?expand=notificationSchemeEvents")
+		fmt.Fprint(w, `{"id":10100,"name":"Standard","notificationSchemeEvents":[{"event":{"id":1,"name":"Issue created"}}]}`)
+	})
+
+	scheme, _, err := testClient.NotificationScheme.Get(context.Background(), 10100, "notificationSchemeEvents")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if scheme.Name != "Standard" || len(scheme.NotificationSchemeEvents) != 1 {
+		t.Errorf("Unexpected notification scheme %+v", scheme)
+	}
+}
+
+func TestNotificationSchemeService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/notificationscheme"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		want := `{"name":"Standard","description":"The standard notification scheme",` +
+			`"notificationSchemeEvents":[{"event":{"id":"1"},"notifications":[{"notificationType":"Group","parameter":"jira-administrators"}]}]}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body\ngot:  %s\nwant: %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10001"}`)
+	})
+
+	scheme, _, err := testClient.NotificationScheme.Create(context.Background(), &NotificationSchemePayload{
+		Name:        "Standard",
+		Description: "The standard notification scheme",
+		NotificationSchemeEvents: []NotificationSchemeEventPayload{{
+			Event:         NotificationEventID{ID: "1"},
+			Notifications: []NotificationPayload{{NotificationType: "Group", Parameter: "jira-administrators"}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if scheme.ID != 10001 || scheme.Name != "Standard" {
+		t.Errorf("Unexpected notification scheme %+v", scheme)
+	}
+}
+
+func TestNotificationSchemeService_UpdateDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/notificationscheme/10001", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"name":"Standard (new)"}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+		case http.MethodDelete:
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.NotificationScheme.Update(context.Background(), 10001, &NotificationSchemeUpdatePayload{Name: "Standard (new)"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.NotificationScheme.Delete(context.Background(), 10001); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestNotificationSchemeService_Notifications(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/notificationscheme/10001/notification", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		body, _ := io.ReadAll(r.Body)
+		want := `{"notificationSchemeEvents":[{"event":{"id":"2"},"notifications":[{"notificationType":"CurrentAssignee"}]}]}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body\ngot:  %s\nwant: %s", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/notificationscheme/10001/notification/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	events := []NotificationSchemeEventPayload{{
+		Event:         NotificationEventID{ID: "2"},
+		Notifications: []NotificationPayload{{NotificationType: "CurrentAssignee"}},
+	}}
+	if _, err := testClient.NotificationScheme.AddNotifications(context.Background(), 10001, events); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.NotificationScheme.RemoveNotification(context.Background(), 10001, 3); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -33,16 +33,6 @@ type OrganizationUsersDTO struct {
 	AccountIds []string `json:"accountIds,omitempty" structs:"accountIds,omitempty"`
 }
 
-// PagedDTO is response of a paged list
-type PagedDTO struct {
-	Size       int           `json:"size,omitempty" structs:"size,omitempty"`
-	Start      int           `json:"start,omitempty" structs:"start,omitempty"`
-	Limit      int           `limit:"size,omitempty" structs:"limit,omitempty"`
-	IsLastPage bool          `json:"isLastPage,omitempty" structs:"isLastPage,omitempty"`
-	Values     []interface{} `values:"isLastPage,omitempty" structs:"values,omitempty"`
-	Expands    []string      `json:"_expands,omitempty" structs:"_expands,omitempty"`
-}
-
 // PropertyKey contains Property key details.
 type PropertyKey struct {
 	Self string `json:"self,omitempty" structs:"self,omitempty"`
@@ -64,27 +54,13 @@ type PropertyKeys struct {
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *OrganizationService) GetAllOrganizations(ctx context.Context, start int, limit int, accountID string) (*PagedDTO, *Response, error) {
+func (s *OrganizationService) GetAllOrganizations(ctx context.Context, start int, limit int, accountID string) (*PagedList[Organization], *Response, error) {
 	apiEndPoint := fmt.Sprintf("rest/servicedeskapi/organization?start=%d&limit=%d", start, limit)
 	if accountID != "" {
 		apiEndPoint += fmt.Sprintf("&accountId=%s", accountID)
 	}
 
-	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndPoint, nil)
-	req.Header.Set("Accept", "application/json")
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	v := new(PagedDTO)
-	resp, err := s.client.Do(req, v)
-	if err != nil {
-		jerr := NewJiraError(resp, err)
-		return nil, resp, jerr
-	}
-
-	return v, resp, nil
+	return getPage[Organization](ctx, s.client, apiEndPoint, serviceDeskPaging)
 }
 
 // CreateOrganization creates an organization by
@@ -297,24 +273,10 @@ func (s *OrganizationService) DeleteProperty(ctx context.Context, organizationID
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *OrganizationService) GetUsers(ctx context.Context, organizationID int, start int, limit int) (*PagedDTO, *Response, error) {
+func (s *OrganizationService) GetUsers(ctx context.Context, organizationID int, start int, limit int) (*PagedList[User], *Response, error) {
 	apiEndPoint := fmt.Sprintf("rest/servicedeskapi/organization/%d/user?start=%d&limit=%d", organizationID, start, limit)
 
-	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndPoint, nil)
-	req.Header.Set("Accept", "application/json")
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	users := new(PagedDTO)
-	resp, err := s.client.Do(req, &users)
-	if err != nil {
-		jerr := NewJiraError(resp, err)
-		return nil, resp, jerr
-	}
-
-	return users, resp, nil
+	return getPage[User](ctx, s.client, apiEndPoint, serviceDeskPaging)
 }
 
 // AddUsers adds users to an organization.
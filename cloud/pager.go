@@ -0,0 +1,335 @@
+package cloud
+
+import "context"
+
+// PageFunc requests the page of a paginated endpoint starting with the item at index startAt.
+type PageFunc[T any] func(ctx context.Context, startAt int) (*PagedList[T], *Response, error)
+
+// Pager iterates over all items of a paginated endpoint.
+// It requests the following page once all items of the current page were consumed,
+// so callers don't need to keep track of startAt and maxResults:
+//
+//	pager := client.Issue.SearchPager("project = EX", nil)
+//	for pager.Next(ctx) {
+//		issue := pager.Value()
+//		// ...
+//	}
+//	if err := pager.Err(); err != nil {
+//		// ...
+//	}
+//
+// A Pager is not safe for concurrent use.
+type Pager[T any] struct {
+	// fetch requests the following page and reports whether it is the last one.
+	fetch func(ctx context.Context) ([]T, bool, *Response, error)
+
+	values []T
+	last   bool
+	resp   *Response
+	index  int
+	value  T
+	err    error
+}
+
+// NewPager returns a Pager requesting its pages via fetch.
+// The first page is requested with startAt 0 by the first call of Pager.Next.
+func NewPager[T any](fetch PageFunc[T]) *Pager[T] {
+	startAt := 0
+	return newPager(func(ctx context.Context) ([]T, bool, *Response, error) {
+		page, resp, err := fetch(ctx, startAt)
+		if err != nil || page == nil {
+			return nil, true, resp, err
+		}
+		startAt = page.StartAt + len(page.Values)
+		return page.Values, page.IsLast, resp, nil
+	})
+}
+
+// newPager returns a Pager requesting the following page via fetch.
+func newPager[T any](fetch func(ctx context.Context) ([]T, bool, *Response, error)) *Pager[T] {
+	return &Pager[T]{fetch: fetch}
+}
+
+// Next advances the pager to the next item, which will then be available via Pager.Value.
+// It returns false if there are no more items or an error occurred.
+// In the latter case, the error is returned by Pager.Err.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	for p.err == nil {
+		if p.index < len(p.values) {
+			p.value = p.values[p.index]
+			p.index++
+			return true
+		}
+
+		if p.last {
+			return false
+		}
+
+		values, last, resp, err := p.fetch(ctx)
+		p.resp = resp
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.values, p.index = values, 0
+		// An empty page would never end
+		p.last = last || len(values) == 0
+	}
+	return false
+}
+
+// Value returns the current item.
+// It is only valid after a call of Pager.Next returned true.
+func (p *Pager[T]) Value() T {
+	return p.value
+}
+
+// Err returns the first error that occurred while requesting a page.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Response returns the response of the last requested page.
+func (p *Pager[T]) Response() *Response {
+	return p.resp
+}
+
+// All consumes the pager and returns all remaining items.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var values []T
+	for p.Next(ctx) {
+		values = append(values, p.Value())
+	}
+	return values, p.Err()
+}
+
+// SearchPager returns a Pager over all issues matching jql.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *IssueService) SearchPager(jql string, options *SearchOptions) *Pager[Issue] {
+	return NewPager(func(ctx context.Context, startAt int) (*PagedList[Issue], *Response, error) {
+		opts := SearchOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		issues, resp, err := s.Search(ctx, jql, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return responsePage(startAt, issues, resp), resp, nil
+	})
+}
+
+// MembersPager returns a Pager over all members of the group name.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *GroupService) MembersPager(name string, options *GroupSearchOptions) *Pager[GroupMember] {
+	return NewPager(func(ctx context.Context, startAt int) (*PagedList[GroupMember], *Response, error) {
+		opts := GroupSearchOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+		if opts.MaxResults == 0 {
+			// Get sends maxResults as given, which makes Jira return an empty page for 0
+			opts.MaxResults = 50
+		}
+
+		members, resp, err := s.Get(ctx, name, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return responsePage(startAt, members, resp), resp, nil
+	})
+}
+
+// SprintsPager returns a Pager over all sprints of the board boardID.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *BoardService) SprintsPager(boardID int64, options *GetAllSprintsOptions) *Pager[Sprint] {
+	return NewPager(func(ctx context.Context, startAt int) (*PagedList[Sprint], *Response, error) {
+		opts := GetAllSprintsOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		return s.GetAllSprints(ctx, boardID, &opts)
+	})
+}
+
+// WorklogsPager returns a Pager over all worklog records of the issue issueID.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *IssueService) WorklogsPager(issueID string, options *GetWorklogsQueryOptions) *Pager[WorklogRecord] {
+	return NewPager(func(ctx context.Context, startAt int) (*PagedList[WorklogRecord], *Response, error) {
+		opts := GetWorklogsQueryOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = int64(startAt)
+
+		worklog, resp, err := s.GetWorklogs(ctx, issueID, WithQueryOptions(&opts))
+		if err != nil {
+			return nil, resp, NewJiraError(resp, err)
+		}
+		return &PagedList[WorklogRecord]{
+			StartAt:    worklog.StartAt,
+			MaxResults: worklog.MaxResults,
+			Total:      worklog.Total,
+			Size:       len(worklog.Worklogs),
+			IsLast:     worklog.StartAt+len(worklog.Worklogs) >= worklog.Total,
+			Values:     worklog.Worklogs,
+		}, resp, nil
+	})
+}
+
+// CommentsPager returns a Pager over all comments of the issue issueID.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *IssueService) CommentsPager(issueID string, options *GetCommentsOptions) *Pager[*Comment] {
+	return NewPager(func(ctx context.Context, startAt int) (*PagedList[*Comment], *Response, error) {
+		opts := GetCommentsOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		comments, resp, err := s.GetComments(ctx, issueID, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &PagedList[*Comment]{
+			StartAt:    comments.StartAt,
+			MaxResults: comments.MaxResults,
+			Total:      comments.Total,
+			Size:       len(comments.Comments),
+			IsLast:     comments.StartAt+len(comments.Comments) >= comments.Total,
+			Values:     comments.Comments,
+		}, resp, nil
+	})
+}
+
+// SearchPager returns a Pager over all projects matching the options.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *ProjectService) SearchPager(options *ProjectSearchOptions) *Pager[Project] {
+	return NewPager(func(ctx context.Context, startAt int) (*PagedList[Project], *Response, error) {
+		opts := ProjectSearchOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		return s.Search(ctx, &opts)
+	})
+}
+
+// FindPager returns a Pager over all users matching the options.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *UserService) FindPager(options *UserSearchOptions) *Pager[User] {
+	return NewPager(func(ctx context.Context, startAt int) (*PagedList[User], *Response, error) {
+		opts := UserSearchOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		users, resp, err := s.Find(ctx, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return userPage(startAt, users), resp, nil
+	})
+}
+
+// FindAssignablePager returns a Pager over all assignable users matching the options.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *UserService) FindAssignablePager(options *UserAssignableSearchOptions) *Pager[User] {
+	return NewPager(func(ctx context.Context, startAt int) (*PagedList[User], *Response, error) {
+		opts := UserAssignableSearchOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		users, resp, err := s.FindAssignable(ctx, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return userPage(startAt, users), resp, nil
+	})
+}
+
+// FindAssignableMultiProjectPager returns a Pager over all users matching the options that are assignable in all of the projects.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *UserService) FindAssignableMultiProjectPager(options *UserMultiProjectAssignableSearchOptions) *Pager[User] {
+	return NewPager(func(ctx context.Context, startAt int) (*PagedList[User], *Response, error) {
+		opts := UserMultiProjectAssignableSearchOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		users, resp, err := s.FindAssignableMultiProject(ctx, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return userPage(startAt, users), resp, nil
+	})
+}
+
+// FindWithPermissionsPager returns a Pager over all users matching the options that hold all of the permissions.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *UserService) FindWithPermissionsPager(options *UserPermissionSearchOptions) *Pager[User] {
+	return NewPager(func(ctx context.Context, startAt int) (*PagedList[User], *Response, error) {
+		opts := UserPermissionSearchOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		users, resp, err := s.FindWithPermissions(ctx, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return userPage(startAt, users), resp, nil
+	})
+}
+
+// FindViewablePager returns a Pager over all users matching the options that can view the issue or project.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *UserService) FindViewablePager(options *UserViewableSearchOptions) *Pager[User] {
+	return NewPager(func(ctx context.Context, startAt int) (*PagedList[User], *Response, error) {
+		opts := UserViewableSearchOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		users, resp, err := s.FindViewable(ctx, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return userPage(startAt, users), resp, nil
+	})
+}
+
+// userPage wraps a page of the user search endpoints into a PagedList.
+// They filter users after selecting the page, so only an empty page reliably marks the end.
+func userPage(startAt int, users []User) *PagedList[User] {
+	return &PagedList[User]{
+		StartAt: startAt,
+		Size:    len(users),
+		IsLast:  len(users) == 0,
+		Values:  users,
+	}
+}
+
+// responsePage wraps the values of an endpoint reporting its pagination information via Response into a PagedList.
+func responsePage[T any](startAt int, values []T, resp *Response) *PagedList[T] {
+	return &PagedList[T]{
+		StartAt:    startAt,
+		MaxResults: resp.MaxResults,
+		Total:      resp.Total,
+		Size:       len(values),
+		IsLast:     startAt+len(values) >= resp.Total,
+		Values:     values,
+	}
+}
@@ -0,0 +1,180 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ErrNoNextPage is returned by PagedList.Next if the list is the last page.
+var ErrNoNextPage = errors.New("no next page available")
+
+// PagedList is one page of a paginated Jira API response.
+//
+// It understands both pagination flavours of Jira:
+// The Agile API (startAt, maxResults, total, isLast) and
+// the Service Management API (start, limit, size, isLastPage).
+// The values of both are mapped to the same fields, so pagination behaves identically everywhere.
+type PagedList[T any] struct {
+	// StartAt is the index of the first item on this page.
+	StartAt int `json:"startAt" structs:"startAt"`
+	// MaxResults is the maximum number of items per page.
+	MaxResults int `json:"maxResults" structs:"maxResults"`
+	// Total is the total number of items.
+	// Not all endpoints return it.
+	Total int `json:"total" structs:"total"`
+	// Size is the number of items on this page.
+	Size int `json:"size" structs:"size"`
+	// IsLast reports whether this is the last page.
+	IsLast  bool     `json:"isLast" structs:"isLast"`
+	Values  []T      `json:"values" structs:"values"`
+	Expands []string `json:"_expands,omitempty" structs:"_expands,omitempty"`
+
+	// next fetches the page following this one.
+	// It is set by the service method that returned the page.
+	next func(ctx context.Context, startAt int) (*PagedList[T], *Response, error)
+}
+
+// UnmarshalJSON maps the pagination fields of the Agile and the Service Management API.
+func (l *PagedList[T]) UnmarshalJSON(data []byte) error {
+	var page struct {
+		StartAt    *int     `json:"startAt"`
+		MaxResults *int     `json:"maxResults"`
+		Total      int      `json:"total"`
+		IsLast     *bool    `json:"isLast"`
+		Start      int      `json:"start"`
+		Limit      int      `json:"limit"`
+		Size       *int     `json:"size"`
+		IsLastPage bool     `json:"isLastPage"`
+		Values     []T      `json:"values"`
+		Expands    []string `json:"_expands"`
+	}
+	if err := json.Unmarshal(data, &page); err != nil {
+		return err
+	}
+
+	l.StartAt, l.MaxResults = page.Start, page.Limit
+	if page.StartAt != nil {
+		l.StartAt = *page.StartAt
+	}
+	if page.MaxResults != nil {
+		l.MaxResults = *page.MaxResults
+	}
+	l.Total = page.Total
+	l.Values = page.Values
+	l.Expands = page.Expands
+
+	l.Size = len(page.Values)
+	if page.Size != nil {
+		l.Size = *page.Size
+	}
+
+	switch {
+	case page.IsLast != nil:
+		l.IsLast = *page.IsLast
+	case page.StartAt != nil:
+		// Some Agile endpoints don't report isLast
+		l.IsLast = l.StartAt+len(l.Values) >= l.Total
+	default:
+		l.IsLast = page.IsLastPage
+	}
+
+	return nil
+}
+
+// HasNext reports whether there is a page following this one.
+func (l *PagedList[T]) HasNext() bool {
+	return !l.IsLast && len(l.Values) > 0 && l.next != nil
+}
+
+// Next fetches the page following this one.
+// It returns ErrNoNextPage if this is the last page.
+func (l *PagedList[T]) Next(ctx context.Context) (*PagedList[T], *Response, error) {
+	if !l.HasNext() {
+		return nil, nil, ErrNoNextPage
+	}
+	return l.next(ctx, l.StartAt+len(l.Values))
+}
+
+// pageValues implements pageInfo.
+func (l *PagedList[T]) pageValues() (startAt, maxResults, total int) {
+	return l.StartAt, l.MaxResults, l.Total
+}
+
+// pageInfo is implemented by all response types carrying pagination information.
+type pageInfo interface {
+	pageValues() (startAt, maxResults, total int)
+}
+
+// paging names the query parameters an API uses to select a page.
+type paging struct {
+	start string
+	limit string
+}
+
+var (
+	// agilePaging is used by the Agile (Jira Software) API.
+	agilePaging = paging{start: "startAt", limit: "maxResults"}
+	// serviceDeskPaging is used by the Service Management API.
+	serviceDeskPaging = paging{start: "start", limit: "limit"}
+)
+
+// getPage requests one page of a paginated endpoint.
+// urlStr contains all query parameters of the first page.
+// The returned page knows how to fetch the following pages via PagedList.Next.
+func getPage[T any](ctx context.Context, c *Client, urlStr string, p paging, opts ...RequestOption) (*PagedList[T], *Response, error) {
+	req, err := c.NewRequest(ctx, http.MethodGet, urlStr, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	list := new(PagedList[T])
+	resp, err := c.Do(req, list)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	list.next = func(ctx context.Context, startAt int) (*PagedList[T], *Response, error) {
+		u, err := url.Parse(urlStr)
+		if err != nil {
+			return nil, nil, err
+		}
+		q := u.Query()
+		q.Set(p.start, strconv.Itoa(startAt))
+		u.RawQuery = q.Encode()
+
+		return getPage[T](ctx, c, u.String(), p, opts...)
+	}
+
+	return list, resp, nil
+}
+
+// withHeader sets the request header key to value.
+func withHeader(key, value string) RequestOption {
+	return func(r *http.Request) error {
+		r.Header.Set(key, value)
+		return nil
+	}
+}
+
+// allPages requests all pages of a paginated endpoint and returns the values of all pages.
+func allPages[T any](ctx context.Context, c *Client, urlStr string, p paging) ([]T, error) {
+	page, _, err := getPage[T](ctx, c, urlStr, p)
+	if err != nil {
+		return nil, err
+	}
+
+	values := page.Values
+	for page.HasNext() {
+		page, _, err = page.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, page.Values...)
+	}
+	return values, nil
+}
@@ -0,0 +1,92 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestPagedList_UnmarshalJSON_Agile(t *testing.T) {
+	list := new(PagedList[Board])
+	err := json.Unmarshal([]byte(`{"startAt":2,"maxResults":2,"total":3,"values":[{"id":3}]}`), list)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if list.StartAt != 2 || list.MaxResults != 2 || list.Total != 3 || list.Size != 1 {
+		t.Errorf("Unexpected pagination values: %+v", list)
+	}
+	if !list.IsLast {
+		t.Error("Expected the page to be the last page")
+	}
+}
+
+func TestPagedList_UnmarshalJSON_ServiceDesk(t *testing.T) {
+	list := new(PagedList[Organization])
+	err := json.Unmarshal([]byte(`{"size":1,"start":5,"limit":1,"isLastPage":false,"values":[{"id":"1","name":"Charlie Cakes Franchises"}]}`), list)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if list.StartAt != 5 || list.MaxResults != 1 || list.Size != 1 {
+		t.Errorf("Unexpected pagination values: %+v", list)
+	}
+	if list.IsLast {
+		t.Error("Expected the page not to be the last page")
+	}
+	if len(list.Values) != 1 || list.Values[0].Name != "Charlie Cakes Franchises" {
+		t.Errorf("Unexpected values: %+v", list.Values)
+	}
+}
+
+func TestPagedList_Next(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/agile/1.0/board"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		switch startAt := r.URL.Query().Get("startAt"); startAt {
+		case "", "0":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"isLast":false,"values":[{"id":1}]}`)
+		case "1":
+			if got := r.URL.Query().Get("type"); got != "scrum" {
+				t.Errorf("Expected options to be kept on the next page. Got type=%q", got)
+			}
+			fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"isLast":true,"values":[{"id":2}]}`)
+		default:
+			t.Errorf("Unexpected startAt %s", startAt)
+		}
+	})
+
+	first, _, err := testClient.Board.GetAllBoards(context.Background(), &BoardListOptions{BoardType: BoardTypeScrum})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !first.HasNext() {
+		t.Fatal("Expected a next page")
+	}
+
+	second, resp, err := first.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(second.Values) != 1 || second.Values[0].ID != 2 {
+		t.Errorf("Unexpected values on second page: %+v", second.Values)
+	}
+	if resp.StartAt != 1 {
+		t.Errorf("Expected Response.StartAt to be 1. Got %d", resp.StartAt)
+	}
+
+	if second.HasNext() {
+		t.Error("Expected no further page")
+	}
+	if _, _, err := second.Next(context.Background()); !errors.Is(err, ErrNoNextPage) {
+		t.Errorf("Expected ErrNoNextPage. Got %v", err)
+	}
+}
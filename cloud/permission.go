@@ -0,0 +1,131 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+)
+
+// PermissionService handles the permissions of the current user for the Jira instance / API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-permissions/
+type PermissionService service
+
+// UserPermission is a permission, as returned by PermissionService.GetMyPermissions and PermissionService.GetAll.
+type UserPermission struct {
+	ID   string        `json:"id,omitempty" structs:"id,omitempty"`
+	Key  PermissionKey `json:"key" structs:"key"`
+	Name string        `json:"name" structs:"name"`
+	// Type is "GLOBAL" or "PROJECT".
+	Type        string `json:"type" structs:"type"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// HavePermission is only returned by PermissionService.GetMyPermissions.
+	HavePermission bool `json:"havePermission,omitempty" structs:"havePermission,omitempty"`
+	DeprecatedKey  bool `json:"deprecatedKey,omitempty" structs:"deprecatedKey,omitempty"`
+}
+
+// MyPermissionsOptions specifies the context of PermissionService.GetMyPermissions.
+// Without a project or issue, the project permissions are reported for any project.
+type MyPermissionsOptions struct {
+	ProjectKey string `url:"projectKey,omitempty"`
+	ProjectID  string `url:"projectId,omitempty"`
+	IssueKey   string `url:"issueKey,omitempty"`
+	IssueID    string `url:"issueId,omitempty"`
+	// Permissions is a comma separated list of the permission keys to report, like "BROWSE_PROJECTS,EDIT_ISSUES".
+	// It is required by Jira Cloud.
+	Permissions string `url:"permissions,omitempty"`
+}
+
+// GetMyPermissions returns the permissions of the current user, keyed by permission key.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-permissions/#api-rest-api-2-mypermissions-get
+func (s *PermissionService) GetMyPermissions(ctx context.Context, options *MyPermissionsOptions) (map[PermissionKey]UserPermission, *Response, error) {
+	u, err := addOptions("rest/api/2/mypermissions", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.getPermissions(ctx, u)
+}
+
+// HasPermission reports whether the current user has the permission in the context of options.
+func (s *PermissionService) HasPermission(ctx context.Context, permission PermissionKey, options *MyPermissionsOptions) (bool, *Response, error) {
+	opts := MyPermissionsOptions{}
+	if options != nil {
+		opts = *options
+	}
+	opts.Permissions = string(permission)
+
+	permissions, resp, err := s.GetMyPermissions(ctx, &opts)
+	if err != nil {
+		return false, resp, err
+	}
+	return permissions[permission].HavePermission, resp, nil
+}
+
+// GetAll returns all permissions of the Jira instance, including the ones defined by apps, keyed by permission key.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-permissions/#api-rest-api-2-permissions-get
+func (s *PermissionService) GetAll(ctx context.Context) (map[PermissionKey]UserPermission, *Response, error) {
+	return s.getPermissions(ctx, "rest/api/2/permissions")
+}
+
+func (s *PermissionService) getPermissions(ctx context.Context, apiEndpoint string) (map[PermissionKey]UserPermission, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := struct {
+		Permissions map[PermissionKey]UserPermission `json:"permissions"`
+	}{}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Permissions, resp, nil
+}
+
+// BulkPermissionsPayload specifies the permissions checked by PermissionService.Check.
+type BulkPermissionsPayload struct {
+	// AccountID is the user whose permissions are checked. By default, the current user.
+	AccountID          string                   `json:"accountId,omitempty" structs:"accountId,omitempty"`
+	GlobalPermissions  []PermissionKey          `json:"globalPermissions,omitempty" structs:"globalPermissions,omitempty"`
+	ProjectPermissions []BulkProjectPermissions `json:"projectPermissions,omitempty" structs:"projectPermissions,omitempty"`
+}
+
+// BulkProjectPermissions are project permissions checked for projects and issues, by ID.
+type BulkProjectPermissions struct {
+	Permissions []PermissionKey `json:"permissions" structs:"permissions"`
+	Projects    []int64         `json:"projects,omitempty" structs:"projects,omitempty"`
+	Issues      []int64         `json:"issues,omitempty" structs:"issues,omitempty"`
+}
+
+// BulkPermissionGrants are the permissions granted, as returned by PermissionService.Check.
+type BulkPermissionGrants struct {
+	GlobalPermissions  []PermissionKey               `json:"globalPermissions" structs:"globalPermissions"`
+	ProjectPermissions []BulkProjectPermissionGrants `json:"projectPermissions" structs:"projectPermissions"`
+}
+
+// BulkProjectPermissionGrants are the projects and issues, by ID, a project permission is granted for.
+type BulkProjectPermissionGrants struct {
+	Permission PermissionKey `json:"permission" structs:"permission"`
+	Projects   []int64       `json:"projects" structs:"projects"`
+	Issues     []int64       `json:"issues" structs:"issues"`
+}
+
+// Check returns which of the global and project permissions of payload are granted.
+// Checking the permissions of another user requires the "Administer Jira" global permission.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-permissions/#api-rest-api-2-permissions-check-post
+func (s *PermissionService) Check(ctx context.Context, payload *BulkPermissionsPayload) (*BulkPermissionGrants, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "rest/api/2/permissions/check", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	grants := new(BulkPermissionGrants)
+	resp, err := s.client.Do(req, grants)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return grants, resp, nil
+}
@@ -11,21 +11,87 @@ import (
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-group-Permissionscheme
 type PermissionSchemeService service
 
+// PermissionKey represents the key of a built-in Jira permission.
+type PermissionKey string
+
+const (
+	PermissionAdministerProjects      PermissionKey = "ADMINISTER_PROJECTS"
+	PermissionBrowseProjects          PermissionKey = "BROWSE_PROJECTS"
+	PermissionViewDevTools            PermissionKey = "VIEW_DEV_TOOLS"
+	PermissionViewReadOnlyWorkflow    PermissionKey = "VIEW_READONLY_WORKFLOW"
+	PermissionCreateIssues            PermissionKey = "CREATE_ISSUES"
+	PermissionEditIssues              PermissionKey = "EDIT_ISSUES"
+	PermissionTransitionIssues        PermissionKey = "TRANSITION_ISSUES"
+	PermissionScheduleIssues          PermissionKey = "SCHEDULE_ISSUES"
+	PermissionMoveIssues              PermissionKey = "MOVE_ISSUES"
+	PermissionAssignIssues            PermissionKey = "ASSIGN_ISSUES"
+	PermissionAssignableUser          PermissionKey = "ASSIGNABLE_USER"
+	PermissionResolveIssues           PermissionKey = "RESOLVE_ISSUES"
+	PermissionCloseIssues             PermissionKey = "CLOSE_ISSUES"
+	PermissionModifyReporter          PermissionKey = "MODIFY_REPORTER"
+	PermissionDeleteIssues            PermissionKey = "DELETE_ISSUES"
+	PermissionLinkIssues              PermissionKey = "LINK_ISSUES"
+	PermissionSetIssueSecurity        PermissionKey = "SET_ISSUE_SECURITY"
+	PermissionManageWatchers          PermissionKey = "MANAGE_WATCHERS"
+	PermissionViewVotersAndWatchers   PermissionKey = "VIEW_VOTERS_AND_WATCHERS"
+	PermissionAddComments             PermissionKey = "ADD_COMMENTS"
+	PermissionEditAllComments         PermissionKey = "EDIT_ALL_COMMENTS"
+	PermissionEditOwnComments         PermissionKey = "EDIT_OWN_COMMENTS"
+	PermissionDeleteAllComments       PermissionKey = "DELETE_ALL_COMMENTS"
+	PermissionDeleteOwnComments       PermissionKey = "DELETE_OWN_COMMENTS"
+	PermissionCreateAttachments       PermissionKey = "CREATE_ATTACHMENTS"
+	PermissionDeleteAllAttachments    PermissionKey = "DELETE_ALL_ATTACHMENTS"
+	PermissionDeleteOwnAttachments    PermissionKey = "DELETE_OWN_ATTACHMENTS"
+	PermissionWorkOnIssues            PermissionKey = "WORK_ON_ISSUES"
+	PermissionEditOwnWorklogs         PermissionKey = "EDIT_OWN_WORKLOGS"
+	PermissionEditAllWorklogs         PermissionKey = "EDIT_ALL_WORKLOGS"
+	PermissionDeleteOwnWorklogs       PermissionKey = "DELETE_OWN_WORKLOGS"
+	PermissionDeleteAllWorklogs       PermissionKey = "DELETE_ALL_WORKLOGS"
+	PermissionManageSprintsPermission PermissionKey = "MANAGE_SPRINTS_PERMISSION"
+)
+
+// IsValid reports whether k is one of the built-in permission keys.
+// Apps can define additional permissions, which are not covered here.
+func (k PermissionKey) IsValid() bool {
+	switch k {
+	case PermissionAdministerProjects, PermissionBrowseProjects, PermissionViewDevTools, PermissionViewReadOnlyWorkflow,
+		PermissionCreateIssues, PermissionEditIssues, PermissionTransitionIssues, PermissionScheduleIssues,
+		PermissionMoveIssues, PermissionAssignIssues, PermissionAssignableUser, PermissionResolveIssues,
+		PermissionCloseIssues, PermissionModifyReporter, PermissionDeleteIssues, PermissionLinkIssues,
+		PermissionSetIssueSecurity, PermissionManageWatchers, PermissionViewVotersAndWatchers,
+		PermissionAddComments, PermissionEditAllComments, PermissionEditOwnComments,
+		PermissionDeleteAllComments, PermissionDeleteOwnComments, PermissionCreateAttachments,
+		PermissionDeleteAllAttachments, PermissionDeleteOwnAttachments, PermissionWorkOnIssues,
+		PermissionEditOwnWorklogs, PermissionEditAllWorklogs, PermissionDeleteOwnWorklogs,
+		PermissionDeleteAllWorklogs, PermissionManageSprintsPermission:
+		return true
+	}
+	return false
+}
+
 type PermissionSchemes struct {
 	PermissionSchemes []PermissionScheme `json:"permissionSchemes" structs:"permissionSchemes"`
 }
 
 type Permission struct {
-	ID     int    `json:"id" structs:"id"`
-	Self   string `json:"expand" structs:"expand"`
-	Holder Holder `json:"holder" structs:"holder"`
-	Name   string `json:"permission" structs:"permission"`
+	ID     int           `json:"id" structs:"id"`
+	Self   string        `json:"expand" structs:"expand"`
+	Holder Holder        `json:"holder" structs:"holder"`
+	Name   PermissionKey `json:"permission" structs:"permission"`
 }
 
 type Holder struct {
 	Type      string `json:"type" structs:"type"`
-	Parameter string `json:"parameter" structs:"parameter"`
-	Expand    string `json:"expand" structs:"expand"`
+	Parameter string `json:"parameter,omitempty" structs:"parameter,omitempty"`
+	Expand    string `json:"expand,omitempty" structs:"expand,omitempty"`
+}
+
+// PermissionGrantPayload is a permission grant added to a permission scheme by PermissionSchemeService.CreateGrant.
+type PermissionGrantPayload struct {
+	// Holder receives the permission. Its Type is for example "group", "projectRole", "user" or "anyone",
+	// its Parameter identifies the group, project role or user.
+	Holder     Holder        `json:"holder" structs:"holder"`
+	Permission PermissionKey `json:"permission" structs:"permission"`
 }
 
 // GetList returns a list of all permission schemes
@@ -76,3 +142,76 @@ func (s *PermissionSchemeService) Get(ctx context.Context, schemeID int) (*Permi
 
 	return ps, resp, nil
 }
+
+// GetGrants returns the permission grants of the permission scheme schemeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-permission-schemes/#api-rest-api-3-permissionscheme-schemeid-permission-get
+func (s *PermissionSchemeService) GetGrants(ctx context.Context, schemeID int) ([]Permission, *Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/3/permissionscheme/%d/permission", schemeID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := struct {
+		Permissions []Permission `json:"permissions"`
+	}{}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Permissions, resp, nil
+}
+
+// GetGrant returns the permission grant permissionID of the permission scheme schemeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-permission-schemes/#api-rest-api-3-permissionscheme-schemeid-permission-permissionid-get
+func (s *PermissionSchemeService) GetGrant(ctx context.Context, schemeID, permissionID int) (*Permission, *Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/3/permissionscheme/%d/permission/%d", schemeID, permissionID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(Permission)
+	resp, err := s.client.Do(req, permission)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return permission, resp, nil
+}
+
+// CreateGrant adds a permission grant to the permission scheme schemeID and returns it.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-permission-schemes/#api-rest-api-3-permissionscheme-schemeid-permission-post
+func (s *PermissionSchemeService) CreateGrant(ctx context.Context, schemeID int, payload *PermissionGrantPayload) (*Permission, *Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/3/permissionscheme/%d/permission", schemeID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(Permission)
+	resp, err := s.client.Do(req, permission)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return permission, resp, nil
+}
+
+// DeleteGrant removes the permission grant permissionID from the permission scheme schemeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-permission-schemes/#api-rest-api-3-permissionscheme-schemeid-permission-permissionid-delete
+func (s *PermissionSchemeService) DeleteGrant(ctx context.Context, schemeID, permissionID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/3/permissionscheme/%d/permission/%d", schemeID, permissionID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -2,7 +2,9 @@ package cloud
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // PriorityService handles priorities for the Jira instance / API.
@@ -19,6 +21,8 @@ type Priority struct {
 	ID          string `json:"id,omitempty" structs:"id,omitempty"`
 	StatusColor string `json:"statusColor,omitempty" structs:"statusColor,omitempty"`
 	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// IsDefault is only returned by PriorityService.Search.
+	IsDefault bool `json:"isDefault,omitempty" structs:"isDefault,omitempty"`
 }
 
 // GetList gets all priorities from Jira
@@ -41,3 +45,123 @@ func (s *PriorityService) GetList(ctx context.Context) ([]Priority, *Response, e
 	}
 	return priorityList, resp, nil
 }
+
+// Get returns the priority priorityID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-priorities/#api-rest-api-2-priority-id-get
+func (s *PriorityService) Get(ctx context.Context, priorityID string) (*Priority, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/priority/%s", url.PathEscape(priorityID))
+	priority := new(Priority)
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, priority)
+	if err != nil {
+		return nil, resp, err
+	}
+	return priority, resp, nil
+}
+
+// PrioritySearchOptions specifies the optional parameters for PriorityService.Search.
+type PrioritySearchOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// IDs restricts the results to the priorities with these IDs.
+	IDs []string `url:"id,omitempty"`
+	// ProjectIDs restricts the results to the priorities of the priority schemes of these projects.
+	ProjectIDs []string `url:"projectId,omitempty"`
+	// PriorityName restricts the results to priorities with a name containing this string.
+	PriorityName string `url:"priorityName,omitempty"`
+	// OnlyDefault restricts the results to the default priority.
+	OnlyDefault bool `url:"onlyDefault,omitempty"`
+	// Expand "schemes" to return the priority schemes using a priority.
+	Expand string `url:"expand,omitempty"`
+}
+
+// Search returns one page of the priorities matching the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-priorities/#api-rest-api-2-priority-search-get
+func (s *PriorityService) Search(ctx context.Context, options *PrioritySearchOptions) (*PagedList[Priority], *Response, error) {
+	u, err := addOptions("rest/api/2/priority/search", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[Priority](ctx, s.client, u, agilePaging)
+}
+
+// PriorityPayload is a priority created by PriorityService.Create or the changes of PriorityService.Update.
+// For updates, empty attributes are left unchanged.
+type PriorityPayload struct {
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// StatusColor is the color of the priority as hex value, like "#FF7452". It is required for new priorities.
+	StatusColor string `json:"statusColor,omitempty" structs:"statusColor,omitempty"`
+	// IconURL is the URL of the icon, like "/images/icons/priorities/major.png". Use either IconURL or AvatarID.
+	IconURL  string `json:"iconUrl,omitempty" structs:"iconUrl,omitempty"`
+	AvatarID int64  `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+}
+
+// Create creates a priority.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-priorities/#api-rest-api-2-priority-post
+func (s *PriorityService) Create(ctx context.Context, payload *PriorityPayload) (*Priority, *Response, error) {
+	result := struct {
+		ID string `json:"id"`
+	}{}
+	resp, err := s.do(ctx, http.MethodPost, "rest/api/2/priority", payload, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	priority := &Priority{
+		ID:          result.ID,
+		Name:        payload.Name,
+		Description: payload.Description,
+		StatusColor: payload.StatusColor,
+		IconURL:     payload.IconURL,
+	}
+	return priority, resp, nil
+}
+
+// Update changes the priority priorityID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-priorities/#api-rest-api-2-priority-id-put
+func (s *PriorityService) Update(ctx context.Context, priorityID string, payload *PriorityPayload) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/priority/%s", url.PathEscape(priorityID))
+	return s.do(ctx, http.MethodPut, apiEndpoint, payload, nil)
+}
+
+// Delete deletes the priority priorityID.
+// Issues with the priority get the replacement priority of their priority scheme.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-priorities/#api-rest-api-2-priority-id-delete
+func (s *PriorityService) Delete(ctx context.Context, priorityID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/priority/%s", url.PathEscape(priorityID))
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// SetDefault makes the priority priorityID the default priority.
+// An empty priorityID removes the default priority.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-priorities/#api-rest-api-2-priority-default-put
+func (s *PriorityService) SetDefault(ctx context.Context, priorityID string) (*Response, error) {
+	if priorityID == "" {
+		priorityID = "-1"
+	}
+	body := struct {
+		ID string `json:"id"`
+	}{priorityID}
+	return s.do(ctx, http.MethodPut, "rest/api/2/priority/default", &body, nil)
+}
+
+// do sends a request with the optional body to apiEndpoint and decodes the response into v, if not nil.
+func (s *PriorityService) do(ctx context.Context, method, apiEndpoint string, body, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -3,6 +3,7 @@ package cloud
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"testing"
@@ -31,3 +32,116 @@ func TestPriorityService_GetList(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestPriorityService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/priority/1"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/priority/3","statusColor":"#009900","description":"Major loss of function.","iconUrl":"https://your-domain.atlassian.net/images/icons/priorities/major.png","name":"Major","id":"1"}`)
+	})
+
+	priority, _, err := testClient.Priority.Get(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if priority.Name != "Major" {
+		t.Errorf("Expected priority Major, got %s", priority.Name)
+	}
+}
+
+func TestPriorityService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/priority/search"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?onlyDefault=true&projectId=10000")
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":1,"isLast":true,"values":[{"description":"Serious problem that could block progress.","iconUrl":"https://your-domain.atlassian.net/images/icons/priorities/major.png","id":"3","isDefault":true,"name":"Major","statusColor":"#009900"}]}`)
+	})
+
+	page, _, err := testClient.Priority.Search(context.Background(), &PrioritySearchOptions{ProjectIDs: []string{"10000"}, OnlyDefault: true})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 1 || !page.Values[0].IsDefault {
+		t.Errorf("Unexpected priorities %+v", page.Values)
+	}
+}
+
+func TestPriorityService_CreateUpdateDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/priority", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		want := `{"name":"My new priority","description":"My priority description","statusColor":"#ABCDEF","iconUrl":"/images/icons/priorities/major.png"}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10001"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/priority/10001", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"statusColor":"#123456"}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+		case http.MethodDelete:
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	priority, _, err := testClient.Priority.Create(context.Background(), &PriorityPayload{
+		Name:        "My new priority",
+		Description: "My priority description",
+		StatusColor: "#ABCDEF",
+		IconURL:     "/images/icons/priorities/major.png",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if priority.ID != "10001" || priority.Name != "My new priority" {
+		t.Errorf("Unexpected priority %+v", priority)
+	}
+	if _, err := testClient.Priority.Update(context.Background(), priority.ID, &PriorityPayload{StatusColor: "#123456"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Priority.Delete(context.Background(), priority.ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPriorityService_SetDefault(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/priority/default"
+
+	var want string
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		body, _ := io.ReadAll(r.Body)
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	want = `{"id":"3"}` + "\n"
+	if _, err := testClient.Priority.SetDefault(context.Background(), "3"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	want = `{"id":"-1"}` + "\n"
+	if _, err := testClient.Priority.SetDefault(context.Background(), ""); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/google/go-querystring/query"
 )
@@ -13,6 +14,24 @@ import (
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project
 type ProjectService service
 
+// ProjectType represents the type of a project.
+type ProjectType string
+
+const (
+	ProjectTypeSoftware    ProjectType = "software"
+	ProjectTypeServiceDesk ProjectType = "service_desk"
+	ProjectTypeBusiness    ProjectType = "business"
+)
+
+// IsValid reports whether t is a project type known to Jira.
+func (t ProjectType) IsValid() bool {
+	switch t {
+	case ProjectTypeSoftware, ProjectTypeServiceDesk, ProjectTypeBusiness:
+		return true
+	}
+	return false
+}
+
 // ProjectList represent a list of Projects
 type ProjectList []struct {
 	Expand          string          `json:"expand" structs:"expand"`
@@ -21,7 +40,7 @@ type ProjectList []struct {
 	Key             string          `json:"key" structs:"key"`
 	Name            string          `json:"name" structs:"name"`
 	AvatarUrls      AvatarUrls      `json:"avatarUrls" structs:"avatarUrls"`
-	ProjectTypeKey  string          `json:"projectTypeKey" structs:"projectTypeKey"`
+	ProjectTypeKey  ProjectType     `json:"projectTypeKey" structs:"projectTypeKey"`
 	ProjectCategory ProjectCategory `json:"projectCategory,omitempty" structs:"projectsCategory,omitempty"`
 	IssueTypes      []IssueType     `json:"issueTypes,omitempty" structs:"issueTypes,omitempty"`
 }
@@ -52,6 +71,31 @@ type Project struct {
 	Roles           map[string]string  `json:"roles,omitempty" structs:"roles,omitempty"`
 	AvatarUrls      AvatarUrls         `json:"avatarUrls,omitempty" structs:"avatarUrls,omitempty"`
 	ProjectCategory ProjectCategory    `json:"projectCategory,omitempty" structs:"projectCategory,omitempty"`
+	// Style is either ProjectStyleClassic (company-managed) or ProjectStyleNextGen (team-managed).
+	Style      string `json:"style,omitempty" structs:"style,omitempty"`
+	Simplified bool   `json:"simplified,omitempty" structs:"simplified,omitempty"`
+	// Deleted is set for projects in the trash, see ProjectService.Delete.
+	// They are deleted permanently after RetentionTillDate.
+	Deleted           bool   `json:"deleted,omitempty" structs:"deleted,omitempty"`
+	DeletedDate       string `json:"deletedDate,omitempty" structs:"deletedDate,omitempty"`
+	RetentionTillDate string `json:"retentionTillDate,omitempty" structs:"retentionTillDate,omitempty"`
+	// Archived is set for projects archived via ProjectService.Archive.
+	Archived     bool   `json:"archived,omitempty" structs:"archived,omitempty"`
+	ArchivedDate string `json:"archivedDate,omitempty" structs:"archivedDate,omitempty"`
+}
+
+// Style of a project
+const (
+	// ProjectStyleClassic is the style of company-managed projects.
+	ProjectStyleClassic = "classic"
+	// ProjectStyleNextGen is the style of team-managed projects.
+	ProjectStyleNextGen = "next-gen"
+)
+
+// IsTeamManaged reports whether p is a team-managed (formerly next-gen) project.
+// It requires Style or Simplified to be returned by Jira, like ProjectService.Get does.
+func (p *Project) IsTeamManaged() bool {
+	return p.Style == ProjectStyleNextGen || p.Simplified
 }
 
 // ProjectComponent represents a single component of a project
@@ -68,6 +112,8 @@ type ProjectComponent struct {
 	IsAssigneeTypeValid bool   `json:"isAssigneeTypeValid" structs:"isAssigneeTypeValid,omitempty"`
 	Project             string `json:"project" structs:"project,omitempty"`
 	ProjectID           int    `json:"projectId" structs:"projectId,omitempty"`
+	// IssueCount is only returned by ComponentService.GetProjectComponents.
+	IssueCount int `json:"issueCount,omitempty" structs:"issueCount,omitempty"`
 }
 
 // PermissionScheme represents the permission scheme for the project
@@ -161,3 +207,389 @@ func (s *ProjectService) GetPermissionScheme(ctx context.Context, projectID stri
 
 	return ps, resp, nil
 }
+
+// CreateProjectPayload is used for creating new projects via ProjectService.Create.
+type CreateProjectPayload struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	// ProjectTypeKey defines the features of the project, like boards for ProjectTypeSoftware.
+	ProjectTypeKey ProjectType `json:"projectTypeKey,omitempty"`
+	// ProjectTemplateKey is the template the project is created from, like "com.pyxis.greenhopper.jira:gh-simplified-scrum-classic".
+	ProjectTemplateKey string `json:"projectTemplateKey,omitempty"`
+	Description        string `json:"description,omitempty"`
+	LeadAccountID      string `json:"leadAccountId"`
+	URL                string `json:"url,omitempty"`
+	// AssigneeType is "PROJECT_LEAD" or "UNASSIGNED".
+	AssigneeType        string `json:"assigneeType,omitempty"`
+	AvatarID            int64  `json:"avatarId,omitempty"`
+	IssueSecurityScheme int64  `json:"issueSecurityScheme,omitempty"`
+	PermissionScheme    int64  `json:"permissionScheme,omitempty"`
+	NotificationScheme  int64  `json:"notificationScheme,omitempty"`
+	CategoryID          int64  `json:"categoryId,omitempty"`
+}
+
+// UpdateProjectPayload is used for updating projects via ProjectService.Update.
+// Only non-empty values are changed.
+type UpdateProjectPayload struct {
+	Key                 string `json:"key,omitempty"`
+	Name                string `json:"name,omitempty"`
+	Description         string `json:"description,omitempty"`
+	LeadAccountID       string `json:"leadAccountId,omitempty"`
+	URL                 string `json:"url,omitempty"`
+	AssigneeType        string `json:"assigneeType,omitempty"`
+	AvatarID            int64  `json:"avatarId,omitempty"`
+	IssueSecurityScheme int64  `json:"issueSecurityScheme,omitempty"`
+	PermissionScheme    int64  `json:"permissionScheme,omitempty"`
+	NotificationScheme  int64  `json:"notificationScheme,omitempty"`
+	CategoryID          int64  `json:"categoryId,omitempty"`
+}
+
+// ProjectIdentifiers identifies a project created via ProjectService.Create.
+type ProjectIdentifiers struct {
+	Self string `json:"self" structs:"self"`
+	ID   int64  `json:"id" structs:"id"`
+	Key  string `json:"key" structs:"key"`
+}
+
+// Create creates a project and returns its ID and key.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-post
+func (s *ProjectService) Create(ctx context.Context, project *CreateProjectPayload) (*ProjectIdentifiers, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "rest/api/2/project", project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(ProjectIdentifiers)
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return created, resp, nil
+}
+
+// Update changes the project projectIDOrKey and returns the updated project.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-put
+func (s *ProjectService) Update(ctx context.Context, projectIDOrKey string, project *UpdateProjectPayload) (*Project, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(Project)
+	resp, err := s.client.Do(req, updated)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return updated, resp, nil
+}
+
+// Delete moves the project projectIDOrKey to the trash, from where it can be restored for 60 days.
+// Use DeletePermanently to skip the trash, or DeleteAsync for projects with many issues.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-delete
+func (s *ProjectService) Delete(ctx context.Context, projectIDOrKey string) (*Response, error) {
+	return s.delete(ctx, projectIDOrKey, true)
+}
+
+// DeletePermanently deletes the project projectIDOrKey including its issues, without moving it to the trash.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-delete
+func (s *ProjectService) DeletePermanently(ctx context.Context, projectIDOrKey string) (*Response, error) {
+	return s.delete(ctx, projectIDOrKey, false)
+}
+
+func (s *ProjectService) delete(ctx context.Context, projectIDOrKey string, enableUndo bool) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s?enableUndo=%t", url.PathEscape(projectIDOrKey), enableUndo)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteAsync deletes the project projectIDOrKey including its issues permanently, as a background task.
+// The progress of the returned task can be followed via TaskService.Get or TaskService.Wait.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-delete-post
+func (s *ProjectService) DeleteAsync(ctx context.Context, projectIDOrKey string) (*Task, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/delete", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Jira responds with "303 See Other" to the task, which is followed by the http.Client.
+	task := new(Task)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return task, resp, nil
+}
+
+// GetPropertyKeys returns the keys of all properties of the project projectIDOrKey.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-properties/#api-rest-api-2-project-projectidorkey-properties-get
+func (s *ProjectService) GetPropertyKeys(ctx context.Context, projectIDOrKey string) (*PropertyKeys, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/properties", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(PropertyKeys)
+	resp, err := s.client.Do(req, keys)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return keys, resp, nil
+}
+
+// GetProperty returns a property of the project projectIDOrKey.
+// Its value can be decoded into a typed value via EntityProperty.Decode.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-properties/#api-rest-api-2-project-projectidorkey-properties-propertykey-get
+func (s *ProjectService) GetProperty(ctx context.Context, projectIDOrKey, propertyKey string) (*EntityProperty, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, projectPropertyEndpoint(projectIDOrKey, propertyKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return property, resp, nil
+}
+
+// SetProperty creates or updates a property of the project projectIDOrKey, like the configuration of an automation.
+// value is encoded as JSON and may not be larger than 32 KB.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-properties/#api-rest-api-2-project-projectidorkey-properties-propertykey-put
+func (s *ProjectService) SetProperty(ctx context.Context, projectIDOrKey, propertyKey string, value interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPut, projectPropertyEndpoint(projectIDOrKey, propertyKey), value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteProperty deletes a property of the project projectIDOrKey.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-properties/#api-rest-api-2-project-projectidorkey-properties-propertykey-delete
+func (s *ProjectService) DeleteProperty(ctx context.Context, projectIDOrKey, propertyKey string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, projectPropertyEndpoint(projectIDOrKey, propertyKey), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// projectPropertyEndpoint returns the endpoint of a single project property.
+func projectPropertyEndpoint(projectIDOrKey, propertyKey string) string {
+	return fmt.Sprintf("rest/api/2/project/%s/properties/%s", url.PathEscape(projectIDOrKey), url.PathEscape(propertyKey))
+}
+
+// GetNotificationScheme returns the notification scheme of the project projectIDOrKey,
+// including the recipients of all events.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectkeyorid-notificationscheme-get
+func (s *ProjectService) GetNotificationScheme(ctx context.Context, projectIDOrKey string) (*NotificationScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/notificationscheme?expand=all", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(NotificationScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// SetNotificationScheme associates the notification scheme schemeID with the project projectIDOrKey.
+// It requires the Administer Jira global permission.
+func (s *ProjectService) SetNotificationScheme(ctx context.Context, projectIDOrKey string, schemeID int64) (*Project, *Response, error) {
+	return s.Update(ctx, projectIDOrKey, &UpdateProjectPayload{NotificationScheme: schemeID})
+}
+
+// SecurityLevel is a level of an issue security scheme, restricting who can see an issue.
+type SecurityLevel struct {
+	Self        string `json:"self,omitempty" structs:"self,omitempty"`
+	ID          string `json:"id" structs:"id"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// IsDefault is set for the default level of the issue security scheme.
+	IsDefault             bool   `json:"isDefault,omitempty" structs:"isDefault,omitempty"`
+	IssueSecuritySchemeID string `json:"issueSecuritySchemeId,omitempty" structs:"issueSecuritySchemeId,omitempty"`
+}
+
+// GetPermissionSchemeGrants returns the permission scheme of the project projectIDOrKey,
+// including its permission grants in PermissionScheme.Permissions.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-permission-schemes/#api-rest-api-2-project-projectkeyorid-permissionscheme-get
+func (s *ProjectService) GetPermissionSchemeGrants(ctx context.Context, projectIDOrKey string) (*PermissionScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/permissionscheme?expand=permissions", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(PermissionScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// AssignPermissionScheme associates the permission scheme schemeID with the project projectIDOrKey
+// and returns the scheme including its permission grants.
+// It requires the Administer Jira global permission.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-permission-schemes/#api-rest-api-2-project-projectkeyorid-permissionscheme-put
+func (s *ProjectService) AssignPermissionScheme(ctx context.Context, projectIDOrKey string, schemeID int) (*PermissionScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/permissionscheme?expand=permissions", url.PathEscape(projectIDOrKey))
+	body := struct {
+		ID int `json:"id"`
+	}{schemeID}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(PermissionScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// GetSecurityLevels returns the issue security levels of the project projectIDOrKey
+// the current user can set on issues.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-permission-schemes/#api-rest-api-2-project-projectkeyorid-securitylevel-get
+func (s *ProjectService) GetSecurityLevels(ctx context.Context, projectIDOrKey string) ([]SecurityLevel, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/securitylevel", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	levels := new(struct {
+		Levels []SecurityLevel `json:"levels"`
+	})
+	resp, err := s.client.Do(req, levels)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return levels.Levels, resp, nil
+}
+
+// Status of a project, for ProjectSearchOptions.Status
+const (
+	ProjectStatusLive     = "live"
+	ProjectStatusArchived = "archived"
+	ProjectStatusDeleted  = "deleted"
+)
+
+// ProjectSearchOptions specifies the optional parameters for ProjectService.Search and ProjectService.SearchPager.
+type ProjectSearchOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// OrderBy sorts the projects, like "name", "-lastIssueUpdatedTime", "issueCount" or "category".
+	OrderBy string `url:"orderBy,omitempty"`
+	// IDs restricts the results to the projects with these IDs.
+	IDs []int64 `url:"id,omitempty"`
+	// Keys restricts the results to the projects with these keys.
+	Keys []string `url:"keys,omitempty"`
+	// Query restricts the results to projects with a key or name containing this string.
+	Query string `url:"query,omitempty"`
+	// TypeKey restricts the results to projects of these types, comma separated, like "business,software".
+	TypeKey    string `url:"typeKey,omitempty"`
+	CategoryID int64  `url:"categoryId,omitempty"`
+	// Action restricts the results to projects the user can "view", "browse" or "edit".
+	Action string `url:"action,omitempty"`
+	// Status restricts the results to projects with one of the ProjectStatus constants. By default, only live projects are returned.
+	Status []string `url:"status,omitempty"`
+	// Expand additional attributes, like "description", "projectKeys", "lead", "issueTypes", "url" or "insight".
+	Expand string `url:"expand,omitempty"`
+}
+
+// Search returns one page of the projects matching the options, visible to the user.
+// The following pages can be fetched via PagedList.Next or all projects via ProjectService.SearchPager.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-search-get
+func (s *ProjectService) Search(ctx context.Context, options *ProjectSearchOptions) (*PagedList[Project], *Response, error) {
+	u, err := addOptions("rest/api/2/project/search", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[Project](ctx, s.client, u, agilePaging)
+}
+
+// ProjectEmail is the sender email address of the notifications of a project.
+type ProjectEmail struct {
+	EmailAddress string `json:"emailAddress,omitempty" structs:"emailAddress,omitempty"`
+	// EmailAddressStatus describes problems with the address, like a missing domain verification.
+	EmailAddressStatus []string `json:"emailAddressStatus,omitempty" structs:"emailAddressStatus,omitempty"`
+}
+
+// GetEmail returns the sender email address of the project projectID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-email/#api-rest-api-2-project-projectid-email-get
+func (s *ProjectService) GetEmail(ctx context.Context, projectID string) (*ProjectEmail, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/email", url.PathEscape(projectID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	email := new(ProjectEmail)
+	resp, err := s.client.Do(req, email)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return email, resp, nil
+}
+
+// SetEmail sets the sender email address of the project projectID.
+// An empty emailAddress resets it to the default address of the Jira instance.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-email/#api-rest-api-2-project-projectid-email-put
+func (s *ProjectService) SetEmail(ctx context.Context, projectID, emailAddress string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/email", url.PathEscape(projectID))
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, &ProjectEmail{EmailAddress: emailAddress})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,46 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Archive archives the project projectIDOrKey.
+// Archived projects and their issues are read-only, until they are restored via ProjectService.Restore.
+// Archiving requires a Premium or Enterprise plan.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-archive-post
+func (s *ProjectService) Archive(ctx context.Context, projectIDOrKey string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/archive", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Restore restores the project projectIDOrKey from the archive or the trash and returns it.
+// Projects are moved to the trash by ProjectService.Delete.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-restore-post
+func (s *ProjectService) Restore(ctx context.Context, projectIDOrKey string) (*Project, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/restore", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(Project)
+	resp, err := s.client.Do(req, project)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return project, resp, nil
+}
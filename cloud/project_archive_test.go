@@ -0,0 +1,44 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestProjectService_Archive(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/project/EX/archive"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testapiEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Project.Archive(context.Background(), "EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_Restore(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/project/EX/restore"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"id":"10000","key":"EX","name":"Example","deleted":true,"deletedDate":"2026-10-01T09:00:00.000+0000","retentionTillDate":"2026-11-30T09:00:00.000+0000"}`)
+	})
+
+	project, _, err := testClient.Project.Restore(context.Background(), "EX")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if project == nil || project.Key != "EX" || !project.Deleted {
+		t.Errorf("Unexpected project %+v", project)
+	}
+}
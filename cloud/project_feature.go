@@ -0,0 +1,76 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ProjectFeatureState is the state of a feature of a project.
+type ProjectFeatureState string
+
+// States of a project feature
+const (
+	ProjectFeatureEnabled    ProjectFeatureState = "ENABLED"
+	ProjectFeatureDisabled   ProjectFeatureState = "DISABLED"
+	ProjectFeatureComingSoon ProjectFeatureState = "COMING_SOON"
+)
+
+// ProjectFeature is a feature of a team-managed project, like the backlog or sprints.
+type ProjectFeature struct {
+	ProjectID int64 `json:"projectId" structs:"projectId"`
+	// Feature is the key of the feature, like "jsw.agility.backlog" or "jsw.agility.sprints".
+	Feature              string              `json:"feature" structs:"feature"`
+	State                ProjectFeatureState `json:"state" structs:"state"`
+	ToggleLocked         bool                `json:"toggleLocked" structs:"toggleLocked"`
+	Prerequisites        []string            `json:"prerequisites,omitempty" structs:"prerequisites,omitempty"`
+	LocalisedName        string              `json:"localisedName,omitempty" structs:"localisedName,omitempty"`
+	LocalisedDescription string              `json:"localisedDescription,omitempty" structs:"localisedDescription,omitempty"`
+	ImageURI             string              `json:"imageUri,omitempty" structs:"imageUri,omitempty"`
+}
+
+// projectFeatures is the response of the project features endpoints.
+type projectFeatures struct {
+	Features []ProjectFeature `json:"features"`
+}
+
+// GetFeatures returns the features of the project projectIDOrKey and their state.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-features/#api-rest-api-3-project-projectidorkey-features-get
+func (s *ProjectService) GetFeatures(ctx context.Context, projectIDOrKey string) ([]ProjectFeature, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/project/%s/features", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	features := new(projectFeatures)
+	resp, err := s.client.Do(req, features)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return features.Features, resp, nil
+}
+
+// SetFeatureState enables or disables the feature featureKey of the project projectIDOrKey.
+// It returns the features of the project after the change, as other features may depend on it.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-features/#api-rest-api-3-project-projectidorkey-features-featurekey-put
+func (s *ProjectService) SetFeatureState(ctx context.Context, projectIDOrKey, featureKey string, state ProjectFeatureState) ([]ProjectFeature, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/project/%s/features/%s", url.PathEscape(projectIDOrKey), url.PathEscape(featureKey))
+	body := struct {
+		State ProjectFeatureState `json:"state"`
+	}{state}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	features := new(projectFeatures)
+	resp, err := s.client.Do(req, features)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return features.Features, resp, nil
+}
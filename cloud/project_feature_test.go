@@ -0,0 +1,55 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestProjectService_GetFeatures(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/project/EX/features"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"features":[{"projectId":10001,"state":"ENABLED","toggleLocked":true,"feature":"jsw.agility.backlog","prerequisites":[],"localisedName":"Backlog","localisedDescription":"Plan and prioritize work in a dedicated space."},{"projectId":10001,"state":"DISABLED","toggleLocked":false,"feature":"jsw.agility.sprints","prerequisites":["jsw.agility.backlog"],"localisedName":"Sprints"}]}`)
+	})
+
+	features, _, err := testClient.Project.GetFeatures(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(features) != 2 {
+		t.Fatalf("Expected 2 features. Got %d", len(features))
+	}
+	if features[1].Feature != "jsw.agility.sprints" || features[1].State != ProjectFeatureDisabled || features[1].Prerequisites[0] != "jsw.agility.backlog" {
+		t.Errorf("Unexpected feature %+v", features[1])
+	}
+}
+
+func TestProjectService_SetFeatureState(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/project/EX/features/jsw.agility.sprints"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint)
+		if b, _ := io.ReadAll(r.Body); string(b) != `{"state":"ENABLED"}`+"\n" {
+			t.Errorf("Unexpected request body %s", b)
+		}
+		fmt.Fprint(w, `{"features":[{"projectId":10001,"state":"ENABLED","toggleLocked":false,"feature":"jsw.agility.sprints"}]}`)
+	})
+
+	features, _, err := testClient.Project.SetFeatureState(context.Background(), "EX", "jsw.agility.sprints", ProjectFeatureEnabled)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(features) != 1 || features[0].State != ProjectFeatureEnabled {
+		t.Errorf("Unexpected features %+v", features)
+	}
+}
@@ -0,0 +1,127 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+)
+
+// IssueTypeSchemeProjects is an issue type scheme together with the IDs of the projects using it.
+type IssueTypeSchemeProjects struct {
+	IssueTypeScheme IssueTypeScheme `json:"issueTypeScheme" structs:"issueTypeScheme"`
+	ProjectIDs      []string        `json:"projectIds" structs:"projectIds"`
+}
+
+// IssueTypeScreenSchemeProjects is an issue type screen scheme together with the IDs of the projects using it.
+type IssueTypeScreenSchemeProjects struct {
+	IssueTypeScreenScheme IssueTypeScreenScheme `json:"issueTypeScreenScheme" structs:"issueTypeScreenScheme"`
+	ProjectIDs            []string              `json:"projectIds" structs:"projectIds"`
+}
+
+// FieldConfigurationSchemeProjects is a field configuration scheme together with the IDs of the projects using it.
+// The scheme is nil for projects using the default field configuration.
+type FieldConfigurationSchemeProjects struct {
+	FieldConfigurationScheme *FieldConfigurationScheme `json:"fieldConfigurationScheme,omitempty" structs:"fieldConfigurationScheme,omitempty"`
+	ProjectIDs               []string                  `json:"projectIds" structs:"projectIds"`
+}
+
+// ProjectSchemeOptions specifies the parameters for ProjectService.GetIssueTypeSchemes,
+// ProjectService.GetIssueTypeScreenSchemes and ProjectService.GetFieldConfigurationSchemes.
+type ProjectSchemeOptions struct {
+	// ProjectIDs are the IDs of the projects to return the schemes of. At least one project ID is required.
+	ProjectIDs []int64 `url:"projectId"`
+	StartAt    int     `url:"startAt,omitempty"`
+	MaxResults int     `url:"maxResults,omitempty"`
+}
+
+// GetIssueTypeSchemes returns one page of the issue type schemes used by the projects of the options,
+// together with the projects using them.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-schemes/#api-rest-api-2-issuetypescheme-project-get
+func (s *ProjectService) GetIssueTypeSchemes(ctx context.Context, options *ProjectSchemeOptions) (*PagedList[IssueTypeSchemeProjects], *Response, error) {
+	url, err := addOptions("rest/api/2/issuetypescheme/project", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[IssueTypeSchemeProjects](ctx, s.client, url, agilePaging)
+}
+
+// SetIssueTypeScheme associates the issue type scheme schemeID with the classic project projectID.
+// Issues of issue types not in the scheme have to be migrated beforehand.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-schemes/#api-rest-api-2-issuetypescheme-project-put
+func (s *ProjectService) SetIssueTypeScheme(ctx context.Context, projectID, schemeID string) (*Response, error) {
+	body := struct {
+		IssueTypeSchemeID string `json:"issueTypeSchemeId"`
+		ProjectID         string `json:"projectId"`
+	}{schemeID, projectID}
+	return s.putSchemeAssociation(ctx, "rest/api/2/issuetypescheme/project", &body)
+}
+
+// GetIssueTypeScreenSchemes returns one page of the issue type screen schemes used by the projects of the options,
+// together with the projects using them.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-screen-schemes/#api-rest-api-2-issuetypescreenscheme-project-get
+func (s *ProjectService) GetIssueTypeScreenSchemes(ctx context.Context, options *ProjectSchemeOptions) (*PagedList[IssueTypeScreenSchemeProjects], *Response, error) {
+	url, err := addOptions("rest/api/2/issuetypescreenscheme/project", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[IssueTypeScreenSchemeProjects](ctx, s.client, url, agilePaging)
+}
+
+// SetIssueTypeScreenScheme associates the issue type screen scheme schemeID with the classic project projectID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-screen-schemes/#api-rest-api-2-issuetypescreenscheme-project-put
+func (s *ProjectService) SetIssueTypeScreenScheme(ctx context.Context, projectID, schemeID string) (*Response, error) {
+	body := struct {
+		IssueTypeScreenSchemeID string `json:"issueTypeScreenSchemeId"`
+		ProjectID               string `json:"projectId"`
+	}{schemeID, projectID}
+	return s.putSchemeAssociation(ctx, "rest/api/2/issuetypescreenscheme/project", &body)
+}
+
+// GetFieldConfigurationSchemes returns one page of the field configuration schemes used by the projects of the options,
+// together with the projects using them.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/#api-rest-api-2-fieldconfigurationscheme-project-get
+func (s *ProjectService) GetFieldConfigurationSchemes(ctx context.Context, options *ProjectSchemeOptions) (*PagedList[FieldConfigurationSchemeProjects], *Response, error) {
+	url, err := addOptions("rest/api/2/fieldconfigurationscheme/project", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[FieldConfigurationSchemeProjects](ctx, s.client, url, agilePaging)
+}
+
+// SetFieldConfigurationScheme associates the field configuration scheme schemeID with the classic project projectID.
+// An empty schemeID associates the default field configuration.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-field-configurations/#api-rest-api-2-fieldconfigurationscheme-project-put
+func (s *ProjectService) SetFieldConfigurationScheme(ctx context.Context, projectID, schemeID string) (*Response, error) {
+	body := struct {
+		FieldConfigurationSchemeID *string `json:"fieldConfigurationSchemeId"`
+		ProjectID                  string  `json:"projectId"`
+	}{ProjectID: projectID}
+	if schemeID != "" {
+		body.FieldConfigurationSchemeID = &schemeID
+	}
+	return s.putSchemeAssociation(ctx, "rest/api/2/fieldconfigurationscheme/project", &body)
+}
+
+func (s *ProjectService) putSchemeAssociation(ctx context.Context, apiEndpoint string, body interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
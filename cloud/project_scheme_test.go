@@ -0,0 +1,147 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestProjectService_GetIssueTypeSchemes(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuetypescheme/project"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			testRequestURL(t, r, testapiEndpoint+"?maxResults=1&projectId=10000&projectId=10001")
+			fmt.Fprint(w, `{"maxResults":1,"startAt":0,"total":2,"isLast":false,"values":[{"issueTypeScheme":{"id":"10000","name":"Default Issue Type Scheme","isDefault":true},"projectIds":["10000"]}]}`)
+		case "1":
+			fmt.Fprint(w, `{"maxResults":1,"startAt":1,"total":2,"isLast":true,"values":[{"issueTypeScheme":{"id":"10001","name":"Software","defaultIssueTypeId":"10003"},"projectIds":["10001"]}]}`)
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+
+	page, _, err := testClient.Project.GetIssueTypeSchemes(context.Background(), &ProjectSchemeOptions{ProjectIDs: []int64{10000, 10001}, MaxResults: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 1 || !page.Values[0].IssueTypeScheme.IsDefault || page.Values[0].ProjectIDs[0] != "10000" {
+		t.Errorf("Unexpected first page %+v", page.Values)
+	}
+
+	page, _, err = page.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 1 || page.Values[0].IssueTypeScheme.DefaultIssueTypeID != "10003" || page.HasNext() {
+		t.Errorf("Unexpected last page %+v", page)
+	}
+}
+
+func TestProjectService_SetIssueTypeScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuetypescheme/project"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint)
+		if b, _ := io.ReadAll(r.Body); string(b) != `{"issueTypeSchemeId":"10001","projectId":"10000"}`+"\n" {
+			t.Errorf("Unexpected request body %s", b)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Project.SetIssueTypeScheme(context.Background(), "10000", "10001"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_GetIssueTypeScreenSchemes(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuetypescreenscheme/project"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?projectId=10000")
+		fmt.Fprint(w, `{"maxResults":100,"startAt":0,"total":1,"isLast":true,"values":[{"issueTypeScreenScheme":{"id":"1","name":"Default Issue Type Screen Scheme","description":"The default issue type screen scheme"},"projectIds":["10000","10001"]}]}`)
+	})
+
+	page, _, err := testClient.Project.GetIssueTypeScreenSchemes(context.Background(), &ProjectSchemeOptions{ProjectIDs: []int64{10000}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 1 || page.Values[0].IssueTypeScreenScheme.ID != "1" || len(page.Values[0].ProjectIDs) != 2 {
+		t.Errorf("Unexpected page %+v", page.Values)
+	}
+}
+
+func TestProjectService_SetIssueTypeScreenScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuetypescreenscheme/project"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint)
+		if b, _ := io.ReadAll(r.Body); string(b) != `{"issueTypeScreenSchemeId":"10001","projectId":"10000"}`+"\n" {
+			t.Errorf("Unexpected request body %s", b)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Project.SetIssueTypeScreenScheme(context.Background(), "10000", "10001"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_GetFieldConfigurationSchemes(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/fieldconfigurationscheme/project"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?projectId=10000&projectId=10001")
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":2,"isLast":true,"values":[{"projectIds":["10000"]},{"fieldConfigurationScheme":{"id":"10002","name":"Field Configuration Scheme for software related projects"},"projectIds":["10001"]}]}`)
+	})
+
+	page, _, err := testClient.Project.GetFieldConfigurationSchemes(context.Background(), &ProjectSchemeOptions{ProjectIDs: []int64{10000, 10001}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 2 || page.Values[0].FieldConfigurationScheme != nil || page.Values[1].FieldConfigurationScheme.ID != "10002" {
+		t.Errorf("Unexpected field configuration schemes %+v", page.Values)
+	}
+}
+
+func TestProjectService_SetFieldConfigurationScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/fieldconfigurationscheme/project"
+
+	var want string
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		body, _ := io.ReadAll(r.Body)
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	want = `{"fieldConfigurationSchemeId":"10002","projectId":"10000"}` + "\n"
+	if _, err := testClient.Project.SetFieldConfigurationScheme(context.Background(), "10000", "10002"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	want = `{"fieldConfigurationSchemeId":null,"projectId":"10000"}` + "\n"
+	if _, err := testClient.Project.SetFieldConfigurationScheme(context.Background(), "10000", ""); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
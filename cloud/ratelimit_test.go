@@ -0,0 +1,71 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+// countingLimiter counts the requests it lets pass.
+type countingLimiter struct {
+	n   int
+	err error
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.n++
+	return l.err
+}
+
+func TestClient_Do_RateLimiter(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {})
+
+	limiter := new(countingLimiter)
+	client, _ := NewClient(testServer.URL, nil, WithRateLimiter(limiter))
+	for i := 0; i < 3; i++ {
+		req, _ := client.NewRequest(context.Background(), http.MethodGet, "/", nil)
+		if _, err := client.Do(req, nil); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+	}
+
+	if limiter.n != 3 {
+		t.Errorf("Expected 3 requests to be gated. Got %d", limiter.n)
+	}
+}
+
+func TestClient_Do_HostRateLimiter(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {})
+
+	u, _ := url.Parse(testServer.URL)
+	all, host := new(countingLimiter), new(countingLimiter)
+	client, _ := NewClient(testServer.URL, nil, WithRateLimiter(all), WithHostRateLimiter(u.Host, host))
+
+	req, _ := client.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if host.n != 1 || all.n != 0 {
+		t.Errorf("Expected the request to be gated by the host rate limiter only. Got host %d, all %d", host.n, all.n)
+	}
+}
+
+func TestClient_Do_RateLimiterError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	wantErr := errors.New("budget exceeded")
+	client, _ := NewClient(testServer.URL, nil, WithRateLimiter(&countingLimiter{err: wantErr}))
+
+	req, _ := client.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	if _, err := client.Do(req, nil); !errors.Is(err, wantErr) {
+		t.Errorf("Expected the rate limiter error. Got %v", err)
+	}
+}
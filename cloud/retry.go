@@ -0,0 +1,204 @@
+package cloud
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how Client.Do retries failed requests.
+// See WithRetry.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts per request, including the first one.
+	// Defaults to 4.
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry.
+	// It doubles with every further retry.
+	// Defaults to 500ms.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the delay between two attempts, including delays requested by Jira via headers.
+	// Defaults to 30s.
+	MaxBackoff time.Duration
+}
+
+// WithRetry makes the client retry requests that failed because of a network error,
+// rate limiting (429) or a server error (5xx, except 501).
+//
+// The delays between attempts grow exponentially with full jitter.
+// If Jira announces when to try again via the Retry-After or X-RateLimit-Reset header, this delay is used instead.
+//
+// POST and PATCH requests are not idempotent.
+// They are only retried on 429 and 503, because Jira didn't process them in this case.
+// Requests with a body that can't be replayed are never retried.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/rate-limiting/
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		if policy.MaxAttempts <= 0 {
+			policy.MaxAttempts = 4
+		}
+		if policy.MinBackoff <= 0 {
+			policy.MinBackoff = 500 * time.Millisecond
+		}
+		if policy.MaxBackoff <= 0 {
+			policy.MaxBackoff = 30 * time.Second
+		}
+		if policy.MaxBackoff < policy.MinBackoff {
+			policy.MaxBackoff = policy.MinBackoff
+		}
+		c.retry = &policy
+		return nil
+	}
+}
+
+// delay returns how long to wait before the next attempt and whether the request should be retried at all.
+// attempt is the number of the failed attempt, starting with 1.
+func (p *RetryPolicy) delay(attempt int, req *http.Request, resp *http.Response, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts || req.Context().Err() != nil {
+		return 0, false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 0, false
+	}
+
+	idempotent := req.Method != http.MethodPost && req.Method != http.MethodPatch
+	if err != nil && !idempotent {
+		// Jira may have processed the request before the connection broke or the attempt timed out
+		return 0, false
+	}
+
+	if err == nil {
+		switch code := resp.StatusCode; {
+		case code == http.StatusTooManyRequests, code == http.StatusServiceUnavailable:
+		case idempotent && code >= http.StatusInternalServerError && code != http.StatusNotImplemented:
+		default:
+			return 0, false
+		}
+
+		if d, ok := retryAfter(resp.Header); ok {
+			if d > p.MaxBackoff {
+				d = p.MaxBackoff
+			}
+			return d, true
+		}
+	}
+
+	backoff := p.MinBackoff << uint(attempt-1)
+	if backoff > p.MaxBackoff || backoff <= 0 {
+		backoff = p.MaxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1)), true
+}
+
+// retryAfter returns the delay requested by Jira via the Retry-After or X-RateLimit-Reset header.
+func retryAfter(h http.Header) (time.Duration, bool) {
+	if v := h.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return nonNegative(time.Until(t)), true
+		}
+	}
+	if v := h.Get("X-RateLimit-Reset"); v != "" {
+		// An ISO 8601 timestamp like 2021-05-21T10:40Z
+		for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00"} {
+			if t, err := time.Parse(layout, v); err == nil {
+				return nonNegative(time.Until(t)), true
+			}
+		}
+	}
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// send sends req and retries it according to the retry policy of the client.
+// The timeout of the client applies to every single attempt.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		if err := c.rateLimiters.wait(attemptReq); err != nil {
+			return nil, err
+		}
+
+		if err := c.concurrency.acquire(attemptReq.Context()); err != nil {
+			return nil, err
+		}
+
+		timedReq, cancel := c.withTimeout(attemptReq)
+		c.debug.dumpRequest(timedReq)
+		start := time.Now()
+		resp, err := c.client.Do(timedReq)
+		duration := time.Since(start)
+		c.debug.dumpResponse(resp, err)
+		c.concurrency.release(resp)
+		if err != nil {
+			cancel()
+		} else {
+			// The timeout has to stay active until the body is consumed
+			resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+		}
+
+		delay, retry := c.retry.delay(attempt, req, resp, err)
+		if c.logger != nil {
+			entry := RequestLog{
+				Method:     req.Method,
+				URL:        redactURL(req.URL),
+				Duration:   duration,
+				Attempt:    attempt,
+				Retry:      retry,
+				RetryDelay: delay,
+				Err:        err,
+			}
+			if resp != nil {
+				entry.StatusCode = resp.StatusCode
+			}
+			c.logger.LogRequest(req.Context(), entry)
+		}
+		c.observe(req, resp, duration, attempt, retry)
+		if !retry {
+			return resp, err
+		}
+		if resp != nil {
+			// Drain the body, so that the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,151 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_Do_RetriesRateLimitedRequests(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var attempts int32
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"key":"EX-1"}`)
+	})
+
+	client, _ := NewClient(testServer.URL, nil, WithRetry(RetryPolicy{MinBackoff: time.Millisecond}))
+	issue, _, err := client.Issue.Get(context.Background(), "EX-1", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if n := atomic.LoadInt32(&attempts); issue.Key != "EX-1" || n != 3 {
+		t.Errorf("Expected issue EX-1 after 3 attempts. Got %q after %d attempts", issue.Key, n)
+	}
+}
+
+func TestClient_Do_RetriesReplayBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var attempts int32
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		attempt := atomic.AddInt32(&attempts, 1)
+		body := make([]byte, r.ContentLength)
+		_, _ = r.Body.Read(body)
+		if len(body) == 0 {
+			t.Errorf("Expected the request body in attempt %d", attempt)
+		}
+		if attempt == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10000","key":"EX-1"}`)
+	})
+
+	client, _ := NewClient(testServer.URL, nil, WithRetry(RetryPolicy{MinBackoff: time.Millisecond}))
+	_, _, err := client.Issue.Create(context.Background(), &Issue{Fields: &IssueFields{Summary: "Retry me"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if n := atomic.LoadInt32(&attempts); n != 2 {
+		t.Errorf("Expected 2 attempts. Got %d", n)
+	}
+}
+
+func TestClient_Do_NoRetryOfNonIdempotentServerError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var attempts int32
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	client, _ := NewClient(testServer.URL, nil, WithRetry(RetryPolicy{MinBackoff: time.Millisecond}))
+	if _, _, err := client.Issue.Create(context.Background(), &Issue{Fields: &IssueFields{Summary: "Once"}}); err == nil {
+		t.Error("Expected an error")
+	}
+	if n := atomic.LoadInt32(&attempts); n != 1 {
+		t.Errorf("Expected a POST with status 500 not to be retried. Got %d attempts", n)
+	}
+}
+
+func TestClient_Do_NoRetryOfNonIdempotentNetworkError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var attempts int32
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("Error given: %s", err)
+			return
+		}
+		conn.Close()
+	})
+
+	client, _ := NewClient(testServer.URL, nil, WithRetry(RetryPolicy{MinBackoff: time.Millisecond}))
+	if _, _, err := client.Issue.Create(context.Background(), &Issue{Fields: &IssueFields{Summary: "Once"}}); err == nil {
+		t.Error("Expected an error")
+	}
+	if n := atomic.LoadInt32(&attempts); n != 1 {
+		t.Errorf("Expected a POST with a network error not to be retried. Got %d attempts", n)
+	}
+}
+
+func TestClient_Do_GivesUpAfterMaxAttempts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var attempts int32
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	client, _ := NewClient(testServer.URL, nil, WithRetry(RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}))
+	_, resp, err := client.Issue.Get(context.Background(), "EX-1", nil)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if n := atomic.LoadInt32(&attempts); resp.StatusCode != http.StatusBadGateway || n != 2 {
+		t.Errorf("Expected status 502 after 2 attempts. Got %d after %d attempts", resp.StatusCode, n)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+		min    time.Duration
+		max    time.Duration
+		found  bool
+	}{
+		{"none", http.Header{}, 0, 0, false},
+		{"seconds", http.Header{"Retry-After": {"5"}}, 5 * time.Second, 5 * time.Second, true},
+		{"http date", http.Header{"Retry-After": {time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)}}, 58 * time.Second, time.Minute, true},
+		{"rate limit reset", http.Header{"X-Ratelimit-Reset": {time.Now().Add(2 * time.Minute).UTC().Format("2006-01-02T15:04Z")}}, time.Second, 2 * time.Minute, true},
+		{"reset in the past", http.Header{"X-Ratelimit-Reset": {"2021-05-21T10:40Z"}}, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, found := retryAfter(tt.header)
+			if found != tt.found || d < tt.min || d > tt.max {
+				t.Errorf("retryAfter() = %s, %v, want between %s and %s, %v", d, found, tt.min, tt.max, tt.found)
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ package cloud
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 )
 
 // RoleService handles roles for the Jira instance / API.
@@ -80,3 +82,146 @@ func (s *RoleService) Get(ctx context.Context, roleID int) (*Role, *Response, er
 
 	return role, resp, err
 }
+
+// RoleActors lists users and groups to add as actors of a role.
+type RoleActors struct {
+	// Users are the account IDs of the users.
+	Users []string `json:"user,omitempty" structs:"user,omitempty"`
+	// GroupIDs are the IDs of the groups.
+	GroupIDs []string `json:"groupId,omitempty" structs:"groupId,omitempty"`
+	// Groups are the names of the groups.
+	// Use GroupIDs instead, because group names can change.
+	Groups []string `json:"group,omitempty" structs:"group,omitempty"`
+}
+
+// Create creates a project role.
+// Only Name and Description of role are used.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-roles/#api-rest-api-3-role-post
+func (s *RoleService) Create(ctx context.Context, role *Role) (*Role, *Response, error) {
+	const apiEndpoint = "rest/api/3/role"
+	return s.save(ctx, http.MethodPost, apiEndpoint, role)
+}
+
+// Update replaces the name and description of a project role.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-roles/#api-rest-api-3-role-id-put
+func (s *RoleService) Update(ctx context.Context, roleID int, role *Role) (*Role, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/role/%d", roleID)
+	return s.save(ctx, http.MethodPut, apiEndpoint, role)
+}
+
+// save sends the name and description of role to apiEndpoint.
+func (s *RoleService) save(ctx context.Context, method, apiEndpoint string, role *Role) (*Role, *Response, error) {
+	payload := struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+	}{Name: role.Name, Description: role.Description}
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	saved := new(Role)
+	resp, err := s.client.Do(req, saved)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return saved, resp, nil
+}
+
+// Delete deletes a project role.
+// If the role is in use, swapRoleID names the role that replaces it in all schemes and projects.
+// Use 0 if the role is not in use.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-roles/#api-rest-api-3-role-id-delete
+func (s *RoleService) Delete(ctx context.Context, roleID, swapRoleID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/role/%d", roleID)
+	if swapRoleID != 0 {
+		apiEndpoint += fmt.Sprintf("?swap=%d", swapRoleID)
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GetDefaultActors returns the default actors of a project role.
+// Default actors are added to the role of every new project.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-role-actors/#api-rest-api-3-role-id-actors-get
+func (s *RoleService) GetDefaultActors(ctx context.Context, roleID int) ([]*Actor, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/role/%d/actors", roleID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role.Actors, resp, nil
+}
+
+// AddDefaultActors adds default actors to a project role and returns all default actors of the role.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-role-actors/#api-rest-api-3-role-id-actors-post
+func (s *RoleService) AddDefaultActors(ctx context.Context, roleID int, actors *RoleActors) ([]*Actor, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/role/%d/actors", roleID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, actors)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role.Actors, resp, nil
+}
+
+// RemoveDefaultActors removes default actors from a project role.
+// Jira removes one actor per request, so one request is sent for every user and group of actors.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-role-actors/#api-rest-api-3-role-id-actors-delete
+func (s *RoleService) RemoveDefaultActors(ctx context.Context, roleID int, actors *RoleActors) (*Response, error) {
+	var params []url.Values
+	for _, user := range actors.Users {
+		params = append(params, url.Values{"user": {user}})
+	}
+	for _, groupID := range actors.GroupIDs {
+		params = append(params, url.Values{"groupId": {groupID}})
+	}
+	for _, group := range actors.Groups {
+		params = append(params, url.Values{"group": {group}})
+	}
+
+	var resp *Response
+	for i, p := range params {
+		apiEndpoint := fmt.Sprintf("rest/api/3/role/%d/actors?%s", roleID, p.Encode())
+		req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err = s.client.Do(req, nil)
+		if err != nil {
+			return resp, NewJiraError(resp, err)
+		}
+		if i < len(params)-1 {
+			// Only the last response is returned, the others have to be released here
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+	}
+	return resp, nil
+}
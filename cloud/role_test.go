@@ -2,9 +2,11 @@ package cloud
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
+	"reflect"
 	"testing"
 )
 
@@ -106,3 +108,123 @@ func TestRoleService_Get(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestRoleService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/role"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if len(payload) != 2 || payload["name"] != "Developers" {
+			t.Errorf("Expected only name and description in the payload. Got %+v", payload)
+		}
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/3/role/10360","name":"Developers","id":10360,"description":"A project role that represents developers in a project"}`)
+	})
+
+	role, _, err := testClient.Role.Create(context.Background(), &Role{
+		Name:        "Developers",
+		Description: "A project role that represents developers in a project",
+		ID:          1,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if role.ID != 10360 {
+		t.Errorf("Expected role 10360. Got %d", role.ID)
+	}
+}
+
+func TestRoleService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/role/10360"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"name":"Engineers","id":10360}`)
+	})
+
+	role, _, err := testClient.Role.Update(context.Background(), 10360, &Role{Name: "Engineers"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if role.Name != "Engineers" {
+		t.Errorf("Expected role Engineers. Got %q", role.Name)
+	}
+}
+
+func TestRoleService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/role/10360"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint+"?swap=10002")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Role.Delete(context.Background(), 10360, 10002); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestRoleService_DefaultActors(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/role/10360/actors"
+
+	var removed []string
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"actors":[{"id":10240,"displayName":"jira-developers","type":"atlassian-group-role-actor","name":"jira-developers"}]}`)
+		case http.MethodPost:
+			actors := new(RoleActors)
+			if err := json.NewDecoder(r.Body).Decode(actors); err != nil {
+				t.Fatal(err)
+			}
+			if len(actors.GroupIDs) != 1 || actors.GroupIDs[0] != "42c8955c-63d7-42c8-9520-63d7aca0625" {
+				t.Errorf("Expected the group ID in the payload. Got %+v", actors)
+			}
+			fmt.Fprint(w, `{"actors":[{"id":10240,"name":"jira-developers"},{"id":10241,"name":"jira-testers"}]}`)
+		case http.MethodDelete:
+			removed = append(removed, r.URL.RawQuery)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	actors, _, err := testClient.Role.GetDefaultActors(context.Background(), 10360)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(actors) != 1 || actors[0].Name != "jira-developers" {
+		t.Errorf("Expected actor jira-developers. Got %+v", actors)
+	}
+
+	actors, _, err = testClient.Role.AddDefaultActors(context.Background(), 10360, &RoleActors{GroupIDs: []string{"42c8955c-63d7-42c8-9520-63d7aca0625"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(actors) != 2 {
+		t.Errorf("Expected 2 actors. Got %d", len(actors))
+	}
+
+	if _, err := testClient.Role.RemoveDefaultActors(context.Background(), 10360, &RoleActors{
+		Users:  []string{"5b10a2844c20165700ede21g"},
+		Groups: []string{"jira-testers"},
+	}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := []string{"user=5b10a2844c20165700ede21g", "group=jira-testers"}; !reflect.DeepEqual(removed, want) {
+		t.Errorf("Expected removal requests %v. Got %v", want, removed)
+	}
+}
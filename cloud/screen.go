@@ -0,0 +1,461 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ScreenService handles screens, screen tabs, screen schemes and issue type screen schemes
+// for the Jira instance / API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screens/
+type ScreenService service
+
+// Screen represents a screen, which arranges fields on tabs.
+type Screen struct {
+	ID          int64  `json:"id" structs:"id"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// ScreenTab is a tab of a screen.
+type ScreenTab struct {
+	ID   int64  `json:"id" structs:"id"`
+	Name string `json:"name" structs:"name"`
+}
+
+// ScreenableField is a field on a screen tab, or a field that can be added to a screen.
+type ScreenableField struct {
+	ID   string `json:"id" structs:"id"`
+	Name string `json:"name" structs:"name"`
+}
+
+// ScreenScheme represents a screen scheme, which assigns screens to the operations "create", "edit" and "view".
+type ScreenScheme struct {
+	ID          int64  `json:"id" structs:"id"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// Screens maps the operations (and "default", used for all operations without a screen) to screen IDs.
+	Screens map[string]int64 `json:"screens" structs:"screens"`
+}
+
+// IssueTypeScreenScheme represents an issue type screen scheme, which assigns screen schemes to the issue types of projects.
+type IssueTypeScreenScheme struct {
+	ID          string `json:"id" structs:"id"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// IssueTypeScreenSchemeMapping maps an issue type to a screen scheme within an IssueTypeScreenScheme.
+type IssueTypeScreenSchemeMapping struct {
+	// IssueTypeScreenSchemeID is only returned by ScreenService.GetIssueTypeScreenSchemeMappings.
+	IssueTypeScreenSchemeID string `json:"issueTypeScreenSchemeId,omitempty" structs:"issueTypeScreenSchemeId,omitempty"`
+	// IssueTypeID is the ID of the issue type, or "default" for all issue types without a mapping.
+	IssueTypeID    string `json:"issueTypeId" structs:"issueTypeId"`
+	ScreenSchemeID string `json:"screenSchemeId" structs:"screenSchemeId"`
+}
+
+// ScreenSearchOptions specifies the optional parameters for ScreenService.Search.
+type ScreenSearchOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// IDs restricts the results to the screens with these IDs.
+	IDs []int64 `url:"id,omitempty"`
+	// QueryString restricts the results to screens with a name containing this string.
+	QueryString string `url:"queryString,omitempty"`
+	// Scope restricts the results to screens of the scopes "GLOBAL", "TEMPLATE" or "PROJECT".
+	Scope []string `url:"scope,omitempty"`
+	// OrderBy sorts the screens, like "name" or "-id".
+	OrderBy string `url:"orderBy,omitempty"`
+}
+
+// Search returns one page of the screens matching the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screens/#api-rest-api-2-screens-get
+func (s *ScreenService) Search(ctx context.Context, options *ScreenSearchOptions) (*PagedList[Screen], *Response, error) {
+	u, err := addOptions("rest/api/2/screens", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[Screen](ctx, s.client, u, agilePaging)
+}
+
+// Create creates a screen with a default tab.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screens/#api-rest-api-2-screens-post
+func (s *ScreenService) Create(ctx context.Context, name, description string) (*Screen, *Response, error) {
+	body := struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+	}{name, description}
+	screen := new(Screen)
+	resp, err := s.do(ctx, http.MethodPost, "rest/api/2/screens", &body, screen)
+	if err != nil {
+		return nil, resp, err
+	}
+	return screen, resp, nil
+}
+
+// Update changes the name and description of the screen screenID.
+// An empty name or description is left unchanged.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screens/#api-rest-api-2-screens-screenid-put
+func (s *ScreenService) Update(ctx context.Context, screenID int64, name, description string) (*Screen, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d", screenID)
+	body := struct {
+		Name        string `json:"name,omitempty"`
+		Description string `json:"description,omitempty"`
+	}{name, description}
+	screen := new(Screen)
+	resp, err := s.do(ctx, http.MethodPut, apiEndpoint, &body, screen)
+	if err != nil {
+		return nil, resp, err
+	}
+	return screen, resp, nil
+}
+
+// Delete deletes the screen screenID.
+// Screens used by a screen scheme or workflow can't be deleted.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screens/#api-rest-api-2-screens-screenid-delete
+func (s *ScreenService) Delete(ctx context.Context, screenID int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d", screenID)
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// AddFieldToDefault adds the field fieldID to the default tab of the default screen.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screens/#api-rest-api-2-screens-addtodefault-fieldid-post
+func (s *ScreenService) AddFieldToDefault(ctx context.Context, fieldID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/addToDefault/%s", url.PathEscape(fieldID))
+	return s.do(ctx, http.MethodPost, apiEndpoint, nil, nil)
+}
+
+// GetAvailableFields returns the fields that can be added to the tabs of the screen screenID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screens/#api-rest-api-2-screens-screenid-availablefields-get
+func (s *ScreenService) GetAvailableFields(ctx context.Context, screenID int64) ([]ScreenableField, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/availableFields", screenID)
+	fields := []ScreenableField{}
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, &fields)
+	if err != nil {
+		return nil, resp, err
+	}
+	return fields, resp, nil
+}
+
+// GetTabs returns the tabs of the screen screenID.
+// If projectKey is set, only the tabs visible in the project are returned.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screen-tabs/#api-rest-api-2-screens-screenid-tabs-get
+func (s *ScreenService) GetTabs(ctx context.Context, screenID int64, projectKey string) ([]ScreenTab, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs", screenID)
+	if projectKey != "" {
+		apiEndpoint += "?projectKey=" + url.QueryEscape(projectKey)
+	}
+	tabs := []ScreenTab{}
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, &tabs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return tabs, resp, nil
+}
+
+// CreateTab adds a tab named name to the screen screenID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screen-tabs/#api-rest-api-2-screens-screenid-tabs-post
+func (s *ScreenService) CreateTab(ctx context.Context, screenID int64, name string) (*ScreenTab, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs", screenID)
+	body := struct {
+		Name string `json:"name"`
+	}{name}
+	tab := new(ScreenTab)
+	resp, err := s.do(ctx, http.MethodPost, apiEndpoint, &body, tab)
+	if err != nil {
+		return nil, resp, err
+	}
+	return tab, resp, nil
+}
+
+// RenameTab renames the tab tabID of the screen screenID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screen-tabs/#api-rest-api-2-screens-screenid-tabs-tabid-put
+func (s *ScreenService) RenameTab(ctx context.Context, screenID, tabID int64, name string) (*ScreenTab, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d", screenID, tabID)
+	body := struct {
+		Name string `json:"name"`
+	}{name}
+	tab := new(ScreenTab)
+	resp, err := s.do(ctx, http.MethodPut, apiEndpoint, &body, tab)
+	if err != nil {
+		return nil, resp, err
+	}
+	return tab, resp, nil
+}
+
+// DeleteTab deletes the tab tabID of the screen screenID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screen-tabs/#api-rest-api-2-screens-screenid-tabs-tabid-delete
+func (s *ScreenService) DeleteTab(ctx context.Context, screenID, tabID int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d", screenID, tabID)
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// MoveTab moves the tab tabID of the screen screenID to the zero based position pos.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screen-tabs/#api-rest-api-2-screens-screenid-tabs-tabid-move-pos-post
+func (s *ScreenService) MoveTab(ctx context.Context, screenID, tabID int64, pos int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d/move/%d", screenID, tabID, pos)
+	return s.do(ctx, http.MethodPost, apiEndpoint, nil, nil)
+}
+
+// GetTabFields returns the fields on the tab tabID of the screen screenID, in their order.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screen-tab-fields/#api-rest-api-2-screens-screenid-tabs-tabid-fields-get
+func (s *ScreenService) GetTabFields(ctx context.Context, screenID, tabID int64) ([]ScreenableField, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d/fields", screenID, tabID)
+	fields := []ScreenableField{}
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, &fields)
+	if err != nil {
+		return nil, resp, err
+	}
+	return fields, resp, nil
+}
+
+// AddTabField adds the field fieldID to the end of the tab tabID of the screen screenID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screen-tab-fields/#api-rest-api-2-screens-screenid-tabs-tabid-fields-post
+func (s *ScreenService) AddTabField(ctx context.Context, screenID, tabID int64, fieldID string) (*ScreenableField, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d/fields", screenID, tabID)
+	body := struct {
+		FieldID string `json:"fieldId"`
+	}{fieldID}
+	field := new(ScreenableField)
+	resp, err := s.do(ctx, http.MethodPost, apiEndpoint, &body, field)
+	if err != nil {
+		return nil, resp, err
+	}
+	return field, resp, nil
+}
+
+// RemoveTabField removes the field fieldID from the tab tabID of the screen screenID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screen-tab-fields/#api-rest-api-2-screens-screenid-tabs-tabid-fields-id-delete
+func (s *ScreenService) RemoveTabField(ctx context.Context, screenID, tabID int64, fieldID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d/fields/%s", screenID, tabID, url.PathEscape(fieldID))
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// Positions of a field on a screen tab, for ScreenTabFieldMove.Position
+const (
+	ScreenTabFieldPositionEarlier = "Earlier"
+	ScreenTabFieldPositionLater   = "Later"
+	ScreenTabFieldPositionFirst   = "First"
+	ScreenTabFieldPositionLast    = "Last"
+)
+
+// ScreenTabFieldMove describes where ScreenService.MoveTabField moves a field to.
+// Either After or Position has to be set.
+type ScreenTabFieldMove struct {
+	// After is the ID of the field the field is placed after.
+	After string `json:"after,omitempty" structs:"after,omitempty"`
+	// Position is one of the ScreenTabFieldPosition constants.
+	Position string `json:"position,omitempty" structs:"position,omitempty"`
+}
+
+// MoveTabField moves the field fieldID on the tab tabID of the screen screenID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screen-tab-fields/#api-rest-api-2-screens-screenid-tabs-tabid-fields-id-move-post
+func (s *ScreenService) MoveTabField(ctx context.Context, screenID, tabID int64, fieldID string, move *ScreenTabFieldMove) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d/fields/%s/move", screenID, tabID, url.PathEscape(fieldID))
+	return s.do(ctx, http.MethodPost, apiEndpoint, move, nil)
+}
+
+// ScreenSchemeListOptions specifies the optional parameters for ScreenService.GetSchemes.
+type ScreenSchemeListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// IDs restricts the results to the screen schemes with these IDs.
+	IDs []int64 `url:"id,omitempty"`
+	// QueryString restricts the results to screen schemes with a name containing this string.
+	QueryString string `url:"queryString,omitempty"`
+	// OrderBy sorts the screen schemes, like "name" or "-id".
+	OrderBy string `url:"orderBy,omitempty"`
+	// Expand "issueTypeScreenSchemes" to return the issue type screen schemes using a screen scheme.
+	Expand string `url:"expand,omitempty"`
+}
+
+// GetSchemes returns one page of the screen schemes matching the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screen-schemes/#api-rest-api-2-screenscheme-get
+func (s *ScreenService) GetSchemes(ctx context.Context, options *ScreenSchemeListOptions) (*PagedList[ScreenScheme], *Response, error) {
+	u, err := addOptions("rest/api/2/screenscheme", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[ScreenScheme](ctx, s.client, u, agilePaging)
+}
+
+// CreateScheme creates a screen scheme.
+// screens maps the operations "create", "edit", "view" and "default" to screen IDs. "default" is required.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screen-schemes/#api-rest-api-2-screenscheme-post
+func (s *ScreenService) CreateScheme(ctx context.Context, name, description string, screens map[string]int64) (*ScreenScheme, *Response, error) {
+	body := struct {
+		Name        string           `json:"name"`
+		Description string           `json:"description,omitempty"`
+		Screens     map[string]int64 `json:"screens"`
+	}{name, description, screens}
+	scheme := &ScreenScheme{Name: name, Description: description, Screens: screens}
+	// Jira only returns the ID of the new screen scheme.
+	resp, err := s.do(ctx, http.MethodPost, "rest/api/2/screenscheme", &body, scheme)
+	if err != nil {
+		return nil, resp, err
+	}
+	return scheme, resp, nil
+}
+
+// UpdateScheme changes the screen scheme schemeID.
+// An empty name or description is left unchanged, as are the operations missing in screens.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screen-schemes/#api-rest-api-2-screenscheme-screenschemeid-put
+func (s *ScreenService) UpdateScheme(ctx context.Context, schemeID int64, name, description string, screens map[string]int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screenscheme/%d", schemeID)
+	body := struct {
+		Name        string           `json:"name,omitempty"`
+		Description string           `json:"description,omitempty"`
+		Screens     map[string]int64 `json:"screens,omitempty"`
+	}{name, description, screens}
+	return s.do(ctx, http.MethodPut, apiEndpoint, &body, nil)
+}
+
+// DeleteScheme deletes the screen scheme schemeID.
+// Screen schemes used by an issue type screen scheme can't be deleted.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-screen-schemes/#api-rest-api-2-screenscheme-screenschemeid-delete
+func (s *ScreenService) DeleteScheme(ctx context.Context, schemeID int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screenscheme/%d", schemeID)
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// IssueTypeScreenSchemeListOptions specifies the optional parameters for ScreenService.GetIssueTypeScreenSchemes.
+type IssueTypeScreenSchemeListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// IDs restricts the results to the issue type screen schemes with these IDs.
+	IDs []int64 `url:"id,omitempty"`
+	// QueryString restricts the results to issue type screen schemes with a name containing this string.
+	QueryString string `url:"queryString,omitempty"`
+	// OrderBy sorts the issue type screen schemes, like "name" or "-id".
+	OrderBy string `url:"orderBy,omitempty"`
+	// Expand "projects" to return the projects using an issue type screen scheme.
+	Expand string `url:"expand,omitempty"`
+}
+
+// GetIssueTypeScreenSchemes returns one page of the issue type screen schemes matching the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-screen-schemes/#api-rest-api-2-issuetypescreenscheme-get
+func (s *ScreenService) GetIssueTypeScreenSchemes(ctx context.Context, options *IssueTypeScreenSchemeListOptions) (*PagedList[IssueTypeScreenScheme], *Response, error) {
+	u, err := addOptions("rest/api/2/issuetypescreenscheme", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[IssueTypeScreenScheme](ctx, s.client, u, agilePaging)
+}
+
+// CreateIssueTypeScreenScheme creates an issue type screen scheme.
+// mappings has to contain a mapping for the issue type "default".
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-screen-schemes/#api-rest-api-2-issuetypescreenscheme-post
+func (s *ScreenService) CreateIssueTypeScreenScheme(ctx context.Context, name, description string, mappings []IssueTypeScreenSchemeMapping) (*IssueTypeScreenScheme, *Response, error) {
+	body := struct {
+		Name              string                         `json:"name"`
+		Description       string                         `json:"description,omitempty"`
+		IssueTypeMappings []IssueTypeScreenSchemeMapping `json:"issueTypeMappings"`
+	}{name, description, mappings}
+	scheme := &IssueTypeScreenScheme{Name: name, Description: description}
+	// Jira only returns the ID of the new issue type screen scheme.
+	resp, err := s.do(ctx, http.MethodPost, "rest/api/2/issuetypescreenscheme", &body, scheme)
+	if err != nil {
+		return nil, resp, err
+	}
+	return scheme, resp, nil
+}
+
+// UpdateIssueTypeScreenScheme changes the name and description of the issue type screen scheme schemeID.
+// An empty name or description is left unchanged.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-screen-schemes/#api-rest-api-2-issuetypescreenscheme-issuetypescreenschemeid-put
+func (s *ScreenService) UpdateIssueTypeScreenScheme(ctx context.Context, schemeID, name, description string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetypescreenscheme/%s", url.PathEscape(schemeID))
+	body := struct {
+		Name        string `json:"name,omitempty"`
+		Description string `json:"description,omitempty"`
+	}{name, description}
+	return s.do(ctx, http.MethodPut, apiEndpoint, &body, nil)
+}
+
+// DeleteIssueTypeScreenScheme deletes the issue type screen scheme schemeID.
+// Issue type screen schemes used by a project can't be deleted.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-screen-schemes/#api-rest-api-2-issuetypescreenscheme-issuetypescreenschemeid-delete
+func (s *ScreenService) DeleteIssueTypeScreenScheme(ctx context.Context, schemeID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetypescreenscheme/%s", url.PathEscape(schemeID))
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// IssueTypeScreenSchemeMappingOptions specifies the optional parameters for ScreenService.GetIssueTypeScreenSchemeMappings.
+type IssueTypeScreenSchemeMappingOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// SchemeIDs restricts the results to the mappings of these issue type screen schemes.
+	SchemeIDs []int64 `url:"issueTypeScreenSchemeId,omitempty"`
+}
+
+// GetIssueTypeScreenSchemeMappings returns one page of the issue type to screen scheme mappings
+// of the issue type screen schemes of the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-screen-schemes/#api-rest-api-2-issuetypescreenscheme-mapping-get
+func (s *ScreenService) GetIssueTypeScreenSchemeMappings(ctx context.Context, options *IssueTypeScreenSchemeMappingOptions) (*PagedList[IssueTypeScreenSchemeMapping], *Response, error) {
+	u, err := addOptions("rest/api/2/issuetypescreenscheme/mapping", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[IssueTypeScreenSchemeMapping](ctx, s.client, u, agilePaging)
+}
+
+// SetIssueTypeScreenSchemeMappings adds the mappings to the issue type screen scheme schemeID,
+// replacing existing mappings of the same issue types.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-screen-schemes/#api-rest-api-2-issuetypescreenscheme-issuetypescreenschemeid-mapping-put
+func (s *ScreenService) SetIssueTypeScreenSchemeMappings(ctx context.Context, schemeID string, mappings []IssueTypeScreenSchemeMapping) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetypescreenscheme/%s/mapping", url.PathEscape(schemeID))
+	body := struct {
+		IssueTypeMappings []IssueTypeScreenSchemeMapping `json:"issueTypeMappings"`
+	}{mappings}
+	return s.do(ctx, http.MethodPut, apiEndpoint, &body, nil)
+}
+
+// do sends a request with the optional body to apiEndpoint and decodes the response into v, if not nil.
+func (s *ScreenService) do(ctx context.Context, method, apiEndpoint string, body, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,344 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestScreenService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/screens"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?queryString=Default&scope=GLOBAL")
+		fmt.Fprint(w, `{"maxResults":100,"startAt":0,"total":2,"isLast":true,"values":[{"id":1,"name":"Default Screen","description":"Provides for the update all system fields."},{"id":2,"name":"Workflow Screen","description":"This screen is used in the workflow and enables you to assign issues"}]}`)
+	})
+
+	page, _, err := testClient.Screen.Search(context.Background(), &ScreenSearchOptions{QueryString: "Default", Scope: []string{"GLOBAL"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 2 || page.Values[1].ID != 2 {
+		t.Errorf("Unexpected screens %+v", page.Values)
+	}
+}
+
+func TestScreenService_CreateUpdateDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/screens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"name":"Resolve Security Issue Screen","description":"Enables changes to resolution and linked issues."}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		fmt.Fprint(w, `{"id":10005,"name":"Resolve Security Issue Screen","description":"Enables changes to resolution and linked issues."}`)
+	})
+	testMux.HandleFunc("/rest/api/2/screens/10005", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"name":"Resolve Issue Screen"}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+			fmt.Fprint(w, `{"id":10005,"name":"Resolve Issue Screen","description":"Enables changes to resolution and linked issues."}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	screen, _, err := testClient.Screen.Create(context.Background(), "Resolve Security Issue Screen", "Enables changes to resolution and linked issues.")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	screen, _, err = testClient.Screen.Update(context.Background(), screen.ID, "Resolve Issue Screen", "")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if screen.Name != "Resolve Issue Screen" {
+		t.Errorf("Unexpected screen %+v", screen)
+	}
+	if _, err := testClient.Screen.Delete(context.Background(), screen.ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestScreenService_AvailableFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/screens/10005/availableFields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":"customfield_10000","name":"Approvers"}]`)
+	})
+	testMux.HandleFunc("/rest/api/2/screens/addToDefault/customfield_10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{}`)
+	})
+
+	fields, _, err := testClient.Screen.GetAvailableFields(context.Background(), 10005)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(fields) != 1 || fields[0].ID != "customfield_10000" {
+		t.Errorf("Unexpected fields %+v", fields)
+	}
+	if _, err := testClient.Screen.AddFieldToDefault(context.Background(), fields[0].ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestScreenService_Tabs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/screens/10005/tabs", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			testRequestURL(t, r, "/rest/api/2/screens/10005/tabs?projectKey=PRJ")
+			fmt.Fprint(w, `[{"id":10000,"name":"Fields Tab"}]`)
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"name":"Details"}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+			fmt.Fprint(w, `{"id":10001,"name":"Details"}`)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/screens/10005/tabs/10001", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			fmt.Fprint(w, `{"id":10001,"name":"More details"}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/screens/10005/tabs/10001/move/0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tabs, _, err := testClient.Screen.GetTabs(context.Background(), 10005, "PRJ")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(tabs) != 1 || tabs[0].Name != "Fields Tab" {
+		t.Errorf("Unexpected tabs %+v", tabs)
+	}
+
+	tab, _, err := testClient.Screen.CreateTab(context.Background(), 10005, "Details")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	tab, _, err = testClient.Screen.RenameTab(context.Background(), 10005, tab.ID, "More details")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if tab.Name != "More details" {
+		t.Errorf("Unexpected tab %+v", tab)
+	}
+	if _, err := testClient.Screen.MoveTab(context.Background(), 10005, tab.ID, 0); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Screen.DeleteTab(context.Background(), 10005, tab.ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestScreenService_TabFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/screens/10005/tabs/10000/fields", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"id":"summary","name":"Summary"}]`)
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"fieldId":"environment"}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+			fmt.Fprint(w, `{"id":"environment","name":"Environment"}`)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/screens/10005/tabs/10000/fields/environment/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"position":"First"}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/screens/10005/tabs/10000/fields/environment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	fields, _, err := testClient.Screen.GetTabFields(context.Background(), 10005, 10000)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(fields) != 1 || fields[0].ID != "summary" {
+		t.Errorf("Unexpected fields %+v", fields)
+	}
+
+	field, _, err := testClient.Screen.AddTabField(context.Background(), 10005, 10000, "environment")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if field.Name != "Environment" {
+		t.Errorf("Unexpected field %+v", field)
+	}
+	if _, err := testClient.Screen.MoveTabField(context.Background(), 10005, 10000, field.ID, &ScreenTabFieldMove{Position: ScreenTabFieldPositionFirst}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Screen.RemoveTabField(context.Background(), 10005, 10000, field.ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestScreenService_Schemes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/screenscheme", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			testRequestURL(t, r, "/rest/api/2/screenscheme?id=10010")
+			fmt.Fprint(w, `{"maxResults":25,"startAt":0,"total":1,"isLast":true,"values":[{"id":10010,"name":"Employee screen scheme","description":"Manage employee data","screens":{"default":10017,"edit":10019,"create":10019,"view":10020}}]}`)
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"name":"Employee screen scheme","screens":{"default":10017}}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+			fmt.Fprint(w, `{"id":10011}`)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/screenscheme/10011", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"screens":{"view":10020}}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+		case http.MethodDelete:
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	page, _, err := testClient.Screen.GetSchemes(context.Background(), &ScreenSchemeListOptions{IDs: []int64{10010}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 1 || page.Values[0].Screens["view"] != 10020 {
+		t.Errorf("Unexpected screen schemes %+v", page.Values)
+	}
+
+	scheme, _, err := testClient.Screen.CreateScheme(context.Background(), "Employee screen scheme", "", map[string]int64{"default": 10017})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if scheme.ID != 10011 || scheme.Name != "Employee screen scheme" {
+		t.Errorf("Unexpected screen scheme %+v", scheme)
+	}
+	if _, err := testClient.Screen.UpdateScheme(context.Background(), scheme.ID, "", "", map[string]int64{"view": 10020}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Screen.DeleteScheme(context.Background(), scheme.ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestScreenService_IssueTypeScreenSchemes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issuetypescreenscheme", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"maxResults":100,"startAt":0,"total":1,"isLast":true,"values":[{"id":"1","name":"Default Issue Type Screen Scheme","description":"The default issue type screen scheme"}]}`)
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			want := `{"name":"Scrum issue type screen scheme","issueTypeMappings":[{"issueTypeId":"default","screenSchemeId":"10001"}]}` + "\n"
+			if got := string(body); got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+			fmt.Fprint(w, `{"id":"10001"}`)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/issuetypescreenscheme/mapping", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/issuetypescreenscheme/mapping?issueTypeScreenSchemeId=10001")
+		fmt.Fprint(w, `{"maxResults":100,"startAt":0,"total":1,"isLast":true,"values":[{"issueTypeScreenSchemeId":"10001","issueTypeId":"default","screenSchemeId":"10001"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issuetypescreenscheme/10001/mapping", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"issueTypeMappings":[{"issueTypeId":"10000","screenSchemeId":"10002"}]}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/issuetypescreenscheme/10001", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut, http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	page, _, err := testClient.Screen.GetIssueTypeScreenSchemes(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 1 || page.Values[0].ID != "1" {
+		t.Errorf("Unexpected issue type screen schemes %+v", page.Values)
+	}
+
+	scheme, _, err := testClient.Screen.CreateIssueTypeScreenScheme(context.Background(), "Scrum issue type screen scheme", "",
+		[]IssueTypeScreenSchemeMapping{{IssueTypeID: "default", ScreenSchemeID: "10001"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if scheme.ID != "10001" {
+		t.Errorf("Unexpected issue type screen scheme %+v", scheme)
+	}
+
+	mappings, _, err := testClient.Screen.GetIssueTypeScreenSchemeMappings(context.Background(), &IssueTypeScreenSchemeMappingOptions{SchemeIDs: []int64{10001}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(mappings.Values) != 1 || mappings.Values[0].IssueTypeScreenSchemeID != "10001" {
+		t.Errorf("Unexpected mappings %+v", mappings.Values)
+	}
+	if _, err := testClient.Screen.SetIssueTypeScreenSchemeMappings(context.Background(), scheme.ID, []IssueTypeScreenSchemeMapping{{IssueTypeID: "10000", ScreenSchemeID: "10002"}}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Screen.UpdateIssueTypeScreenScheme(context.Background(), scheme.ID, "Renamed", ""); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Screen.DeleteIssueTypeScreenScheme(context.Background(), scheme.ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
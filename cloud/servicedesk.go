@@ -2,12 +2,9 @@ package cloud
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-
-	"github.com/google/go-querystring/query"
 )
 
 // ServiceDeskService handles ServiceDesk for the Jira instance / API.
@@ -25,27 +22,13 @@ type ServiceDeskOrganizationDTO struct {
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *ServiceDeskService) GetOrganizations(ctx context.Context, serviceDeskID interface{}, start int, limit int, accountID string) (*PagedDTO, *Response, error) {
+func (s *ServiceDeskService) GetOrganizations(ctx context.Context, serviceDeskID interface{}, start int, limit int, accountID string) (*PagedList[Organization], *Response, error) {
 	apiEndPoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/organization?start=%d&limit=%d", serviceDeskID, start, limit)
 	if accountID != "" {
 		apiEndPoint += fmt.Sprintf("&accountId=%s", accountID)
 	}
 
-	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndPoint, nil)
-	req.Header.Set("Accept", "application/json")
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	orgs := new(PagedDTO)
-	resp, err := s.client.Do(req, &orgs)
-	if err != nil {
-		jerr := NewJiraError(resp, err)
-		return nil, resp, jerr
-	}
-
-	return orgs, resp, nil
+	return getPage[Organization](ctx, s.client, apiEndPoint, serviceDeskPaging)
 }
 
 // AddOrganization adds an organization to
@@ -180,32 +163,11 @@ func (s *ServiceDeskService) RemoveCustomers(ctx context.Context, serviceDeskID
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *ServiceDeskService) ListCustomers(ctx context.Context, serviceDeskID interface{}, options *CustomerListOptions) (*CustomerList, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/customer", serviceDeskID)
-	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	url, err := addOptions(apiEndpoint, options)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// this is an experiemntal endpoint
-	req.Header.Set("X-ExperimentalApi", "opt-in")
-
-	if options != nil {
-		q, err := query.Values(options)
-		if err != nil {
-			return nil, nil, err
-		}
-		req.URL.RawQuery = q.Encode()
-	}
-
-	resp, err := s.client.Do(req, nil)
-	if err != nil {
-		return nil, resp, NewJiraError(resp, err)
-	}
-	defer resp.Body.Close()
-
-	customerList := new(CustomerList)
-	if err := json.NewDecoder(resp.Body).Decode(customerList); err != nil {
-		return nil, resp, fmt.Errorf("could not unmarshall the data into struct")
-	}
-
-	return customerList, resp, nil
+	return getPage[Customer](ctx, s.client, url, serviceDeskPaging, withHeader("X-ExperimentalApi", "opt-in"))
 }
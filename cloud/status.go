@@ -44,3 +44,178 @@ func (s *StatusService) GetAllStatuses(ctx context.Context) ([]Status, *Response
 
 	return statusList, resp, nil
 }
+
+// StatusDetails represents a status as it is returned by the statuses search and bulk endpoints.
+// Compared to Status it contains the scope and the usages of the status.
+type StatusDetails struct {
+	ID          string `json:"id" structs:"id"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// StatusCategory is one of "TODO", "IN_PROGRESS" or "DONE".
+	StatusCategory string         `json:"statusCategory" structs:"statusCategory"`
+	Scope          *StatusScope   `json:"scope,omitempty" structs:"scope,omitempty"`
+	Usages         []*StatusUsage `json:"usages,omitempty" structs:"usages,omitempty"`
+}
+
+// StatusScope describes whether a status is available globally or only in a single project.
+type StatusScope struct {
+	// Type is either "GLOBAL" or "PROJECT".
+	Type    string   `json:"type" structs:"type"`
+	Project *Project `json:"project,omitempty" structs:"project,omitempty"`
+}
+
+// StatusUsage lists the issue types of a project that use a status.
+type StatusUsage struct {
+	Project    *Project `json:"project,omitempty" structs:"project,omitempty"`
+	IssueTypes []string `json:"issueTypes,omitempty" structs:"issueTypes,omitempty"`
+}
+
+// StatusSearchOptions specifies the optional parameters for StatusService.Search.
+type StatusSearchOptions struct {
+	// ProjectID restricts the results to statuses of this project.
+	ProjectID string `url:"projectId,omitempty"`
+	// SearchString restricts the results to statuses with a name containing this string.
+	SearchString string `url:"searchString,omitempty"`
+	// StatusCategory restricts the results to statuses of this category.
+	// Valid values are "TODO", "IN_PROGRESS" and "DONE".
+	StatusCategory string `url:"statusCategory,omitempty"`
+	// Expand "usages" to return the projects and issue types using a status.
+	Expand     string `url:"expand,omitempty"`
+	StartAt    int    `url:"startAt,omitempty"`
+	MaxResults int    `url:"maxResults,omitempty"`
+}
+
+// Search returns one page of statuses matching the options.
+// Unlike GetAllStatuses this works on large instances, because the statuses are returned page by page.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-status/#api-rest-api-3-statuses-search-get
+func (s *StatusService) Search(ctx context.Context, options *StatusSearchOptions) (*PagedList[StatusDetails], *Response, error) {
+	apiEndpoint := "rest/api/3/statuses/search"
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[StatusDetails](ctx, s.client, url, agilePaging)
+}
+
+// StatusGetOptions specifies the optional parameters for StatusService.GetByIDs.
+type StatusGetOptions struct {
+	// Expand "usages" to return the projects and issue types using a status.
+	Expand string `url:"expand,omitempty"`
+}
+
+// GetByIDs returns the statuses with the given IDs.
+// Up to 50 IDs can be requested at once.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-status/#api-rest-api-3-statuses-get
+func (s *StatusService) GetByIDs(ctx context.Context, ids []string, options *StatusGetOptions) ([]StatusDetails, *Response, error) {
+	apiEndpoint := "rest/api/3/statuses"
+	query := struct {
+		ID     []string `url:"id"`
+		Expand string   `url:"expand,omitempty"`
+	}{ID: ids}
+	if options != nil {
+		query.Expand = options.Expand
+	}
+	url, err := addOptions(apiEndpoint, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := []StatusDetails{}
+	resp, err := s.client.Do(req, &statuses)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return statuses, resp, nil
+}
+
+// StatusDefinition is a status created by StatusService.Create or changed by StatusService.Update.
+type StatusDefinition struct {
+	// ID is required for updates.
+	ID   string `json:"id,omitempty" structs:"id,omitempty"`
+	Name string `json:"name" structs:"name"`
+	// StatusCategory is one of "TODO", "IN_PROGRESS" or "DONE".
+	StatusCategory string `json:"statusCategory" structs:"statusCategory"`
+	Description    string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// Create creates the statuses in scope, either globally or in a team-managed project.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-status/#api-rest-api-3-statuses-post
+func (s *StatusService) Create(ctx context.Context, scope WorkflowScope, statuses []StatusDefinition) ([]StatusDetails, *Response, error) {
+	apiEndpoint := "rest/api/3/statuses"
+	payload := struct {
+		Scope    WorkflowScope      `json:"scope"`
+		Statuses []StatusDefinition `json:"statuses"`
+	}{scope, statuses}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := []StatusDetails{}
+	resp, err := s.client.Do(req, &created)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return created, resp, nil
+}
+
+// Update changes the name, category or description of the statuses, identified by their ID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-status/#api-rest-api-3-statuses-put
+func (s *StatusService) Update(ctx context.Context, statuses []StatusDefinition) (*Response, error) {
+	apiEndpoint := "rest/api/3/statuses"
+	payload := struct {
+		Statuses []StatusDefinition `json:"statuses"`
+	}{statuses}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Delete deletes the statuses with the given IDs.
+// Statuses still used by a workflow can't be deleted.
+// Up to 50 IDs can be deleted at once.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-status/#api-rest-api-3-statuses-delete
+func (s *StatusService) Delete(ctx context.Context, ids []string) (*Response, error) {
+	apiEndpoint := "rest/api/3/statuses"
+	query := struct {
+		ID []string `url:"id"`
+	}{ids}
+	url, err := addOptions(apiEndpoint, query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
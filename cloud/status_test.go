@@ -3,6 +3,7 @@ package cloud
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"testing"
@@ -34,3 +35,137 @@ func TestStatusService_GetAllStatuses(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestStatusService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/statuses/search"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("startAt") == "1" {
+			testRequestURL(t, r, testapiEndpoint+"?expand=usages&maxResults=1&projectId=10000&startAt=1&statusCategory=DONE")
+			fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"isLast":true,"values":[{"id":"10002","name":"Closed","statusCategory":"DONE","scope":{"type":"GLOBAL"}}]}`)
+			return
+		}
+		testRequestURL(t, r, testapiEndpoint+"?expand=usages&maxResults=1&projectId=10000&statusCategory=DONE")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"isLast":false,"values":[{"id":"10001","name":"Done","statusCategory":"DONE","scope":{"type":"PROJECT","project":{"id":"10000"}},"usages":[{"project":{"id":"10000"},"issueTypes":["10002"]}]}]}`)
+	})
+
+	page, _, err := testClient.Status.Search(context.Background(), &StatusSearchOptions{
+		ProjectID:      "10000",
+		StatusCategory: "DONE",
+		Expand:         "usages",
+		MaxResults:     1,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 1 || page.Values[0].Name != "Done" {
+		t.Fatalf("Expected status Done. Got %+v", page.Values)
+	}
+	if got := page.Values[0].Scope.Project.ID; got != "10000" {
+		t.Errorf("Expected scope project 10000. Got %s", got)
+	}
+	if got := page.Values[0].Usages[0].IssueTypes; len(got) != 1 || got[0] != "10002" {
+		t.Errorf("Expected usage by issue type 10002. Got %v", got)
+	}
+
+	next, _, err := page.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(next.Values) != 1 || next.Values[0].Name != "Closed" {
+		t.Errorf("Expected status Closed. Got %+v", next.Values)
+	}
+	if next.HasNext() {
+		t.Error("Expected the second page to be the last page")
+	}
+}
+
+func TestStatusService_GetByIDs(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/statuses"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?id=1&id=3")
+		fmt.Fprint(w, `[{"id":"1","name":"Open","statusCategory":"TODO"},{"id":"3","name":"In Progress","statusCategory":"IN_PROGRESS"}]`)
+	})
+
+	statuses, _, err := testClient.Status.GetByIDs(context.Background(), []string{"1", "3"}, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(statuses) != 2 || statuses[1].StatusCategory != "IN_PROGRESS" {
+		t.Errorf("Expected two statuses. Got %+v", statuses)
+	}
+}
+
+func TestStatusService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/statuses"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testapiEndpoint)
+		body, _ := io.ReadAll(r.Body)
+		want := `{"scope":{"type":"PROJECT","project":{"id":"10001"}},"statuses":[{"name":"Finished","statusCategory":"DONE","description":"The issue is resolved"}]}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body\ngot:  %s\nwant: %s", got, want)
+		}
+		fmt.Fprint(w, `[{"id":"1000","name":"Finished","statusCategory":"DONE","description":"The issue is resolved","scope":{"type":"PROJECT","project":{"id":"10001"}}}]`)
+	})
+
+	scope := WorkflowScope{Type: WorkflowScopeTypeProject, Project: &WorkflowScopeProject{ID: "10001"}}
+	statuses, _, err := testClient.Status.Create(context.Background(), scope, []StatusDefinition{
+		{Name: "Finished", StatusCategory: "DONE", Description: "The issue is resolved"},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(statuses) != 1 || statuses[0].ID != "1000" || statuses[0].Scope.Project.ID != "10001" {
+		t.Errorf("Unexpected statuses %+v", statuses)
+	}
+}
+
+func TestStatusService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/statuses"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint)
+		body, _ := io.ReadAll(r.Body)
+		want := `{"statuses":[{"id":"1000","name":"Done","statusCategory":"DONE"}]}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body\ngot:  %s\nwant: %s", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Status.Update(context.Background(), []StatusDefinition{{ID: "1000", Name: "Done", StatusCategory: "DONE"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+}
+
+func TestStatusService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/statuses"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testapiEndpoint+"?id=1000&id=1001")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Status.Delete(context.Background(), []string{"1000", "1001"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+}
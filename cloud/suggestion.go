@@ -0,0 +1,107 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// FieldValueSuggestion is a value suggested for a field, like a label or a custom field option.
+type FieldValueSuggestion struct {
+	Value string `json:"value" structs:"value"`
+	// DisplayName is the value with the matching part highlighted by HTML bold tags.
+	DisplayName string `json:"displayName" structs:"displayName"`
+}
+
+// FieldValueSuggestionOptions specifies the parameters for FieldService.GetValueSuggestions.
+type FieldValueSuggestionOptions struct {
+	// FieldName is the JQL name of the field, like "labels" or "cf[10000]" for a custom field.
+	FieldName string `url:"fieldName,omitempty"`
+	// FieldValue is the partial value typed by the user.
+	FieldValue string `url:"fieldValue,omitempty"`
+	// PredicateName and PredicateValue narrow down the suggestions of a CHANGED operator predicate, like "by".
+	PredicateName  string `url:"predicateName,omitempty"`
+	PredicateValue string `url:"predicateValue,omitempty"`
+}
+
+// GetValueSuggestions returns the values of a field matching the partial field value.
+// These are the same type-ahead suggestions Jira shows in its own pickers.
+// Use the JQL name of a field, for example "cf[10000]", to get the options of a select list custom field.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-jql/#api-rest-api-3-jql-autocompletedata-suggestions-get
+func (s *FieldService) GetValueSuggestions(ctx context.Context, options *FieldValueSuggestionOptions) ([]FieldValueSuggestion, *Response, error) {
+	apiEndpoint := "rest/api/3/jql/autocompletedata/suggestions"
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(struct {
+		Results []FieldValueSuggestion `json:"results"`
+	})
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result.Results, resp, nil
+}
+
+// GetLabelSuggestions returns the labels starting with or containing query.
+func (s *FieldService) GetLabelSuggestions(ctx context.Context, query string) ([]string, *Response, error) {
+	suggestions, resp, err := s.GetValueSuggestions(ctx, &FieldValueSuggestionOptions{
+		FieldName:  "labels",
+		FieldValue: query,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	labels := make([]string, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		labels = append(labels, suggestion.Value)
+	}
+	return labels, resp, nil
+}
+
+// LabelListOptions specifies the optional parameters for FieldService.GetLabels.
+type LabelListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// GetLabels returns one page of all labels of the instance.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-labels/#api-rest-api-3-label-get
+func (s *FieldService) GetLabels(ctx context.Context, options *LabelListOptions) (*PagedList[string], *Response, error) {
+	apiEndpoint := "rest/api/3/label"
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[string](ctx, s.client, url, agilePaging)
+}
+
+// customFieldJQLName returns the JQL name of a custom field ID, like "cf[10000]" for "customfield_10000".
+// Other field IDs are returned as they are.
+func customFieldJQLName(fieldID string) string {
+	if id := strings.TrimPrefix(fieldID, "customfield_"); id != fieldID {
+		return "cf[" + id + "]"
+	}
+	return fieldID
+}
+
+// GetOptionSuggestions returns the options of a custom field (by ID, like "customfield_10000") matching query.
+func (s *FieldService) GetOptionSuggestions(ctx context.Context, fieldID, query string) ([]FieldValueSuggestion, *Response, error) {
+	return s.GetValueSuggestions(ctx, &FieldValueSuggestionOptions{
+		FieldName:  customFieldJQLName(fieldID),
+		FieldValue: query,
+	})
+}
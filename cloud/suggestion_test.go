@@ -0,0 +1,69 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestFieldService_GetLabelSuggestions(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/jql/autocompletedata/suggestions"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?fieldName=labels&fieldValue=ba")
+		fmt.Fprint(w, `{"results":[{"value":"backend","displayName":"<b>ba</b>ckend"},{"value":"batch","displayName":"<b>ba</b>tch"}]}`)
+	})
+
+	labels, _, err := testClient.Field.GetLabelSuggestions(context.Background(), "ba")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := []string{"backend", "batch"}; !reflect.DeepEqual(labels, want) {
+		t.Errorf("Expected labels %v. Got %v", want, labels)
+	}
+}
+
+func TestFieldService_GetOptionSuggestions(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/jql/autocompletedata/suggestions"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?fieldName=cf%5B10000%5D&fieldValue=hi")
+		fmt.Fprint(w, `{"results":[{"value":"High","displayName":"<b>Hi</b>gh"}]}`)
+	})
+
+	options, _, err := testClient.Field.GetOptionSuggestions(context.Background(), "customfield_10000", "hi")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(options) != 1 || options[0].Value != "High" {
+		t.Errorf("Expected option High. Got %+v", options)
+	}
+}
+
+func TestFieldService_GetLabels(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/label"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?maxResults=2")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":2,"isLast":true,"values":["backend","frontend"]}`)
+	})
+
+	labels, _, err := testClient.Field.GetLabels(context.Background(), &LabelListOptions{MaxResults: 2})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := []string{"backend", "frontend"}; !reflect.DeepEqual(labels.Values, want) {
+		t.Errorf("Expected labels %v. Got %v", want, labels.Values)
+	}
+}
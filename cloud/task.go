@@ -0,0 +1,122 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// TaskService handles long-running asynchronous tasks for the Jira instance / API,
+// like the deletion of a project via ProjectService.DeleteAsync.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-tasks/
+type TaskService service
+
+// Status of a task
+const (
+	TaskStatusEnqueued        = "ENQUEUED"
+	TaskStatusRunning         = "RUNNING"
+	TaskStatusComplete        = "COMPLETE"
+	TaskStatusFailed          = "FAILED"
+	TaskStatusCancelRequested = "CANCEL_REQUESTED"
+	TaskStatusCancelled       = "CANCELLED"
+	TaskStatusDead            = "DEAD"
+)
+
+// defaultTaskPollInterval is the interval TaskService.Wait requests the progress of a task with by default.
+const defaultTaskPollInterval = time.Second
+
+// Task is the progress of a long-running asynchronous task.
+type Task struct {
+	Self        string `json:"self" structs:"self"`
+	ID          string `json:"id" structs:"id"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// Status is one of the TaskStatus constants.
+	Status  string `json:"status" structs:"status"`
+	Message string `json:"message,omitempty" structs:"message,omitempty"`
+	// Result is the result of the task, if any. Its format depends on the task.
+	Result interface{} `json:"result,omitempty" structs:"result,omitempty"`
+	// SubmittedBy is the ID of the user who submitted the task.
+	SubmittedBy int64 `json:"submittedBy" structs:"submittedBy"`
+	// Progress is the progress of the task in percent.
+	Progress int64 `json:"progress" structs:"progress"`
+	// ElapsedRuntime is the runtime of the task in milliseconds.
+	ElapsedRuntime int64 `json:"elapsedRuntime" structs:"elapsedRuntime"`
+	// Submitted, Started, Finished and LastUpdate are times in milliseconds since the Unix epoch.
+	Submitted  int64 `json:"submitted" structs:"submitted"`
+	Started    int64 `json:"started,omitempty" structs:"started,omitempty"`
+	Finished   int64 `json:"finished,omitempty" structs:"finished,omitempty"`
+	LastUpdate int64 `json:"lastUpdate" structs:"lastUpdate"`
+}
+
+// Done reports whether the task ended, successfully or not.
+func (t *Task) Done() bool {
+	switch t.Status {
+	case TaskStatusComplete, TaskStatusFailed, TaskStatusCancelled, TaskStatusDead:
+		return true
+	}
+	return false
+}
+
+// Get returns the progress of the task taskID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-tasks/#api-rest-api-2-task-taskid-get
+func (s *TaskService) Get(ctx context.Context, taskID string) (*Task, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/task/%s", url.PathEscape(taskID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(Task)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return task, resp, nil
+}
+
+// Cancel requests the cancellation of the task taskID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-tasks/#api-rest-api-2-task-taskid-cancel-post
+func (s *TaskService) Cancel(ctx context.Context, taskID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/task/%s/cancel", url.PathEscape(taskID))
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Wait requests the progress of the task taskID every interval until the task is done
+// or ctx is done. An interval of zero or less polls every second.
+//
+// The task is returned for every final status, so callers have to check Task.Status
+// to find out whether the task succeeded.
+func (s *TaskService) Wait(ctx context.Context, taskID string, interval time.Duration) (*Task, *Response, error) {
+	if interval <= 0 {
+		interval = defaultTaskPollInterval
+	}
+
+	for {
+		task, resp, err := s.Get(ctx, taskID)
+		if err != nil || task.Done() {
+			return task, resp, err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return task, resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
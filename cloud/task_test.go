@@ -0,0 +1,86 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTaskService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/task/10641", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/task/10641")
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/task/10641","id":"10641","description":"Deleting project EX","status":"COMPLETE","result":"the task result, this may be any JSON","submittedBy":10000,"progress":100,"elapsedRuntime":156,"submitted":1501708132800,"started":1501708132900,"finished":1501708133000,"lastUpdate":1501708133000}`)
+	})
+
+	task, _, err := testClient.Task.Get(context.Background(), "10641")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if task == nil {
+		t.Fatal("Expected task. Task is nil")
+	}
+	if task.Progress != 100 || task.Finished != 1501708133000 || !task.Done() {
+		t.Errorf("Unexpected task %+v", task)
+	}
+}
+
+func TestTaskService_Cancel(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/task/10641/cancel", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, "/rest/api/2/task/10641/cancel")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	if _, err := testClient.Task.Cancel(context.Background(), "10641"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestTaskService_Wait(t *testing.T) {
+	setup()
+	defer teardown()
+	statuses := []string{TaskStatusEnqueued, TaskStatusRunning, TaskStatusComplete}
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/task/10641", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprintf(w, `{"id":"10641","status":%q}`, statuses[requests])
+		requests++
+	})
+
+	task, _, err := testClient.Task.Wait(context.Background(), "10641", time.Millisecond)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if task == nil || task.Status != TaskStatusComplete {
+		t.Errorf("Expected completed task, got %+v", task)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+}
+
+func TestTaskService_Wait_ContextDone(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/task/10641", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"10641","status":"RUNNING"}`)
+	})
+
+	// The deadline is exceeded while Wait sleeps between two polls, never during a request.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	task, _, err := testClient.Task.Wait(ctx, "10641", time.Hour)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if task == nil || task.Status != TaskStatusRunning {
+		t.Errorf("Expected running task, got %+v", task)
+	}
+}
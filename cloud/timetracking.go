@@ -0,0 +1,129 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// TimeTrackingService handles the time tracking configuration for the Jira instance / API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-time-tracking/
+type TimeTrackingService service
+
+// TimeTrackingProvider represents a time tracking provider, like the built-in "JIRA" provider.
+type TimeTrackingProvider struct {
+	Key  string `json:"key" structs:"key"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+	URL  string `json:"url,omitempty" structs:"url,omitempty"`
+}
+
+// TimeTrackingOptions represents the settings used to convert and display durations.
+type TimeTrackingOptions struct {
+	WorkingHoursPerDay float64 `json:"workingHoursPerDay" structs:"workingHoursPerDay"`
+	WorkingDaysPerWeek float64 `json:"workingDaysPerWeek" structs:"workingDaysPerWeek"`
+	// TimeFormat is one of "pretty", "days" or "hours".
+	TimeFormat string `json:"timeFormat" structs:"timeFormat"`
+	// DefaultUnit is one of "minute", "hour", "day" or "week".
+	DefaultUnit string `json:"defaultUnit" structs:"defaultUnit"`
+}
+
+// GetSelectedProvider returns the time tracking provider that is currently selected.
+// If time tracking is disabled, nil is returned.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-time-tracking/#api-rest-api-3-configuration-timetracking-get
+func (s *TimeTrackingService) GetSelectedProvider(ctx context.Context) (*TimeTrackingProvider, *Response, error) {
+	apiEndpoint := "rest/api/3/configuration/timetracking"
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	defer resp.Body.Close()
+
+	// Jira answers without a body if time tracking is disabled
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, resp, nil
+	}
+
+	provider := new(TimeTrackingProvider)
+	if err := json.NewDecoder(resp.Body).Decode(provider); err != nil {
+		return nil, resp, err
+	}
+	return provider, resp, nil
+}
+
+// SelectProvider selects a time tracking provider (by key).
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-time-tracking/#api-rest-api-3-configuration-timetracking-put
+func (s *TimeTrackingService) SelectProvider(ctx context.Context, key string) (*Response, error) {
+	apiEndpoint := "rest/api/3/configuration/timetracking"
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, &TimeTrackingProvider{Key: key})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GetAllProviders returns all time tracking providers.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-time-tracking/#api-rest-api-3-configuration-timetracking-list-get
+func (s *TimeTrackingService) GetAllProviders(ctx context.Context) ([]TimeTrackingProvider, *Response, error) {
+	apiEndpoint := "rest/api/3/configuration/timetracking/list"
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	providers := []TimeTrackingProvider{}
+	resp, err := s.client.Do(req, &providers)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return providers, resp, nil
+}
+
+// GetOptions returns the time tracking settings, like the working hours per day.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-time-tracking/#api-rest-api-3-configuration-timetracking-options-get
+func (s *TimeTrackingService) GetOptions(ctx context.Context) (*TimeTrackingOptions, *Response, error) {
+	apiEndpoint := "rest/api/3/configuration/timetracking/options"
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	options := new(TimeTrackingOptions)
+	resp, err := s.client.Do(req, options)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return options, resp, nil
+}
+
+// SetOptions updates the time tracking settings and returns the stored settings.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-time-tracking/#api-rest-api-3-configuration-timetracking-options-put
+func (s *TimeTrackingService) SetOptions(ctx context.Context, options *TimeTrackingOptions) (*TimeTrackingOptions, *Response, error) {
+	apiEndpoint := "rest/api/3/configuration/timetracking/options"
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stored := new(TimeTrackingOptions)
+	resp, err := s.client.Do(req, stored)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return stored, resp, nil
+}
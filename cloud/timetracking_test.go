@@ -0,0 +1,122 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestTimeTrackingService_GetSelectedProvider(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/configuration/timetracking"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"key":"Jira","name":"JIRA provided time tracking","url":"/example/config/url"}`)
+	})
+
+	provider, _, err := testClient.TimeTracking.GetSelectedProvider(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if provider == nil || provider.Key != "Jira" {
+		t.Errorf("Expected provider Jira. Got %+v", provider)
+	}
+}
+
+func TestTimeTrackingService_GetSelectedProvider_Disabled(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/configuration/timetracking", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	provider, _, err := testClient.TimeTracking.GetSelectedProvider(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if provider != nil {
+		t.Errorf("Expected no provider if time tracking is disabled. Got %+v", provider)
+	}
+}
+
+func TestTimeTrackingService_SelectProvider(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/configuration/timetracking", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+
+		provider := new(TimeTrackingProvider)
+		if err := json.NewDecoder(r.Body).Decode(provider); err != nil {
+			t.Fatal(err)
+		}
+		if provider.Key != "Jira" {
+			t.Errorf("Expected provider key Jira. Got %q", provider.Key)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.TimeTracking.SelectProvider(context.Background(), "Jira"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestTimeTrackingService_GetAllProviders(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/configuration/timetracking/list"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `[{"key":"Jira","name":"JIRA provided time tracking"},{"key":"Tempo","name":"Tempo Timesheets"}]`)
+	})
+
+	providers, _, err := testClient.TimeTracking.GetAllProviders(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(providers) != 2 {
+		t.Errorf("Expected 2 providers. Got %d", len(providers))
+	}
+}
+
+func TestTimeTrackingService_Options(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/configuration/timetracking/options"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, testapiEndpoint)
+		if r.Method == http.MethodPut {
+			options := new(TimeTrackingOptions)
+			if err := json.NewDecoder(r.Body).Decode(options); err != nil {
+				t.Fatal(err)
+			}
+			if options.WorkingHoursPerDay != 7.5 {
+				t.Errorf("Expected 7.5 working hours per day. Got %v", options.WorkingHoursPerDay)
+			}
+		}
+		fmt.Fprint(w, `{"workingHoursPerDay":7.5,"workingDaysPerWeek":5,"timeFormat":"pretty","defaultUnit":"hour"}`)
+	})
+
+	options, _, err := testClient.TimeTracking.GetOptions(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if options.WorkingDaysPerWeek != 5 || options.DefaultUnit != "hour" {
+		t.Errorf("Unexpected options: %+v", options)
+	}
+
+	stored, _, err := testClient.TimeTracking.SetOptions(context.Background(), options)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if stored.TimeFormat != "pretty" {
+		t.Errorf("Unexpected stored options: %+v", stored)
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 // UserService handles users for the Jira instance / API.
@@ -67,14 +70,68 @@ type ApplicationRole struct {
 	// Key `defaultGroupsDetails` missing - https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-application-roles/#api-rest-api-3-applicationrole-key-get
 }
 
-type UserSearchParam struct {
-	name  string
-	value string
+// UserSearchOptions specifies the parameters for UserService.Find.
+// At least one of Query, AccountID or Property is required.
+type UserSearchOptions struct {
+	// Query is matched against the display name and email address of users.
+	Query string `url:"query,omitempty"`
+	// AccountID restricts the result to the user with the account ID.
+	AccountID string `url:"accountId,omitempty"`
+	// Property is a user property query, like "thepropertykey.something.nested=1".
+	Property   string `url:"property,omitempty"`
+	StartAt    int    `url:"startAt,omitempty"`
+	MaxResults int    `url:"maxResults,omitempty"`
 }
 
-type UserSearch []UserSearchParam
+// UserAssignableSearchOptions specifies the parameters for UserService.FindAssignable.
+// One of Project, IssueKey or IssueID is required.
+type UserAssignableSearchOptions struct {
+	Query     string `url:"query,omitempty"`
+	AccountID string `url:"accountId,omitempty"`
+	// Project is the key or ID of the project new issues are assigned in.
+	Project  string `url:"project,omitempty"`
+	IssueKey string `url:"issueKey,omitempty"`
+	IssueID  string `url:"issueId,omitempty"`
+	// ActionDescriptorID is the ID of a workflow transition, to find users assignable during it.
+	ActionDescriptorID int  `url:"actionDescriptorId,omitempty"`
+	Recommend          bool `url:"recommend,omitempty"`
+	StartAt            int  `url:"startAt,omitempty"`
+	MaxResults         int  `url:"maxResults,omitempty"`
+}
+
+// UserMultiProjectAssignableSearchOptions specifies the parameters for UserService.FindAssignableMultiProject.
+type UserMultiProjectAssignableSearchOptions struct {
+	Query     string `url:"query,omitempty"`
+	AccountID string `url:"accountId,omitempty"`
+	// ProjectKeys are the projects the users have to be assignable in. It is required.
+	ProjectKeys []string `url:"projectKeys,comma,omitempty"`
+	StartAt     int      `url:"startAt,omitempty"`
+	MaxResults  int      `url:"maxResults,omitempty"`
+}
 
-type UserSearchF func(UserSearch) UserSearch
+// UserPermissionSearchOptions specifies the parameters for UserService.FindWithPermissions.
+// Without IssueKey and ProjectKey, the permissions are checked globally.
+type UserPermissionSearchOptions struct {
+	Query     string `url:"query,omitempty"`
+	AccountID string `url:"accountId,omitempty"`
+	// Permissions the users need to hold all of. It is required.
+	Permissions []PermissionKey `url:"permissions,comma,omitempty"`
+	IssueKey    string          `url:"issueKey,omitempty"`
+	ProjectKey  string          `url:"projectKey,omitempty"`
+	StartAt     int             `url:"startAt,omitempty"`
+	MaxResults  int             `url:"maxResults,omitempty"`
+}
+
+// UserViewableSearchOptions specifies the parameters for UserService.FindViewable.
+// One of IssueKey or ProjectKey is required.
+type UserViewableSearchOptions struct {
+	Query      string `url:"query,omitempty"`
+	AccountID  string `url:"accountId,omitempty"`
+	IssueKey   string `url:"issueKey,omitempty"`
+	ProjectKey string `url:"projectKey,omitempty"`
+	StartAt    int    `url:"startAt,omitempty"`
+	MaxResults int    `url:"maxResults,omitempty"`
+}
 
 // Get gets user info from Jira using its Account Id
 //
@@ -191,10 +248,14 @@ func (s *UserService) GetGroups(ctx context.Context, accountId string) (*[]UserG
 }
 
 // GetCurrentUser returns details for the current user.
+// expand can be "groups" and "applicationRoles" to return the groups and application roles of the user.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-myself/#api-rest-api-3-myself-get
-func (s *UserService) GetCurrentUser(ctx context.Context) (*User, *Response, error) {
-	const apiEndpoint = "rest/api/3/myself"
+func (s *UserService) GetCurrentUser(ctx context.Context, expand ...string) (*User, *Response, error) {
+	apiEndpoint := "rest/api/3/myself"
+	if len(expand) > 0 {
+		apiEndpoint += "?expand=" + url.QueryEscape(strings.Join(expand, ","))
+	}
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -209,95 +270,136 @@ func (s *UserService) GetCurrentUser(ctx context.Context) (*User, *Response, err
 	return &user, resp, nil
 }
 
-// WithMaxResults sets the max results to return
-func WithMaxResults(maxResults int) UserSearchF {
-	return func(s UserSearch) UserSearch {
-		s = append(s, UserSearchParam{name: "maxResults", value: fmt.Sprintf("%d", maxResults)})
-		return s
-	}
+// Find returns one page of the active and inactive users matching the options.
+// Jira may return fewer users than options.MaxResults although more follow, use UserService.FindPager to get all of them.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-user-search/#api-rest-api-2-user-search-get
+func (s *UserService) Find(ctx context.Context, options *UserSearchOptions) ([]User, *Response, error) {
+	return s.findUsers(ctx, "rest/api/2/user/search", options)
 }
 
-// WithStartAt set the start pager
-func WithStartAt(startAt int) UserSearchF {
-	return func(s UserSearch) UserSearch {
-		s = append(s, UserSearchParam{name: "startAt", value: fmt.Sprintf("%d", startAt)})
-		return s
-	}
+// FindAssignable returns one page of the users that can be assigned to the issue or to new issues of the project.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-user-search/#api-rest-api-2-user-assignable-search-get
+func (s *UserService) FindAssignable(ctx context.Context, options *UserAssignableSearchOptions) ([]User, *Response, error) {
+	return s.findUsers(ctx, "rest/api/2/user/assignable/search", options)
 }
 
-// WithActive sets the active users lookup
-func WithActive(active bool) UserSearchF {
-	return func(s UserSearch) UserSearch {
-		s = append(s, UserSearchParam{name: "includeActive", value: fmt.Sprintf("%t", active)})
-		return s
-	}
+// FindAssignableMultiProject returns one page of the users that can be assigned to issues in all of the projects.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-user-search/#api-rest-api-2-user-assignable-multiprojectsearch-get
+func (s *UserService) FindAssignableMultiProject(ctx context.Context, options *UserMultiProjectAssignableSearchOptions) ([]User, *Response, error) {
+	return s.findUsers(ctx, "rest/api/2/user/assignable/multiProjectSearch", options)
 }
 
-// WithInactive sets the inactive users lookup
-func WithInactive(inactive bool) UserSearchF {
-	return func(s UserSearch) UserSearch {
-		s = append(s, UserSearchParam{name: "includeInactive", value: fmt.Sprintf("%t", inactive)})
-		return s
-	}
+// FindWithPermissions returns one page of the users that hold all of the permissions in the context of the issue or project.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-user-search/#api-rest-api-2-user-permission-search-get
+func (s *UserService) FindWithPermissions(ctx context.Context, options *UserPermissionSearchOptions) ([]User, *Response, error) {
+	return s.findUsers(ctx, "rest/api/2/user/permission/search", options)
 }
 
-// WithUsername sets the username to search
-func WithUsername(username string) UserSearchF {
-	return func(s UserSearch) UserSearch {
-		s = append(s, UserSearchParam{name: "username", value: username})
-		return s
-	}
+// FindViewable returns one page of the users that have permission to view the issue, or the issues of the project.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-user-search/#api-rest-api-2-user-viewissue-search-get
+func (s *UserService) FindViewable(ctx context.Context, options *UserViewableSearchOptions) ([]User, *Response, error) {
+	return s.findUsers(ctx, "rest/api/2/user/viewissue/search", options)
 }
 
-// WithAccountId sets the account id to search
-func WithAccountId(accountId string) UserSearchF {
-	return func(s UserSearch) UserSearch {
-		s = append(s, UserSearchParam{name: "accountId", value: accountId})
-		return s
+func (s *UserService) findUsers(ctx context.Context, apiEndpoint string, options interface{}) ([]User, *Response, error) {
+	u, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
 	}
-}
 
-// WithProperty sets the property (Property keys are specified by path) to search
-func WithProperty(property string) UserSearchF {
-	return func(s UserSearch) UserSearch {
-		s = append(s, UserSearchParam{name: "property", value: property})
-		return s
+	users := []User{}
+	resp, err := s.client.Do(req, &users)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
 	}
+	return users, resp, nil
 }
 
-// Find searches for user info from Jira:
-// It can find users by email or display name using the query parameter
-//
-// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-user-search-get
+// userBulkLimit is the maximum number of users the bulk endpoints accept per request.
+const userBulkLimit = 100
+
+// UserAccountID maps the username and key of a user to the account ID, as returned by UserService.GetAccountIDs.
+type UserAccountID struct {
+	Username  string `json:"username,omitempty" structs:"username,omitempty"`
+	Key       string `json:"key,omitempty" structs:"key,omitempty"`
+	AccountID string `json:"accountId" structs:"accountId"`
+}
+
+// GetBulk returns the users with the account IDs.
+// Any number of account IDs can be given, they are requested in chunks of 100.
+// Account IDs that don't exist are left out.
 //
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *UserService) Find(ctx context.Context, property string, tweaks ...UserSearchF) ([]User, *Response, error) {
-	search := []UserSearchParam{
-		{
-			name:  "query",
-			value: property,
-		},
-	}
-	for _, f := range tweaks {
-		search = f(search)
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-users/#api-rest-api-2-user-bulk-get
+func (s *UserService) GetBulk(ctx context.Context, accountIDs []string) ([]User, error) {
+	users := []User{}
+	for _, chunk := range chunkStrings(accountIDs, userBulkLimit) {
+		options := struct {
+			AccountIDs []string `url:"accountId"`
+			MaxResults int      `url:"maxResults"`
+		}{chunk, len(chunk)}
+		u, err := addOptions("rest/api/2/user/bulk", &options)
+		if err != nil {
+			return nil, err
+		}
+
+		values, err := allPages[User](ctx, s.client, u, agilePaging)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, values...)
 	}
+	return users, nil
+}
 
-	var queryString = ""
-	for _, param := range search {
-		queryString += param.name + "=" + param.value + "&"
+// GetAccountIDs returns the account IDs of the users with the usernames or keys, which were used before
+// Jira Cloud switched to account IDs.
+// Any number of usernames and keys can be given, they are requested in chunks of 100.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-users/#api-rest-api-2-user-bulk-migration-get
+func (s *UserService) GetAccountIDs(ctx context.Context, usernames, keys []string) ([]UserAccountID, error) {
+	accountIDs := []UserAccountID{}
+	queries := []struct {
+		name   string
+		values []string
+	}{{"username", usernames}, {"key", keys}}
+	for _, query := range queries {
+		for _, chunk := range chunkStrings(query.values, userBulkLimit) {
+			q := url.Values{query.name: chunk}
+			q.Set("maxResults", strconv.Itoa(len(chunk)))
+			req, err := s.client.NewRequest(ctx, http.MethodGet, "rest/api/2/user/bulk/migration?"+q.Encode(), nil)
+			if err != nil {
+				return nil, err
+			}
+
+			var values []UserAccountID
+			resp, err := s.client.Do(req, &values)
+			if err != nil {
+				return nil, NewJiraError(resp, err)
+			}
+			accountIDs = append(accountIDs, values...)
+		}
 	}
+	return accountIDs, nil
+}
 
-	apiEndpoint := fmt.Sprintf("/rest/api/2/user/search?%s", queryString[:len(queryString)-1])
-	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
-	if err != nil {
-		return nil, nil, err
+// chunkStrings splits values into chunks of at most size values.
+func chunkStrings(values []string, size int) [][]string {
+	var chunks [][]string
+	for len(values) > size {
+		chunks = append(chunks, values[:size])
+		values = values[size:]
 	}
-
-	users := []User{}
-	resp, err := s.client.Do(req, &users)
-	if err != nil {
-		return nil, resp, NewJiraError(resp, err)
+	if len(values) > 0 {
+		chunks = append(chunks, values)
 	}
-	return users, resp, nil
+	return chunks
 }
@@ -242,7 +242,7 @@ func TestUserService_Find_Success(t *testing.T) {
 	defer teardown()
 	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodGet)
-		testRequestURL(t, r, "/rest/api/2/user/search?query=fred@example.com")
+		testRequestURL(t, r, "/rest/api/2/user/search?query=fred%40example.com")
 
 		fmt.Fprint(w, `[{"self":"http://www.example.com/jira/rest/api/2/user?accountId=000000000000000000000000","key":"fred",
         "name":"fred","emailAddress":"fred@example.com","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred",
@@ -253,7 +253,7 @@ func TestUserService_Find_Success(t *testing.T) {
         }]},"applicationRoles":{"size":1,"items":[]},"expand":"groups,applicationRoles"}]`)
 	})
 
-	if user, _, err := testClient.User.Find(context.Background(), "fred@example.com"); err != nil {
+	if user, _, err := testClient.User.Find(context.Background(), &UserSearchOptions{Query: "fred@example.com"}); err != nil {
 		t.Errorf("Error given: %s", err)
 	} else if user == nil {
 		t.Error("Expected user. User is nil")
@@ -265,7 +265,7 @@ func TestUserService_Find_SuccessParams(t *testing.T) {
 	defer teardown()
 	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodGet)
-		testRequestURL(t, r, "/rest/api/2/user/search?query=fred@example.com&startAt=100&maxResults=1000")
+		testRequestURL(t, r, "/rest/api/2/user/search?maxResults=1000&query=fred%40example.com&startAt=100")
 
 		fmt.Fprint(w, `[{"self":"http://www.example.com/jira/rest/api/2/user?query=fred","key":"fred",
         "name":"fred","emailAddress":"fred@example.com","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred",
@@ -276,9 +276,184 @@ func TestUserService_Find_SuccessParams(t *testing.T) {
         }]},"applicationRoles":{"size":1,"items":[]},"expand":"groups,applicationRoles"}]`)
 	})
 
-	if user, _, err := testClient.User.Find(context.Background(), "fred@example.com", WithStartAt(100), WithMaxResults(1000)); err != nil {
+	if user, _, err := testClient.User.Find(context.Background(), &UserSearchOptions{Query: "fred@example.com", StartAt: 100, MaxResults: 1000}); err != nil {
 		t.Errorf("Error given: %s", err)
 	} else if user == nil {
 		t.Error("Expected user. User is nil")
 	}
 }
+
+func TestUserService_FindPager(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `[{"accountId":"5b10a2844c20165700ede21g","displayName":"Mia Krystof"},{"accountId":"5b10ac8d82e05b22cc7d4ef5","displayName":"Emma Richards"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"accountId":"5b109f2e9729b51b54dc274d","displayName":"Fred F. User"}]`)
+		case "3":
+			fmt.Fprint(w, `[]`)
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+
+	users, err := testClient.User.FindPager(&UserSearchOptions{Property: "team.name=platform", MaxResults: 2}).All(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 3 || users[2].DisplayName != "Fred F. User" {
+		t.Errorf("Unexpected users %+v", users)
+	}
+}
+
+func TestUserService_FindAssignable(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/assignable/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/user/assignable/search?issueKey=EX-1&query=mia")
+		fmt.Fprint(w, `[{"accountId":"5b10a2844c20165700ede21g","displayName":"Mia Krystof","active":true}]`)
+	})
+
+	users, _, err := testClient.User.FindAssignable(context.Background(), &UserAssignableSearchOptions{Query: "mia", IssueKey: "EX-1"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Unexpected users %+v", users)
+	}
+}
+
+func TestUserService_FindViewable(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/viewissue/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/user/viewissue/search?accountId=5b10a2844c20165700ede21g&projectKey=EX")
+		fmt.Fprint(w, `[{"accountId":"5b10a2844c20165700ede21g","displayName":"Mia Krystof","active":true}]`)
+	})
+
+	users, _, err := testClient.User.FindViewable(context.Background(), &UserViewableSearchOptions{AccountID: "5b10a2844c20165700ede21g", ProjectKey: "EX"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 {
+		t.Errorf("Expected 1 user, got %d", len(users))
+	}
+}
+
+func TestUserService_GetBulk(t *testing.T) {
+	setup()
+	defer teardown()
+
+	accountIDs := make([]string, 150)
+	for i := range accountIDs {
+		accountIDs[i] = fmt.Sprintf("acc-%d", i)
+	}
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/user/bulk", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests++
+		ids := r.URL.Query()["accountId"]
+		switch requests {
+		case 1:
+			if len(ids) != 100 || ids[0] != "acc-0" || r.URL.Query().Get("maxResults") != "100" {
+				t.Errorf("Unexpected first request %s", r.URL)
+			}
+		case 2:
+			if len(ids) != 50 || ids[0] != "acc-100" {
+				t.Errorf("Unexpected second request %s", r.URL)
+			}
+		}
+		fmt.Fprintf(w, `{"startAt":0,"maxResults":%d,"total":1,"isLast":true,"values":[{"accountId":"%s","displayName":"User"}]}`, len(ids), ids[0])
+	})
+
+	users, err := testClient.User.GetBulk(context.Background(), accountIDs)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+	if len(users) != 2 || users[1].AccountID != "acc-100" {
+		t.Errorf("Unexpected users %+v", users)
+	}
+}
+
+func TestUserService_GetAccountIDs(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/bulk/migration", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.RawQuery {
+		case "maxResults=2&username=fred&username=mia":
+			fmt.Fprint(w, `[{"username":"fred","accountId":"5b109f2e9729b51b54dc274d"},{"username":"mia","accountId":"5b10a2844c20165700ede21g"}]`)
+		case "key=JIRAUSER10100&maxResults=1":
+			fmt.Fprint(w, `[{"key":"JIRAUSER10100","accountId":"5b10ac8d82e05b22cc7d4ef5"}]`)
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+
+	accountIDs, err := testClient.User.GetAccountIDs(context.Background(), []string{"fred", "mia"}, []string{"JIRAUSER10100"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(accountIDs) != 3 || accountIDs[2].Key != "JIRAUSER10100" || accountIDs[2].AccountID != "5b10ac8d82e05b22cc7d4ef5" {
+		t.Errorf("Unexpected account IDs %+v", accountIDs)
+	}
+}
+
+func TestUserService_FindAssignableMultiProjectPager(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/assignable/multiProjectSearch", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			testRequestURL(t, r, "/rest/api/2/user/assignable/multiProjectSearch?maxResults=1&projectKeys=EX%2CABC&query=mia")
+			fmt.Fprint(w, `[{"accountId":"5b10a2844c20165700ede21g","displayName":"Mia Krystof"}]`)
+		case "1":
+			fmt.Fprint(w, `[]`)
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+
+	pager := testClient.User.FindAssignableMultiProjectPager(&UserMultiProjectAssignableSearchOptions{
+		Query:       "mia",
+		ProjectKeys: []string{"EX", "ABC"},
+		MaxResults:  1,
+	})
+	users, err := pager.All(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].DisplayName != "Mia Krystof" {
+		t.Errorf("Unexpected users %+v", users)
+	}
+}
+
+func TestUserService_FindWithPermissions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/permission/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/user/permission/search?permissions=BROWSE_PROJECTS%2CEDIT_ISSUES&projectKey=EX")
+		fmt.Fprint(w, `[{"accountId":"5b10a2844c20165700ede21g","displayName":"Mia Krystof","active":true}]`)
+	})
+
+	users, _, err := testClient.User.FindWithPermissions(context.Background(), &UserPermissionSearchOptions{
+		Permissions: []PermissionKey{PermissionBrowseProjects, PermissionEditIssues},
+		ProjectKey:  "EX",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Unexpected users %+v", users)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // VersionService handles Versions for the Jira instance / API.
@@ -99,3 +100,171 @@ func (s *VersionService) Update(ctx context.Context, version *Version) (*Version
 	ret := *version
 	return &ret, resp, nil
 }
+
+// Positions of a version, relative to the other versions of the project
+const (
+	VersionPositionFirst   = "First"
+	VersionPositionLast    = "Last"
+	VersionPositionEarlier = "Earlier"
+	VersionPositionLater   = "Later"
+)
+
+// VersionMoveOptions specifies the new position of a version for VersionService.Move.
+// Either Position or After has to be set.
+type VersionMoveOptions struct {
+	// Position is one of the VersionPosition constants.
+	Position string `json:"position,omitempty"`
+	// After is the URL (self) of the version to place the version after.
+	After string `json:"after,omitempty"`
+}
+
+// VersionIssueCounts are the numbers of issues related to a version.
+type VersionIssueCounts struct {
+	Self                                     string                    `json:"self,omitempty" structs:"self,omitempty"`
+	IssuesFixedCount                         int                       `json:"issuesFixedCount" structs:"issuesFixedCount"`
+	IssuesAffectedCount                      int                       `json:"issuesAffectedCount" structs:"issuesAffectedCount"`
+	IssueCountWithCustomFieldsShowingVersion int                       `json:"issueCountWithCustomFieldsShowingVersion,omitempty" structs:"issueCountWithCustomFieldsShowingVersion,omitempty"`
+	CustomFieldUsage                         []VersionCustomFieldUsage `json:"customFieldUsage,omitempty" structs:"customFieldUsage,omitempty"`
+}
+
+// VersionCustomFieldUsage is the number of issues with a version in a version picker custom field.
+type VersionCustomFieldUsage struct {
+	FieldName                          string `json:"fieldName" structs:"fieldName"`
+	CustomFieldID                      int64  `json:"customFieldId" structs:"customFieldId"`
+	IssueCountWithVersionInCustomField int    `json:"issueCountWithVersionInCustomField" structs:"issueCountWithVersionInCustomField"`
+}
+
+// VersionUnresolvedIssueCount is the number of unresolved issues of a version.
+type VersionUnresolvedIssueCount struct {
+	Self                  string `json:"self,omitempty" structs:"self,omitempty"`
+	IssuesUnresolvedCount int    `json:"issuesUnresolvedCount" structs:"issuesUnresolvedCount"`
+	IssuesCount           int    `json:"issuesCount,omitempty" structs:"issuesCount,omitempty"`
+}
+
+// VersionDeleteOptions specifies the versions the issues of a deleted version are moved to, for VersionService.DeleteAndSwap.
+// By default, the version is only removed from the issues.
+type VersionDeleteOptions struct {
+	// MoveFixIssuesTo is the ID of the version replacing the deleted version in the fixVersion field.
+	MoveFixIssuesTo string `json:"moveFixIssuesTo,omitempty"`
+	// MoveAffectedIssuesTo is the ID of the version replacing the deleted version in the affectedVersion field.
+	MoveAffectedIssuesTo string `json:"moveAffectedIssuesTo,omitempty"`
+	// CustomFieldReplacements replace the deleted version in version picker custom fields.
+	CustomFieldReplacements []VersionCustomFieldReplacement `json:"customFieldReplacementList,omitempty"`
+}
+
+// VersionCustomFieldReplacement replaces a deleted version in a version picker custom field.
+type VersionCustomFieldReplacement struct {
+	CustomFieldID int64 `json:"customFieldId"`
+	// MoveTo is the ID of the version replacing the deleted version.
+	MoveTo int64 `json:"moveTo"`
+}
+
+// Merge merges the version versionID into the version moveIssuesTo and deletes versionID.
+// The issues of versionID are moved to moveIssuesTo.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-versions/#api-rest-api-2-version-id-mergeto-moveissuesto-put
+func (s *VersionService) Merge(ctx context.Context, versionID, moveIssuesTo string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s/mergeto/%s", url.PathEscape(versionID), url.PathEscape(moveIssuesTo))
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Move changes the position of the version versionID in the list of versions of its project.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-versions/#api-rest-api-2-version-id-move-post
+func (s *VersionService) Move(ctx context.Context, versionID string, options *VersionMoveOptions) (*Version, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s/move", url.PathEscape(versionID))
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(Version)
+	resp, err := s.client.Do(req, version)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return version, resp, nil
+}
+
+// GetRelatedIssueCounts returns the numbers of issues fixed in and affected by the version versionID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-versions/#api-rest-api-2-version-id-relatedissuecounts-get
+func (s *VersionService) GetRelatedIssueCounts(ctx context.Context, versionID string) (*VersionIssueCounts, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s/relatedIssueCounts", url.PathEscape(versionID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	counts := new(VersionIssueCounts)
+	resp, err := s.client.Do(req, counts)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return counts, resp, nil
+}
+
+// GetUnresolvedIssueCount returns the number of unresolved issues of the version versionID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-versions/#api-rest-api-2-version-id-unresolvedissuecount-get
+func (s *VersionService) GetUnresolvedIssueCount(ctx context.Context, versionID string) (*VersionUnresolvedIssueCount, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s/unresolvedIssueCount", url.PathEscape(versionID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	count := new(VersionUnresolvedIssueCount)
+	resp, err := s.client.Do(req, count)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return count, resp, nil
+}
+
+// Delete deletes the version versionID and removes it from all issues.
+// Use DeleteAndSwap to move the issues to another version instead.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-versions/#api-rest-api-2-version-id-delete
+func (s *VersionService) Delete(ctx context.Context, versionID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s", url.PathEscape(versionID))
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteAndSwap deletes the version versionID and replaces it in the issues with the versions of options.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-versions/#api-rest-api-2-version-id-removeandswap-post
+func (s *VersionService) DeleteAndSwap(ctx context.Context, versionID string, options *VersionDeleteOptions) (*Response, error) {
+	if options == nil {
+		options = &VersionDeleteOptions{}
+	}
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s/removeAndSwap", url.PathEscape(versionID))
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
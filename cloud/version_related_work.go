@@ -0,0 +1,90 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// VersionRelatedWork is a link of a version to work related to the release, like a build or a deployment.
+type VersionRelatedWork struct {
+	// RelatedWorkID is set by Jira. It is required to update related work.
+	RelatedWorkID string `json:"relatedWorkId,omitempty" structs:"relatedWorkId,omitempty"`
+	// Category is the type of the work, like "Communication", "Design" or "Deployment".
+	Category string `json:"category" structs:"category"`
+	Title    string `json:"title,omitempty" structs:"title,omitempty"`
+	URL      string `json:"url,omitempty" structs:"url,omitempty"`
+	// IssueID is the ID of the issue the work is related to. Issues can't be added via the API.
+	IssueID int64 `json:"issueId,omitempty" structs:"issueId,omitempty"`
+}
+
+// GetRelatedWork returns the related work of the version versionID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-relatedwork-get
+func (s *VersionService) GetRelatedWork(ctx context.Context, versionID string) ([]VersionRelatedWork, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, relatedWorkEndpoint(versionID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	work := []VersionRelatedWork{}
+	resp, err := s.client.Do(req, &work)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return work, resp, nil
+}
+
+// CreateRelatedWork adds work to the version versionID and returns it.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-relatedwork-post
+func (s *VersionService) CreateRelatedWork(ctx context.Context, versionID string, work *VersionRelatedWork) (*VersionRelatedWork, *Response, error) {
+	return s.sendRelatedWork(ctx, http.MethodPost, versionID, work)
+}
+
+// UpdateRelatedWork updates the related work work.RelatedWorkID of the version versionID and returns it.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-relatedwork-put
+func (s *VersionService) UpdateRelatedWork(ctx context.Context, versionID string, work *VersionRelatedWork) (*VersionRelatedWork, *Response, error) {
+	if work == nil || work.RelatedWorkID == "" {
+		return nil, nil, fmt.Errorf("jira: related work ID is required")
+	}
+	return s.sendRelatedWork(ctx, http.MethodPut, versionID, work)
+}
+
+func (s *VersionService) sendRelatedWork(ctx context.Context, method, versionID string, work *VersionRelatedWork) (*VersionRelatedWork, *Response, error) {
+	req, err := s.client.NewRequest(ctx, method, relatedWorkEndpoint(versionID), work)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(VersionRelatedWork)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// DeleteRelatedWork removes the related work relatedWorkID from the version versionID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-versionid-relatedwork-relatedworkid-delete
+func (s *VersionService) DeleteRelatedWork(ctx context.Context, versionID, relatedWorkID string) (*Response, error) {
+	apiEndpoint := relatedWorkEndpoint(versionID) + "/" + url.PathEscape(relatedWorkID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// relatedWorkEndpoint returns the endpoint of the related work of a version.
+func relatedWorkEndpoint(versionID string) string {
+	return fmt.Sprintf("rest/api/3/version/%s/relatedwork", url.PathEscape(versionID))
+}
@@ -0,0 +1,70 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestVersionService_RelatedWork(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/version/10000/relatedwork"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, testapiEndpoint)
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"relatedWorkId":"fabcdef6-7878-1234-beaf-43211234abcd","category":"Design","title":"Design link","url":"https://www.atlassian.com"},{"category":"Bug fix","issueId":10001}]`)
+		case http.MethodPost, http.MethodPut:
+			work := new(VersionRelatedWork)
+			if err := json.NewDecoder(r.Body).Decode(work); err != nil {
+				t.Fatal(err)
+			}
+			if work.Category != "Deployment" || work.URL != "https://ci.example.com/builds/42" {
+				t.Errorf("Unexpected related work %+v", work)
+			}
+			if r.Method == http.MethodPost {
+				work.RelatedWorkID = "fabcdef6-7878-1234-beaf-43211234abce"
+				w.WriteHeader(http.StatusCreated)
+			}
+			json.NewEncoder(w).Encode(work)
+		default:
+			t.Errorf("Unexpected request method %s", r.Method)
+		}
+	})
+	testMux.HandleFunc(testapiEndpoint+"/fabcdef6-7878-1234-beaf-43211234abce", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	work, _, err := testClient.Version.GetRelatedWork(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(work) != 2 || work[0].Title != "Design link" || work[1].IssueID != 10001 {
+		t.Errorf("Unexpected related work %+v", work)
+	}
+
+	created, _, err := testClient.Version.CreateRelatedWork(context.Background(), "10000", &VersionRelatedWork{Category: "Deployment", Title: "Build #42", URL: "https://ci.example.com/builds/42"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if created.RelatedWorkID == "" {
+		t.Error("Expected the ID of the created related work")
+	}
+
+	created.Title = "Build #42 (production)"
+	if _, _, err := testClient.Version.UpdateRelatedWork(context.Background(), "10000", created); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, _, err := testClient.Version.UpdateRelatedWork(context.Background(), "10000", &VersionRelatedWork{Category: "Deployment"}); err == nil {
+		t.Error("Expected an error for related work without ID")
+	}
+
+	if _, err := testClient.Version.DeleteRelatedWork(context.Background(), "10000", created.RelatedWorkID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
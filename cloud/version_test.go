@@ -3,6 +3,7 @@ package cloud
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 )
@@ -111,3 +112,123 @@ func TestServiceService_Update(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestVersionService_Merge(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/version/10000/mergeto/10001"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Version.Merge(context.Background(), "10000", "10001"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestVersionService_Move(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/version/10000/move"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testapiEndpoint)
+		if b, _ := io.ReadAll(r.Body); string(b) != `{"position":"Earlier"}`+"\n" {
+			t.Errorf("Unexpected request body %s", b)
+		}
+		fmt.Fprint(w, `{"id":"10000","name":"1.0","projectId":10000}`)
+	})
+
+	version, _, err := testClient.Version.Move(context.Background(), "10000", &VersionMoveOptions{Position: VersionPositionEarlier})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if version.Name != "1.0" {
+		t.Errorf("Expected version 1.0. Got %+v", version)
+	}
+}
+
+func TestVersionService_GetRelatedIssueCounts(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/version/10000/relatedIssueCounts"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/version/10000","issuesFixedCount":23,"issuesAffectedCount":101,"issueCountWithCustomFieldsShowingVersion":54,"customFieldUsage":[{"fieldName":"Field1","customFieldId":10000,"issueCountWithVersionInCustomField":2}]}`)
+	})
+
+	counts, _, err := testClient.Version.GetRelatedIssueCounts(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if counts.IssuesFixedCount != 23 || counts.IssuesAffectedCount != 101 || len(counts.CustomFieldUsage) != 1 {
+		t.Errorf("Unexpected issue counts %+v", counts)
+	}
+}
+
+func TestVersionService_GetUnresolvedIssueCount(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/version/10000/unresolvedIssueCount"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/version/10000","issuesUnresolvedCount":23,"issuesCount":30}`)
+	})
+
+	count, _, err := testClient.Version.GetUnresolvedIssueCount(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if count.IssuesUnresolvedCount != 23 {
+		t.Errorf("Expected 23 unresolved issues. Got %+v", count)
+	}
+}
+
+func TestVersionService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/version/10000"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testapiEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Version.Delete(context.Background(), "10000"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestVersionService_DeleteAndSwap(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/version/10000/removeAndSwap"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testapiEndpoint)
+		want := `{"moveFixIssuesTo":"10001","moveAffectedIssuesTo":"10002","customFieldReplacementList":[{"customFieldId":10050,"moveTo":10001}]}` + "\n"
+		if b, _ := io.ReadAll(r.Body); string(b) != want {
+			t.Errorf("Unexpected request body\ngot:  %s\nwant: %s", b, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Version.DeleteAndSwap(context.Background(), "10000", &VersionDeleteOptions{
+		MoveFixIssuesTo:         "10001",
+		MoveAffectedIssuesTo:    "10002",
+		CustomFieldReplacements: []VersionCustomFieldReplacement{{CustomFieldID: 10050, MoveTo: 10001}},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,284 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// WorkflowService handles workflows for the Jira instance / API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-workflows/
+type WorkflowService service
+
+// Scope types of workflows and statuses, for WorkflowScope.Type
+const (
+	WorkflowScopeTypeGlobal  = "GLOBAL"
+	WorkflowScopeTypeProject = "PROJECT"
+)
+
+// WorkflowScope is the scope of a workflow or status. Project scoped ones belong to a team-managed project.
+type WorkflowScope struct {
+	// Type is one of the WorkflowScopeType constants.
+	Type    string                `json:"type" structs:"type"`
+	Project *WorkflowScopeProject `json:"project,omitempty" structs:"project,omitempty"`
+}
+
+// WorkflowScopeProject is the project of a project scoped workflow or status.
+type WorkflowScopeProject struct {
+	ID string `json:"id" structs:"id"`
+}
+
+// WorkflowID identifies a workflow in the search results.
+type WorkflowID struct {
+	Name     string `json:"name" structs:"name"`
+	EntityID string `json:"entityId,omitempty" structs:"entityId,omitempty"`
+}
+
+// Workflow represents a workflow as returned by WorkflowService.Search.
+type Workflow struct {
+	ID               WorkflowID           `json:"id" structs:"id"`
+	Description      string               `json:"description,omitempty" structs:"description,omitempty"`
+	Transitions      []WorkflowTransition `json:"transitions,omitempty" structs:"transitions,omitempty"`
+	Statuses         []WorkflowStatus     `json:"statuses,omitempty" structs:"statuses,omitempty"`
+	IsDefault        bool                 `json:"isDefault,omitempty" structs:"isDefault,omitempty"`
+	HasDraftWorkflow bool                 `json:"hasDraftWorkflow,omitempty" structs:"hasDraftWorkflow,omitempty"`
+	Created          string               `json:"created,omitempty" structs:"created,omitempty"`
+	Updated          string               `json:"updated,omitempty" structs:"updated,omitempty"`
+}
+
+// WorkflowTransition is a transition of a Workflow.
+type WorkflowTransition struct {
+	ID          string `json:"id" structs:"id"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// From are the IDs of the statuses the transition starts from. Empty for global transitions.
+	From []string `json:"from,omitempty" structs:"from,omitempty"`
+	// To is the ID of the status the transition ends in.
+	To string `json:"to" structs:"to"`
+	// Type is "global", "initial" or "directed".
+	Type       string                 `json:"type" structs:"type"`
+	Properties map[string]interface{} `json:"properties,omitempty" structs:"properties,omitempty"`
+}
+
+// WorkflowStatus is a status of a Workflow.
+type WorkflowStatus struct {
+	ID         string                 `json:"id" structs:"id"`
+	Name       string                 `json:"name" structs:"name"`
+	Properties map[string]interface{} `json:"properties,omitempty" structs:"properties,omitempty"`
+}
+
+// WorkflowSearchOptions specifies the optional parameters for WorkflowService.Search.
+type WorkflowSearchOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// WorkflowName restricts the results to the workflows with these names.
+	WorkflowName []string `url:"workflowName,omitempty"`
+	// Expand additional attributes, comma separated, like "transitions", "transitions.rules",
+	// "transitions.properties", "statuses", "statuses.properties", "default", "schemes", "projects",
+	// "hasDraftWorkflow" or "operations".
+	Expand string `url:"expand,omitempty"`
+	// QueryString restricts the results to workflows with a name containing this string.
+	QueryString string `url:"queryString,omitempty"`
+	// OrderBy sorts the workflows, like "name", "-created" or "updated".
+	OrderBy  string `url:"orderBy,omitempty"`
+	IsActive *bool  `url:"isActive,omitempty"`
+}
+
+// Search returns one page of the workflows matching the options.
+// The following pages can be fetched via PagedList.Next.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-workflows/#api-rest-api-3-workflow-search-get
+func (s *WorkflowService) Search(ctx context.Context, options *WorkflowSearchOptions) (*PagedList[Workflow], *Response, error) {
+	u, err := addOptions("rest/api/3/workflow/search", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getPage[Workflow](ctx, s.client, u, agilePaging)
+}
+
+// WorkflowDocumentVersion is the version of a workflow.
+// Updates have to reference the current version, to detect concurrent modifications.
+type WorkflowDocumentVersion struct {
+	ID            string `json:"id" structs:"id"`
+	VersionNumber int    `json:"versionNumber" structs:"versionNumber"`
+}
+
+// WorkflowLayout is the position of a status in the workflow editor.
+type WorkflowLayout struct {
+	X float64 `json:"x" structs:"x"`
+	Y float64 `json:"y" structs:"y"`
+}
+
+// WorkflowStatusDefinition is a status used by the workflows of WorkflowService.Create and WorkflowService.Update.
+// New statuses are created, existing ones are referenced by ID.
+type WorkflowStatusDefinition struct {
+	ID string `json:"id,omitempty" structs:"id,omitempty"`
+	// StatusReference identifies the status within the request and is used by WorkflowStatusReference and transitions.
+	StatusReference string `json:"statusReference" structs:"statusReference"`
+	Name            string `json:"name" structs:"name"`
+	// StatusCategory is "TODO", "IN_PROGRESS" or "DONE".
+	StatusCategory string         `json:"statusCategory" structs:"statusCategory"`
+	Description    string         `json:"description,omitempty" structs:"description,omitempty"`
+	Scope          *WorkflowScope `json:"scope,omitempty" structs:"scope,omitempty"`
+}
+
+// WorkflowStatusReference places a status of the request in a workflow.
+type WorkflowStatusReference struct {
+	StatusReference string            `json:"statusReference" structs:"statusReference"`
+	Layout          *WorkflowLayout   `json:"layout,omitempty" structs:"layout,omitempty"`
+	Properties      map[string]string `json:"properties,omitempty" structs:"properties,omitempty"`
+	Deprecated      bool              `json:"deprecated,omitempty" structs:"deprecated,omitempty"`
+}
+
+// WorkflowTransitionLink connects a transition with the status it starts from.
+type WorkflowTransitionLink struct {
+	FromStatusReference string `json:"fromStatusReference,omitempty" structs:"fromStatusReference,omitempty"`
+	FromPort            int    `json:"fromPort,omitempty" structs:"fromPort,omitempty"`
+	ToPort              int    `json:"toPort,omitempty" structs:"toPort,omitempty"`
+}
+
+// WorkflowTransitionDefinition is a transition of a WorkflowDefinition.
+// The rules are passed as is, see the capabilities of WorkflowService.GetCapabilities for the available ones.
+type WorkflowTransitionDefinition struct {
+	ID          string `json:"id" structs:"id"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// Type is "INITIAL", "GLOBAL" or "DIRECTED".
+	Type              string                   `json:"type" structs:"type"`
+	ToStatusReference string                   `json:"toStatusReference" structs:"toStatusReference"`
+	Links             []WorkflowTransitionLink `json:"links,omitempty" structs:"links,omitempty"`
+	Properties        map[string]string        `json:"properties,omitempty" structs:"properties,omitempty"`
+	Actions           json.RawMessage          `json:"actions,omitempty" structs:"actions,omitempty"`
+	Conditions        json.RawMessage          `json:"conditions,omitempty" structs:"conditions,omitempty"`
+	Validators        json.RawMessage          `json:"validators,omitempty" structs:"validators,omitempty"`
+	Triggers          json.RawMessage          `json:"triggers,omitempty" structs:"triggers,omitempty"`
+}
+
+// WorkflowDefinition is a complete workflow, as used by WorkflowService.Create and WorkflowService.Update.
+type WorkflowDefinition struct {
+	// ID and Version are required for updates.
+	ID               string                         `json:"id,omitempty" structs:"id,omitempty"`
+	Version          *WorkflowDocumentVersion       `json:"version,omitempty" structs:"version,omitempty"`
+	Name             string                         `json:"name,omitempty" structs:"name,omitempty"`
+	Description      string                         `json:"description,omitempty" structs:"description,omitempty"`
+	Scope            *WorkflowScope                 `json:"scope,omitempty" structs:"scope,omitempty"`
+	StartPointLayout *WorkflowLayout                `json:"startPointLayout,omitempty" structs:"startPointLayout,omitempty"`
+	Statuses         []WorkflowStatusReference      `json:"statuses" structs:"statuses"`
+	Transitions      []WorkflowTransitionDefinition `json:"transitions" structs:"transitions"`
+}
+
+// WorkflowCreatePayload are the workflows and statuses created by WorkflowService.Create.
+type WorkflowCreatePayload struct {
+	Scope     WorkflowScope              `json:"scope" structs:"scope"`
+	Statuses  []WorkflowStatusDefinition `json:"statuses" structs:"statuses"`
+	Workflows []WorkflowDefinition       `json:"workflows" structs:"workflows"`
+}
+
+// WorkflowUpdatePayload are the workflows and statuses updated by WorkflowService.Update.
+type WorkflowUpdatePayload struct {
+	Statuses  []WorkflowStatusDefinition `json:"statuses" structs:"statuses"`
+	Workflows []WorkflowDefinition       `json:"workflows" structs:"workflows"`
+}
+
+// WorkflowsResult are the workflows and the statuses they use,
+// as returned by WorkflowService.Get, WorkflowService.Create and WorkflowService.Update.
+type WorkflowsResult struct {
+	Statuses  []WorkflowStatusDefinition `json:"statuses" structs:"statuses"`
+	Workflows []WorkflowDefinition       `json:"workflows" structs:"workflows"`
+}
+
+// Get returns the complete definition, including the current version, of the workflows with the IDs or names.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-workflows/#api-rest-api-3-workflows-post
+func (s *WorkflowService) Get(ctx context.Context, workflowIDs, workflowNames []string) (*WorkflowsResult, *Response, error) {
+	payload := struct {
+		WorkflowIDs   []string `json:"workflowIds,omitempty"`
+		WorkflowNames []string `json:"workflowNames,omitempty"`
+	}{workflowIDs, workflowNames}
+	return s.post(ctx, "rest/api/3/workflows", payload)
+}
+
+// Create creates the workflows together with the statuses they use.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-workflows/#api-rest-api-3-workflows-create-post
+func (s *WorkflowService) Create(ctx context.Context, payload *WorkflowCreatePayload) (*WorkflowsResult, *Response, error) {
+	return s.post(ctx, "rest/api/3/workflows/create", payload)
+}
+
+// Update updates the workflows and statuses.
+// Every workflow needs its ID and current version, as returned by WorkflowService.Get.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-workflows/#api-rest-api-3-workflows-update-post
+func (s *WorkflowService) Update(ctx context.Context, payload *WorkflowUpdatePayload) (*WorkflowsResult, *Response, error) {
+	return s.post(ctx, "rest/api/3/workflows/update", payload)
+}
+
+func (s *WorkflowService) post(ctx context.Context, apiEndpoint string, payload interface{}) (*WorkflowsResult, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(WorkflowsResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// WorkflowCapabilitiesOptions specifies the optional parameters for WorkflowService.GetCapabilities.
+// Without any of them, the capabilities of global workflows are returned.
+type WorkflowCapabilitiesOptions struct {
+	WorkflowID  string `url:"workflowId,omitempty"`
+	ProjectID   string `url:"projectId,omitempty"`
+	IssueTypeID string `url:"issueTypeId,omitempty"`
+}
+
+// WorkflowRuleCapability is a rule that can be added to the transitions of a workflow.
+type WorkflowRuleCapability struct {
+	RuleKey     string `json:"ruleKey" structs:"ruleKey"`
+	RuleType    string `json:"ruleType" structs:"ruleType"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// IncompatibleRuleKeys are the rules that can't be used on the same transition.
+	IncompatibleRuleKeys            []string `json:"incompatibleRuleKeys,omitempty" structs:"incompatibleRuleKeys,omitempty"`
+	IsAvailableForInitialTransition bool     `json:"isAvailableForInitialTransition" structs:"isAvailableForInitialTransition"`
+	IsVisible                       bool     `json:"isVisible" structs:"isVisible"`
+}
+
+// WorkflowCapabilities describe what can be used in a workflow of a scope.
+// The rules of apps are passed as is.
+type WorkflowCapabilities struct {
+	// EditorScope is "GLOBAL" or "PROJECT".
+	EditorScope  string                   `json:"editorScope" structs:"editorScope"`
+	ProjectTypes []string                 `json:"projectTypes,omitempty" structs:"projectTypes,omitempty"`
+	SystemRules  []WorkflowRuleCapability `json:"systemRules,omitempty" structs:"systemRules,omitempty"`
+	ConnectRules json.RawMessage          `json:"connectRules,omitempty" structs:"connectRules,omitempty"`
+	ForgeRules   json.RawMessage          `json:"forgeRules,omitempty" structs:"forgeRules,omitempty"`
+	TriggerRules json.RawMessage          `json:"triggerRules,omitempty" structs:"triggerRules,omitempty"`
+}
+
+// GetCapabilities returns the rules available to a workflow, the workflows of a project or issue type,
+// or to global workflows.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-workflows/#api-rest-api-3-workflows-capabilities-get
+func (s *WorkflowService) GetCapabilities(ctx context.Context, options *WorkflowCapabilitiesOptions) (*WorkflowCapabilities, *Response, error) {
+	u, err := addOptions("rest/api/3/workflows/capabilities", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	capabilities := new(WorkflowCapabilities)
+	resp, err := s.client.Do(req, capabilities)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return capabilities, resp, nil
+}
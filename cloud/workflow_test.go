@@ -0,0 +1,166 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestWorkflowService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/workflow/search"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?expand=transitions%2Cstatuses&isActive=true&workflowName=SCRUM+Workflow")
+		fmt.Fprint(w, `{"isLast":true,"maxResults":50,"startAt":0,"total":1,"values":[{"id":{"name":"SCRUM Workflow","entityId":"5ed312c5-f7a6-4a78-a1f6-8ff7f307d063"},"description":"A workflow used for Software projects in the SCRUM methodology","transitions":[{"id":"5","name":"In Progress","description":"Start working on the issue.","from":["10","13"],"to":"14","type":"directed"}],"statuses":[{"id":"3","name":"In Progress","properties":{"issueEditable":false}}],"isDefault":false}]}`)
+	})
+
+	active := true
+	workflows, _, err := testClient.Workflow.Search(context.Background(), &WorkflowSearchOptions{
+		WorkflowName: []string{"SCRUM Workflow"},
+		Expand:       "transitions,statuses",
+		IsActive:     &active,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(workflows.Values) != 1 {
+		t.Fatalf("Expected 1 workflow, got %d", len(workflows.Values))
+	}
+	workflow := workflows.Values[0]
+	if workflow.ID.EntityID != "5ed312c5-f7a6-4a78-a1f6-8ff7f307d063" {
+		t.Errorf("Unexpected workflow ID %+v", workflow.ID)
+	}
+	if len(workflow.Transitions) != 1 || workflow.Transitions[0].To != "14" || len(workflow.Transitions[0].From) != 2 {
+		t.Errorf("Unexpected transitions %+v", workflow.Transitions)
+	}
+	if len(workflow.Statuses) != 1 || workflow.Statuses[0].Properties["issueEditable"] != false {
+		t.Errorf("Unexpected statuses %+v", workflow.Statuses)
+	}
+}
+
+func TestWorkflowService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/workflows"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testapiEndpoint)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"workflowNames":["Software workflow"]}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		fmt.Fprint(w, `{"statuses":[{"id":"10001","statusReference":"f0b24de5-25e7-4fab-ab94-63d81db6c0c0","name":"To Do","statusCategory":"TODO"}],"workflows":[{"id":"b9ff2384-d3b6-4d4e-9509-3ee19f607168","name":"Software workflow","version":{"id":"f010ac1b-3dd3-43a3-aa66-0ee8a447f76e","versionNumber":2},"statuses":[{"statusReference":"f0b24de5-25e7-4fab-ab94-63d81db6c0c0","layout":{"x":114.99993896484375,"y":-16}}],"transitions":[{"id":"1","name":"Create","type":"INITIAL","toStatusReference":"f0b24de5-25e7-4fab-ab94-63d81db6c0c0","conditions":{"operation":"ALL","conditions":[]}}]}]}`)
+	})
+
+	result, _, err := testClient.Workflow.Get(context.Background(), nil, []string{"Software workflow"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(result.Workflows) != 1 || result.Workflows[0].Version.VersionNumber != 2 {
+		t.Fatalf("Unexpected workflows %+v", result.Workflows)
+	}
+	if got := string(result.Workflows[0].Transitions[0].Conditions); got != `{"operation":"ALL","conditions":[]}` {
+		t.Errorf("Unexpected conditions %s", got)
+	}
+	if len(result.Statuses) != 1 || result.Statuses[0].StatusCategory != "TODO" {
+		t.Errorf("Unexpected statuses %+v", result.Statuses)
+	}
+}
+
+func TestWorkflowService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/workflows/create"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testapiEndpoint)
+		body, _ := io.ReadAll(r.Body)
+		want := `{"scope":{"type":"PROJECT","project":{"id":"10000"}},` +
+			`"statuses":[{"statusReference":"todo","name":"To Do","statusCategory":"TODO"}],` +
+			`"workflows":[{"name":"Team workflow","statuses":[{"statusReference":"todo"}],"transitions":[{"id":"1","name":"Create","type":"INITIAL","toStatusReference":"todo"}]}]}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body\ngot:  %s\nwant: %s", got, want)
+		}
+		fmt.Fprint(w, `{"statuses":[{"id":"10005","statusReference":"todo","name":"To Do","statusCategory":"TODO"}],"workflows":[{"id":"a1b2","name":"Team workflow","version":{"id":"c3d4","versionNumber":0},"statuses":[{"statusReference":"todo"}],"transitions":[]}]}`)
+	})
+
+	result, _, err := testClient.Workflow.Create(context.Background(), &WorkflowCreatePayload{
+		Scope:    WorkflowScope{Type: WorkflowScopeTypeProject, Project: &WorkflowScopeProject{ID: "10000"}},
+		Statuses: []WorkflowStatusDefinition{{StatusReference: "todo", Name: "To Do", StatusCategory: "TODO"}},
+		Workflows: []WorkflowDefinition{{
+			Name:        "Team workflow",
+			Statuses:    []WorkflowStatusReference{{StatusReference: "todo"}},
+			Transitions: []WorkflowTransitionDefinition{{ID: "1", Name: "Create", Type: "INITIAL", ToStatusReference: "todo"}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(result.Workflows) != 1 || result.Workflows[0].ID != "a1b2" || result.Statuses[0].ID != "10005" {
+		t.Errorf("Unexpected result %+v", result)
+	}
+}
+
+func TestWorkflowService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/workflows/update"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testapiEndpoint)
+		body, _ := io.ReadAll(r.Body)
+		want := `{"statuses":[],"workflows":[{"id":"a1b2","version":{"id":"c3d4","versionNumber":0},"description":"Updated","statuses":[],"transitions":[]}]}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body\ngot:  %s\nwant: %s", got, want)
+		}
+		fmt.Fprint(w, `{"statuses":[],"workflows":[{"id":"a1b2","description":"Updated","version":{"id":"e5f6","versionNumber":1},"statuses":[],"transitions":[]}]}`)
+	})
+
+	result, _, err := testClient.Workflow.Update(context.Background(), &WorkflowUpdatePayload{
+		Statuses: []WorkflowStatusDefinition{},
+		Workflows: []WorkflowDefinition{{
+			ID:          "a1b2",
+			Version:     &WorkflowDocumentVersion{ID: "c3d4"},
+			Description: "Updated",
+			Statuses:    []WorkflowStatusReference{},
+			Transitions: []WorkflowTransitionDefinition{},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if result.Workflows[0].Version.VersionNumber != 1 {
+		t.Errorf("Unexpected version %+v", result.Workflows[0].Version)
+	}
+}
+
+func TestWorkflowService_GetCapabilities(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/workflows/capabilities"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?projectId=10000")
+		fmt.Fprint(w, `{"editorScope":"PROJECT","projectTypes":["software"],"systemRules":[{"ruleKey":"system:restrict-issue-transition","ruleType":"Condition","name":"Restrict who can move an issue","incompatibleRuleKeys":[],"isAvailableForInitialTransition":true,"isVisible":true}],"connectRules":[],"forgeRules":[],"triggerRules":[]}`)
+	})
+
+	capabilities, _, err := testClient.Workflow.GetCapabilities(context.Background(), &WorkflowCapabilitiesOptions{ProjectID: "10000"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if capabilities.EditorScope != WorkflowScopeTypeProject {
+		t.Errorf("Expected editor scope %s, got %s", WorkflowScopeTypeProject, capabilities.EditorScope)
+	}
+	if len(capabilities.SystemRules) != 1 || capabilities.SystemRules[0].RuleType != "Condition" {
+		t.Errorf("Unexpected system rules %+v", capabilities.SystemRules)
+	}
+}
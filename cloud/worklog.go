@@ -0,0 +1,82 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// WorklogChange is a worklog that was updated or deleted.
+type WorklogChange struct {
+	WorklogID int64 `json:"worklogId" structs:"worklogId"`
+	// UpdatedTime is the time of the change, in milliseconds since the Unix epoch.
+	UpdatedTime int64            `json:"updatedTime" structs:"updatedTime"`
+	Properties  []EntityProperty `json:"properties,omitempty" structs:"properties,omitempty"`
+}
+
+// WorklogChanges is a page of worklogs changed since a point in time.
+// The following page is requested with Until as since, until LastPage is true.
+type WorklogChanges struct {
+	Values []WorklogChange `json:"values" structs:"values"`
+	// Since and Until are the bounds of the page, in milliseconds since the Unix epoch.
+	Since    int64  `json:"since" structs:"since"`
+	Until    int64  `json:"until" structs:"until"`
+	Self     string `json:"self,omitempty" structs:"self,omitempty"`
+	NextPage string `json:"nextPage,omitempty" structs:"nextPage,omitempty"`
+	LastPage bool   `json:"lastPage" structs:"lastPage"`
+}
+
+// GetUpdatedWorklogs returns a page of the IDs of the worklogs created or updated since
+// since (in milliseconds since the Unix epoch), up to 1000 per page.
+// The worklogs themselves can be requested via GetWorklogsByIDs.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-worklog-updated-get
+func (s *IssueService) GetUpdatedWorklogs(ctx context.Context, since int64) (*WorklogChanges, *Response, error) {
+	return s.getWorklogChanges(ctx, "rest/api/2/worklog/updated", since)
+}
+
+// GetDeletedWorklogs returns a page of the IDs of the worklogs deleted since
+// since (in milliseconds since the Unix epoch), up to 1000 per page.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-worklog-deleted-get
+func (s *IssueService) GetDeletedWorklogs(ctx context.Context, since int64) (*WorklogChanges, *Response, error) {
+	return s.getWorklogChanges(ctx, "rest/api/2/worklog/deleted", since)
+}
+
+func (s *IssueService) getWorklogChanges(ctx context.Context, apiEndpoint string, since int64) (*WorklogChanges, *Response, error) {
+	if since > 0 {
+		apiEndpoint = fmt.Sprintf("%s?since=%d", apiEndpoint, since)
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changes := new(WorklogChanges)
+	resp, err := s.client.Do(req, changes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return changes, resp, nil
+}
+
+// GetWorklogsByIDs returns the worklogs with the IDs ids, up to 1000 per request.
+// Worklogs the user has no permission to view are left out.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-worklog-list-post
+func (s *IssueService) GetWorklogsByIDs(ctx context.Context, ids []int64) ([]WorklogRecord, *Response, error) {
+	body := struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: ids}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "rest/api/2/worklog/list", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var worklogs []WorklogRecord
+	resp, err := s.client.Do(req, &worklogs)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return worklogs, resp, nil
+}
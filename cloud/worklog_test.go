@@ -0,0 +1,85 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIssueService_GetUpdatedWorklogs(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/worklog/updated"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint+"?since=1438013671562")
+		fmt.Fprint(w, `{"values":[{"worklogId":103,"updatedTime":1438013671562,"properties":[]},{"worklogId":104,"updatedTime":1438013672165,"properties":[]}],
+			"since":1438013671562,"until":1438013693136,"self":"https://your-domain.atlassian.net/api/~ver~/worklog/updated?since=1438013671562",
+			"nextPage":"https://your-domain.atlassian.net/api/~ver~/worklog/updated?since=1438013693136","lastPage":false}`)
+	})
+
+	changes, _, err := testClient.Issue.GetUpdatedWorklogs(context.Background(), 1438013671562)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(changes.Values) != 2 || changes.Values[1].WorklogID != 104 || changes.Values[1].UpdatedTime != 1438013672165 {
+		t.Errorf("Unexpected worklog changes %+v", changes.Values)
+	}
+	if changes.Until != 1438013693136 || changes.LastPage {
+		t.Errorf("Unexpected page bounds %+v", changes)
+	}
+}
+
+func TestIssueService_GetDeletedWorklogs(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/worklog/deleted"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.RawQuery != "" {
+			t.Errorf("Expected no query for since 0, got %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"values":[{"worklogId":105,"updatedTime":1438013671562}],"since":0,"until":1438013671562,"lastPage":true}`)
+	})
+
+	changes, _, err := testClient.Issue.GetDeletedWorklogs(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(changes.Values) != 1 || changes.Values[0].WorklogID != 105 || !changes.LastPage {
+		t.Errorf("Unexpected worklog changes %+v", changes)
+	}
+}
+
+func TestIssueService_GetWorklogsByIDs(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/worklog/list"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var body map[string][]int64
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(body["ids"], []int64{103, 104}) {
+			t.Errorf("Unexpected body %v", body)
+		}
+		fmt.Fprint(w, `[{"id":"103","issueId":"10002","timeSpentSeconds":3600},{"id":"104","issueId":"10002","timeSpentSeconds":7200}]`)
+	})
+
+	worklogs, _, err := testClient.Issue.GetWorklogsByIDs(context.Background(), []int64{103, 104})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(worklogs) != 2 || worklogs[1].ID != "104" || worklogs[1].TimeSpentSeconds != 7200 {
+		t.Errorf("Unexpected worklogs %+v", worklogs)
+	}
+}
@@ -0,0 +1,61 @@
+package jiratest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	issuePattern       = "/rest/api/{version}/issue/{key}"
+	transitionsPattern = "/rest/api/{version}/issue/{key}/transitions"
+	projectPattern     = "/rest/api/{version}/project/{key}"
+)
+
+// registerDefaults registers the canned responses of the common endpoints.
+func (s *Server) registerDefaults() {
+	s.Handle(http.MethodGet, issuePattern, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, issueJSON(s.URL, PathValue(r, issuePattern, "key")))
+	})
+	s.Respond(http.MethodPost, "/rest/api/{version}/issue", http.StatusCreated,
+		fmt.Sprintf(`{"id":"10002","key":"EX-2","self":"%s/rest/api/2/issue/10002"}`, s.URL))
+	s.Respond(http.MethodPut, issuePattern, http.StatusNoContent, "")
+	s.Respond(http.MethodDelete, issuePattern, http.StatusNoContent, "")
+
+	search := func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, fmt.Sprintf(`{"startAt":0,"maxResults":50,"total":1,"issues":[%s]}`, issueJSON(s.URL, "EX-1")))
+	}
+	s.Handle(http.MethodGet, "/rest/api/{version}/search", search)
+	s.Handle(http.MethodPost, "/rest/api/{version}/search", search)
+
+	s.Respond(http.MethodGet, transitionsPattern, http.StatusOK, `{"expand":"transitions","transitions":[`+
+		`{"id":"11","name":"To Do","to":{"id":"10000","name":"To Do","statusCategory":{"id":2,"key":"new","name":"To Do"}}},`+
+		`{"id":"21","name":"In Progress","to":{"id":"3","name":"In Progress","statusCategory":{"id":4,"key":"indeterminate","name":"In Progress"}}},`+
+		`{"id":"31","name":"Done","to":{"id":"10001","name":"Done","statusCategory":{"id":3,"key":"done","name":"Done"}}}]}`)
+	s.Respond(http.MethodPost, transitionsPattern, http.StatusNoContent, "")
+
+	s.Respond(http.MethodGet, "/rest/api/{version}/project", http.StatusOK, "["+projectJSON(s.URL, "EX")+"]")
+	s.Handle(http.MethodGet, projectPattern, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, projectJSON(s.URL, PathValue(r, projectPattern, "key")))
+	})
+
+	s.Respond(http.MethodGet, "/rest/api/{version}/myself", http.StatusOK,
+		`{"accountId":"5b10a2844c20165700ede21g","name":"admin","displayName":"Admin","emailAddress":"admin@example.com","active":true}`)
+	s.Respond(http.MethodGet, "/rest/api/{version}/serverInfo", http.StatusOK,
+		fmt.Sprintf(`{"baseUrl":"%s","version":"1001.0.0-SNAPSHOT","versionNumbers":[1001,0,0],"deploymentType":"Cloud","serverTitle":"Jira"}`, s.URL))
+}
+
+// issueJSON returns a minimal issue with key in project EX.
+func issueJSON(baseURL, key string) string {
+	return fmt.Sprintf(`{"id":"10001","key":"%[2]s","self":"%[1]s/rest/api/2/issue/10001","fields":{`+
+		`"summary":"Example issue %[2]s",`+
+		`"issuetype":{"id":"10001","name":"Task"},`+
+		`"project":{"id":"10000","key":"EX","name":"Example"},`+
+		`"status":{"id":"10000","name":"To Do","statusCategory":{"id":2,"key":"new","name":"To Do"}},`+
+		`"labels":[]}}`, baseURL, key)
+}
+
+// projectJSON returns a minimal project with key.
+func projectJSON(baseURL, key string) string {
+	return fmt.Sprintf(`{"id":"10000","key":"%[2]s","name":"Example","self":"%[1]s/rest/api/2/project/10000","projectTypeKey":"software",`+
+		`"issueTypes":[{"id":"10001","name":"Task"},{"id":"10002","name":"Bug"}]}`, baseURL, key)
+}
@@ -0,0 +1,267 @@
+package jiratest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// RecorderMode defines whether a RecorderTransport records or replays interactions.
+type RecorderMode int
+
+const (
+	// ModeReplay answers requests from the fixture file without touching the network.
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends requests to Jira and records the interactions for RecorderTransport.Save.
+	ModeRecord
+)
+
+// redacted replaces secrets in recorded bodies.
+const redacted = "REDACTED"
+
+var (
+	// sensitiveHeaders are removed from recorded interactions.
+	sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "Proxy-Authorization"}
+
+	// sensitiveFieldRegex matches JSON fields carrying credentials, like the password of a session login
+	// or the tokens of an OAuth 2.0 refresh.
+	sensitiveFieldRegex = regexp.MustCompile(`(?i)("(?:password|token|apiToken|accessToken|access_token|refreshToken|refresh_token|client_secret|secret)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	// sessionValueRegex matches the session cookie returned by a session login: {"session":{"name":"JSESSIONID","value":"..."}}
+	sessionValueRegex = regexp.MustCompile(`("session"\s*:\s*\{[^{}]*?"value"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+)
+
+// Interaction is a recorded request together with its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a sanitized request.
+// The URL only contains path and query, so that fixtures don't depend on the Jira instance.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a sanitized response.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// RecorderTransport is a http.RoundTripper recording real API interactions to a fixture file
+// and replaying them in tests, without hitting the network:
+//
+//	mode := jiratest.ModeReplay
+//	if os.Getenv("JIRA_RECORD") != "" {
+//		mode = jiratest.ModeRecord
+//	}
+//	recorder, err := jiratest.NewRecorderTransport("testdata/get_issue.json", mode)
+//	// ...
+//	defer recorder.Save()
+//	client, _ := cloud.NewClient(baseURL, &http.Client{Transport: recorder})
+//
+// Credentials are never written to the fixture file: Authorization and cookie headers, user info
+// and the jwt query parameter are stripped. In request and response bodies, JSON fields like "password",
+// "client_secret", "access_token" or "refresh_token" and the value of a login session are redacted.
+// Authentication transports have to wrap the recorder, or have to be set as its Transport.
+type RecorderTransport struct {
+	// Path is the fixture file.
+	Path string
+	// Mode defines whether interactions are recorded or replayed.
+	Mode RecorderMode
+	// Transport is used to send requests in ModeRecord.
+	// If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+}
+
+// NewRecorderTransport returns a RecorderTransport for the fixture file path.
+// In ModeReplay the fixture file is loaded and has to exist.
+func NewRecorderTransport(path string, mode RecorderMode) (*RecorderTransport, error) {
+	r := &RecorderTransport{Path: path, Mode: mode}
+	if mode != ModeReplay {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read fixture: %w", err)
+	}
+	if err := json.Unmarshal(data, &r.interactions); err != nil {
+		return nil, fmt.Errorf("could not parse fixture %s: %w", path, err)
+	}
+	r.replayed = make([]bool, len(r.interactions))
+	return r, nil
+}
+
+// RoundTrip records or replays req, depending on the mode of the transport.
+func (r *RecorderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, outgoing, err := recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+	return r.record(outgoing, recorded)
+}
+
+// Save writes all recorded interactions to the fixture file.
+// It does nothing in ModeReplay.
+func (r *RecorderTransport) Save() error {
+	if r.Mode == ModeReplay {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(r.Path, append(data, '\n'), 0o644)
+}
+
+func (r *RecorderTransport) record(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.interactions = append(r.interactions, Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     sanitizeHeader(resp.Header),
+			Body:       redactBody(body),
+		},
+	})
+	return resp, nil
+}
+
+// replay answers req with the first interaction not yet replayed matching method, URL and body.
+// If all matching interactions were replayed already, the last one is repeated.
+func (r *RecorderTransport) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	match := -1
+	for i, in := range r.interactions {
+		if in.Request.Method != recorded.Method || in.Request.URL != recorded.URL || in.Request.Body != recorded.Body {
+			continue
+		}
+		match = i
+		if !r.replayed[i] {
+			break
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("no recorded interaction for %s %s in %s", recorded.Method, recorded.URL, r.Path)
+	}
+	r.replayed[match] = true
+
+	in := r.interactions[match].Response
+	header := in.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", in.StatusCode, http.StatusText(in.StatusCode)),
+		StatusCode:    in.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(in.Body)),
+		ContentLength: int64(len(in.Body)),
+		Request:       req,
+	}, nil
+}
+
+// recordRequest returns the sanitized form of req and a copy of req to send.
+// The body of req is consumed, so req itself must not be sent anymore.
+func recordRequest(req *http.Request) (RecordedRequest, *http.Request, error) {
+	outgoing := req
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return RecordedRequest{}, nil, err
+		}
+		outgoing = req.Clone(req.Context())
+		outgoing.Body = io.NopCloser(bytes.NewReader(body))
+		outgoing.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	return RecordedRequest{
+		Method: req.Method,
+		URL:    sanitizeURL(req.URL),
+		Header: sanitizeHeader(req.Header),
+		Body:   redactBody(body),
+	}, outgoing, nil
+}
+
+// redactBody returns body with the values of JSON fields carrying credentials replaced.
+func redactBody(body []byte) string {
+	body = sensitiveFieldRegex.ReplaceAll(body, []byte(`${1}"`+redacted+`"`))
+	body = sessionValueRegex.ReplaceAll(body, []byte(`${1}"`+redacted+`"`))
+	return string(body)
+}
+
+// sanitizeURL returns path and query of u without credentials.
+func sanitizeURL(u *url.URL) string {
+	q := u.Query()
+	if q.Has("jwt") {
+		q.Set("jwt", "REDACTED")
+	}
+	s := u.EscapedPath()
+	if len(q) > 0 {
+		s += "?" + q.Encode()
+	}
+	return s
+}
+
+// sanitizeHeader returns a copy of h without credentials.
+func sanitizeHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, key := range sensitiveHeaders {
+		h.Del(key)
+	}
+	if len(h) == 0 {
+		return nil
+	}
+	return h
+}
@@ -0,0 +1,119 @@
+package jiratest_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/andygrunwald/go-jira/v2/jiratest"
+)
+
+func TestRecorderTransport_RecordAndReplay(t *testing.T) {
+	server := jiratest.NewServer()
+	defer server.Close()
+	fixture := filepath.Join(t.TempDir(), "get_issue.json")
+
+	recorder, err := jiratest.NewRecorderTransport(fixture, jiratest.ModeRecord)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	auth := &cloud.BasicAuthTransport{Username: "admin", APIToken: "secret", Transport: recorder}
+	client, _ := cloud.NewClient(server.URL, auth.Client())
+	if _, _, err := client.Issue.Get(context.Background(), "EX-7", nil); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	data, _ := os.ReadFile(fixture)
+	if strings.Contains(string(data), "Authorization") || strings.Contains(string(data), "Basic ") {
+		t.Errorf("Expected a sanitized fixture. Got\n%s", data)
+	}
+
+	// Replay against an address nobody listens on
+	replayer, err := jiratest.NewRecorderTransport(fixture, jiratest.ModeReplay)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	client, _ = cloud.NewClient("http://127.0.0.1:1/", &http.Client{Transport: replayer})
+	issue, _, err := client.Issue.Get(context.Background(), "EX-7", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-7" {
+		t.Errorf("Expected the recorded issue. Got %s", issue.Key)
+	}
+
+	if _, _, err := client.Issue.Get(context.Background(), "EX-8", nil); err == nil {
+		t.Error("Expected an error for a request without recorded interaction")
+	}
+}
+
+func TestRecorderTransport_RedactsBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/auth/1/session" {
+			fmt.Fprint(w, `{"session":{"name":"JSESSIONID","value":"session-secret"},"loginInfo":{"loginCount":1}}`)
+			return
+		}
+		fmt.Fprint(w, `{"access_token":"access-secret","refresh_token":"refresh-secret","expires_in":3600}`)
+	}))
+	defer server.Close()
+	fixture := filepath.Join(t.TempDir(), "login.json")
+
+	recorder, err := jiratest.NewRecorderTransport(fixture, jiratest.ModeRecord)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	requests := map[string]string{
+		"/rest/auth/1/session": `{"username":"admin","password":"password-secret"}`,
+		"/oauth/token":         `{"grant_type":"refresh_token","client_secret":"client-secret","refresh_token":"old-refresh-secret"}`,
+	}
+	for path, payload := range requests {
+		req, _ := http.NewRequest(http.MethodPost, server.URL+path, strings.NewReader(payload))
+		original := req.Body
+		resp, err := recorder.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		responseBody, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if !strings.Contains(string(responseBody), "-secret") {
+			t.Errorf("Expected the unredacted response for the caller. Got %s", responseBody)
+		}
+		if req.Body != original {
+			t.Error("Expected the body of the request not to be replaced")
+		}
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	data, _ := os.ReadFile(fixture)
+	if strings.Contains(string(data), "-secret") {
+		t.Errorf("Expected redacted bodies. Got\n%s", data)
+	}
+
+	// The redacted requests still match when replayed
+	replayer, err := jiratest.NewRecorderTransport(fixture, jiratest.ModeReplay)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	req, _ := http.NewRequest(http.MethodPost, "http://127.0.0.1:1/rest/auth/1/session", strings.NewReader(requests["/rest/auth/1/session"]))
+	if _, err := replayer.RoundTrip(req); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestNewRecorderTransport_MissingFixture(t *testing.T) {
+	if _, err := jiratest.NewRecorderTransport(filepath.Join(t.TempDir(), "missing.json"), jiratest.ModeReplay); err == nil {
+		t.Error("Expected an error. Got none")
+	}
+}
@@ -0,0 +1,168 @@
+// Package jiratest provides a fake Jira server for testing code built on the cloud and onpremise clients.
+//
+// The fake serves canned responses for common endpoints of the platform REST API (issues, search, transitions, projects)
+// and records every request, so that tests can assert what was sent:
+//
+//	server := jiratest.NewServer()
+//	defer server.Close()
+//
+//	client, _ := cloud.NewClient(server.URL, nil)
+//	issue, _, err := client.Issue.Get(ctx, "EX-1", nil)
+//	// ...
+//	if got := server.Requests(); len(got) != 1 || got[0].Path != "/rest/api/2/issue/EX-1" {
+//		// ...
+//	}
+//
+// Canned responses can be replaced or extended per test via Server.Handle and Server.Respond.
+package jiratest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Request is a request received by the Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Server is a fake Jira instance.
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	routes   []route
+	requests []Request
+}
+
+// route connects a request method and path pattern with a handler.
+type route struct {
+	method  string
+	pattern []string
+	handler http.HandlerFunc
+}
+
+// NewServer starts a Server serving the canned responses of the common endpoints.
+// The server has to be closed via Close at the end of the test.
+func NewServer() *Server {
+	s := &Server{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	s.registerDefaults()
+	return s
+}
+
+// Handle registers h for requests with method to path pattern.
+// Path segments in curly braces, like "/rest/api/{version}/issue/{key}", match any value.
+// Handlers registered later take precedence, so canned responses can be replaced.
+func (s *Server) Handle(method, pattern string, h http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes = append(s.routes, route{method: method, pattern: splitPath(pattern), handler: h})
+}
+
+// Respond registers a response with status and JSON body for requests with method to path pattern.
+// An empty body is sent without Content-Type.
+func (s *Server) Respond(method, pattern string, status int, body string) {
+	s.Handle(method, pattern, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, status, body)
+	})
+}
+
+// Requests returns all requests received so far, in order of arrival.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Reset forgets all received requests.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+}
+
+// serve records r and dispatches it to the matching route.
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	handler := s.match(r)
+	s.mu.Unlock()
+
+	if handler == nil {
+		writeJSON(w, http.StatusNotFound, fmt.Sprintf(`{"errorMessages":["No fake response for %s %s"],"errors":{}}`, r.Method, r.URL.Path))
+		return
+	}
+	handler(w, r)
+}
+
+// match returns the handler of the latest route matching r.
+func (s *Server) match(r *http.Request) http.HandlerFunc {
+	segments := splitPath(r.URL.Path)
+	for i := len(s.routes) - 1; i >= 0; i-- {
+		rt := s.routes[i]
+		if rt.method == r.Method && matchPath(rt.pattern, segments) {
+			return rt.handler
+		}
+	}
+	return nil
+}
+
+func matchPath(pattern, segments []string) bool {
+	if len(pattern) != len(segments) {
+		return false
+	}
+	for i, p := range pattern {
+		if strings.HasPrefix(p, "{") && strings.HasSuffix(p, "}") {
+			continue
+		}
+		if p != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// PathValue returns the segment of the request path at the position of the placeholder name in pattern,
+// like the issue key for the name "key" in "/rest/api/{version}/issue/{key}".
+func PathValue(r *http.Request, pattern, name string) string {
+	segments := splitPath(r.URL.Path)
+	for i, p := range splitPath(pattern) {
+		if p == "{"+name+"}" && i < len(segments) {
+			return segments[i]
+		}
+	}
+	return ""
+}
+
+func writeJSON(w http.ResponseWriter, status int, body string) {
+	if body != "" {
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	}
+	w.WriteHeader(status)
+	fmt.Fprint(w, body)
+}
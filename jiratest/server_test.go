@@ -0,0 +1,117 @@
+package jiratest_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/andygrunwald/go-jira/v2/jiratest"
+	"github.com/andygrunwald/go-jira/v2/onpremise"
+)
+
+func TestServer_CannedResponses(t *testing.T) {
+	server := jiratest.NewServer()
+	defer server.Close()
+
+	client, _ := cloud.NewClient(server.URL, nil)
+	ctx := context.Background()
+
+	issue, _, err := client.Issue.Get(ctx, "EX-42", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-42" || issue.Fields.Project.Key != "EX" {
+		t.Errorf("Unexpected issue %+v", issue)
+	}
+
+	issues, _, err := client.Issue.Search(ctx, "project = EX", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issues) != 1 {
+		t.Errorf("Expected 1 issue. Got %d", len(issues))
+	}
+
+	transitions, _, err := client.Issue.GetTransitions(ctx, "EX-42")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(transitions) != 3 || transitions[2].Name != "Done" {
+		t.Errorf("Unexpected transitions %+v", transitions)
+	}
+	if _, err := client.Issue.DoTransition(ctx, "EX-42", transitions[2].ID); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	project, _, err := client.Project.Get(ctx, "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if project.Key != "EX" {
+		t.Errorf("Unexpected project %+v", project)
+	}
+}
+
+func TestServer_RecordsRequests(t *testing.T) {
+	server := jiratest.NewServer()
+	defer server.Close()
+
+	client, _ := onpremise.NewClient(server.URL, nil)
+	if _, err := client.Issue.DoTransition(context.Background(), "EX-1", "31"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	requests := server.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("Expected 1 request. Got %d", len(requests))
+	}
+	got := requests[0]
+	if got.Method != http.MethodPost || got.Path != "/rest/api/2/issue/EX-1/transitions" {
+		t.Errorf("Unexpected request %s %s", got.Method, got.Path)
+	}
+	var body struct {
+		Transition struct {
+			ID string `json:"id"`
+		} `json:"transition"`
+	}
+	if err := json.Unmarshal(got.Body, &body); err != nil || body.Transition.ID != "31" {
+		t.Errorf("Unexpected body %s", got.Body)
+	}
+
+	server.Reset()
+	if len(server.Requests()) != 0 {
+		t.Error("Expected no requests after Reset")
+	}
+}
+
+func TestServer_Respond(t *testing.T) {
+	server := jiratest.NewServer()
+	defer server.Close()
+	server.Respond(http.MethodGet, "/rest/api/{version}/issue/{key}", http.StatusNotFound,
+		`{"errorMessages":["Issue does not exist or you do not have permission to see it."],"errors":{}}`)
+
+	client, _ := cloud.NewClient(server.URL, nil)
+	_, resp, err := client.Issue.Get(context.Background(), "EX-1", nil)
+	if err == nil {
+		t.Fatal("Expected an error. Got none")
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status code 404. Got %d", resp.StatusCode)
+	}
+}
+
+func TestServer_UnknownEndpoint(t *testing.T) {
+	server := jiratest.NewServer()
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/rest/api/2/unknown")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status code 404. Got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,363 @@
+// Package jql builds Jira Query Language (JQL) queries for the search endpoints of the cloud and onpremise clients.
+//
+// Values are quoted and escaped, so user input can't change the structure of a query:
+//
+//	query := jql.Project("FOO").
+//		And(jql.Status().In("Open", "In Progress")).
+//		And(jql.Assignee().Eq(jql.CurrentUser())).
+//		OrderBy("created", jql.Desc)
+//
+//	issues, _, err := client.Issue.Search(ctx, query.String(), nil)
+//
+// The query above results in
+//
+//	project = "FOO" AND status in ("Open", "In Progress") AND assignee = currentUser() ORDER BY created DESC
+package jql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Direction is the sort order of an ORDER BY clause.
+type Direction string
+
+// Sort orders
+const (
+	Asc  Direction = "ASC"
+	Desc Direction = "DESC"
+)
+
+// Clause is a condition of a query, like `status = "Open"`.
+// Clauses are combined via Clause.And and Clause.Or.
+// The zero value is an empty clause, which is left out when combined with other clauses.
+type Clause struct {
+	// op is "AND", "OR" or "NOT" for compound clauses, otherwise empty.
+	op       string
+	children []Clause
+	text     string
+}
+
+func compound(op string, clauses []Clause) Clause {
+	c := Clause{op: op}
+	for _, clause := range clauses {
+		switch {
+		case clause.isEmpty():
+			continue
+		case clause.op == op && op != "NOT":
+			// (a AND b) AND c is a AND b AND c
+			c.children = append(c.children, clause.children...)
+		default:
+			c.children = append(c.children, clause)
+		}
+	}
+	if len(c.children) == 1 {
+		return c.children[0]
+	}
+	return c
+}
+
+// And returns a clause matching if all of clauses match.
+func And(clauses ...Clause) Clause {
+	return compound("AND", clauses)
+}
+
+// Or returns a clause matching if any of clauses matches.
+func Or(clauses ...Clause) Clause {
+	return compound("OR", clauses)
+}
+
+// Not returns a clause matching if clause doesn't match.
+func Not(clause Clause) Clause {
+	if clause.isEmpty() {
+		return clause
+	}
+	return Clause{op: "NOT", children: []Clause{clause}}
+}
+
+// And returns a clause matching if c and all of clauses match.
+func (c Clause) And(clauses ...Clause) Clause {
+	return And(append([]Clause{c}, clauses...)...)
+}
+
+// Or returns a clause matching if c or any of clauses matches.
+func (c Clause) Or(clauses ...Clause) Clause {
+	return Or(append([]Clause{c}, clauses...)...)
+}
+
+// OrderBy returns a query of the issues matching c, sorted by field.
+func (c Clause) OrderBy(field string, direction Direction) *Query {
+	return (&Query{where: c}).OrderBy(field, direction)
+}
+
+// String returns the clause as JQL.
+func (c Clause) String() string {
+	switch c.op {
+	case "":
+		return c.text
+	case "NOT":
+		return "NOT " + c.children[0].nested()
+	}
+
+	parts := make([]string, 0, len(c.children))
+	for _, child := range c.children {
+		parts = append(parts, child.nested())
+	}
+	return strings.Join(parts, " "+c.op+" ")
+}
+
+// nested returns the clause as JQL to be used as operand of another compound clause.
+func (c Clause) nested() string {
+	if c.op == "AND" || c.op == "OR" {
+		return "(" + c.String() + ")"
+	}
+	return c.String()
+}
+
+func (c Clause) isEmpty() bool {
+	return c.op == "" && c.text == ""
+}
+
+// Query is a complete JQL query, consisting of a clause and its sort order.
+type Query struct {
+	where   Clause
+	orderBy []string
+}
+
+// OrderBy returns a query of all issues, sorted by field.
+func OrderBy(field string, direction Direction) *Query {
+	return (&Query{}).OrderBy(field, direction)
+}
+
+// OrderBy sorts the issues by field, after all previously added fields.
+func (q *Query) OrderBy(field string, direction Direction) *Query {
+	order := Field(field).String()
+	if direction != "" {
+		order += " " + string(direction)
+	}
+	q.orderBy = append(q.orderBy, order)
+	return q
+}
+
+// String returns the query as JQL.
+func (q *Query) String() string {
+	s := q.where.String()
+	if len(q.orderBy) > 0 {
+		if s != "" {
+			s += " "
+		}
+		s += "ORDER BY " + strings.Join(q.orderBy, ", ")
+	}
+	return s
+}
+
+// Field is a field referenced in a clause, by name, like "status" or "Story Points", or by ID, like "cf[10010]".
+type Field string
+
+// Assignee returns the field assignee.
+func Assignee() Field { return Field("assignee") }
+
+// Component returns the field component.
+func Component() Field { return Field("component") }
+
+// Created returns the field created.
+func Created() Field { return Field("created") }
+
+// Due returns the field due.
+func Due() Field { return Field("due") }
+
+// FixVersion returns the field fixVersion.
+func FixVersion() Field { return Field("fixVersion") }
+
+// IssueKey returns the field issuekey.
+func IssueKey() Field { return Field("issuekey") }
+
+// IssueType returns the field issuetype.
+func IssueType() Field { return Field("issuetype") }
+
+// Labels returns the field labels.
+func Labels() Field { return Field("labels") }
+
+// Parent returns the field parent.
+func Parent() Field { return Field("parent") }
+
+// Priority returns the field priority.
+func Priority() Field { return Field("priority") }
+
+// Reporter returns the field reporter.
+func Reporter() Field { return Field("reporter") }
+
+// Resolution returns the field resolution.
+func Resolution() Field { return Field("resolution") }
+
+// Sprint returns the field sprint.
+func Sprint() Field { return Field("sprint") }
+
+// Status returns the field status.
+func Status() Field { return Field("status") }
+
+// Summary returns the field summary.
+func Summary() Field { return Field("summary") }
+
+// Text returns the field text.
+func Text() Field { return Field("text") }
+
+// Updated returns the field updated.
+func Updated() Field { return Field("updated") }
+
+// CustomField returns the custom field with the numeric ID id, like 10010 for customfield_10010.
+func CustomField(id int) Field {
+	return Field(fmt.Sprintf("cf[%d]", id))
+}
+
+// Project returns a clause matching the issues of the projects keys.
+func Project(keys ...string) Clause {
+	return Field("project").oneOf(keys)
+}
+
+// Key returns a clause matching the issues keys.
+func Key(keys ...string) Clause {
+	return IssueKey().oneOf(keys)
+}
+
+func (f Field) oneOf(values []string) Clause {
+	if len(values) == 1 {
+		return f.Eq(values[0])
+	}
+	operands := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		operands = append(operands, v)
+	}
+	return f.In(operands...)
+}
+
+// plainField matches field names that don't need to be quoted.
+var plainField = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_.]*|cf\[\d+\])$`)
+
+// String returns the field as JQL. Names containing spaces or special characters are quoted.
+func (f Field) String() string {
+	if plainField.MatchString(string(f)) && !reservedWords[strings.ToLower(string(f))] {
+		return string(f)
+	}
+	return quote(string(f))
+}
+
+func (f Field) compare(operator string, value interface{}) Clause {
+	return Clause{text: fmt.Sprintf("%s %s %s", f, operator, Format(value))}
+}
+
+// Eq returns the clause `f = value`.
+func (f Field) Eq(value interface{}) Clause { return f.compare("=", value) }
+
+// NotEq returns the clause `f != value`.
+func (f Field) NotEq(value interface{}) Clause { return f.compare("!=", value) }
+
+// Gt returns the clause `f > value`.
+func (f Field) Gt(value interface{}) Clause { return f.compare(">", value) }
+
+// Gte returns the clause `f >= value`.
+func (f Field) Gte(value interface{}) Clause { return f.compare(">=", value) }
+
+// Lt returns the clause `f < value`.
+func (f Field) Lt(value interface{}) Clause { return f.compare("<", value) }
+
+// Lte returns the clause `f <= value`.
+func (f Field) Lte(value interface{}) Clause { return f.compare("<=", value) }
+
+// Contains returns the clause `f ~ value`, a text search.
+func (f Field) Contains(value string) Clause { return f.compare("~", value) }
+
+// NotContains returns the clause `f !~ value`.
+func (f Field) NotContains(value string) Clause { return f.compare("!~", value) }
+
+// IsEmpty returns the clause `f is EMPTY`.
+func (f Field) IsEmpty() Clause { return Clause{text: fmt.Sprintf("%s is EMPTY", f)} }
+
+// IsNotEmpty returns the clause `f is not EMPTY`.
+func (f Field) IsNotEmpty() Clause { return Clause{text: fmt.Sprintf("%s is not EMPTY", f)} }
+
+// In returns the clause `f in (values...)`.
+func (f Field) In(values ...interface{}) Clause { return f.compare("in", list(values)) }
+
+// NotIn returns the clause `f not in (values...)`.
+func (f Field) NotIn(values ...interface{}) Clause { return f.compare("not in", list(values)) }
+
+// Was returns the clause `f was value`, matching issues whose field had value at some point.
+func (f Field) Was(value interface{}) Clause { return f.compare("was", value) }
+
+// Function is a JQL function like currentUser() or startOfDay("-1d"). Functions are not quoted.
+type Function struct {
+	name string
+	args []string
+}
+
+// Func returns the function name called with args. The arguments are quoted.
+func Func(name string, args ...string) Function {
+	return Function{name: name, args: args}
+}
+
+// CurrentUser returns the function currentUser().
+func CurrentUser() Function { return Func("currentUser") }
+
+// OpenSprints returns the function openSprints().
+func OpenSprints() Function { return Func("openSprints") }
+
+// Now returns the function now().
+func Now() Function { return Func("now") }
+
+// StartOfDay returns the function startOfDay(), optionally with an increment like "-1d".
+func StartOfDay(increment ...string) Function { return Func("startOfDay", increment...) }
+
+// String returns the function call as JQL.
+func (f Function) String() string {
+	args := make([]string, 0, len(f.args))
+	for _, arg := range f.args {
+		args = append(args, quote(arg))
+	}
+	return fmt.Sprintf("%s(%s)", f.name, strings.Join(args, ", "))
+}
+
+// list is the operand of the in and not in operators.
+type list []interface{}
+
+// Format returns value as JQL operand.
+// Strings are quoted and escaped, numbers are used as is, time.Time is formatted as "yyyy-MM-dd HH:mm"
+// and Function is called. Other values are quoted in their default format.
+func Format(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return quote(v)
+	case Function:
+		return v.String()
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return quote(v.Format("2006-01-02 15:04"))
+	case list:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, Format(item))
+		}
+		return "(" + strings.Join(values, ", ") + ")"
+	}
+	return quote(fmt.Sprint(value))
+}
+
+// quote returns s as quoted JQL string.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`).Replace(s) + `"`
+}
+
+// reservedWords can't be used as unquoted field names.
+// See https://support.atlassian.com/jira-software-cloud/docs/use-advanced-search-with-jira-query-language-jql/
+var reservedWords = map[string]bool{
+	"and": true, "or": true, "not": true, "empty": true, "null": true, "order": true, "by": true,
+	"asc": true, "desc": true, "in": true, "is": true, "was": true, "changed": true,
+}
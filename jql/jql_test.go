@@ -0,0 +1,78 @@
+package jql_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/andygrunwald/go-jira/v2/jql"
+)
+
+func TestQuery_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		query fmt.Stringer
+		want  string
+	}{
+		{
+			name:  "project and status",
+			query: jql.Project("FOO").And(jql.Status().In("Open", "In Progress")).OrderBy("created", jql.Desc),
+			want:  `project = "FOO" AND status in ("Open", "In Progress") ORDER BY created DESC`,
+		},
+		{
+			name:  "multiple projects",
+			query: jql.Project("FOO", "BAR"),
+			want:  `project in ("FOO", "BAR")`,
+		},
+		{
+			name:  "quoting",
+			query: jql.Summary().Contains(`say "hi" \o/`),
+			want:  `summary ~ "say \"hi\" \\o/"`,
+		},
+		{
+			name:  "injection",
+			query: jql.Labels().Eq(`x" OR project = "SECRET`),
+			want:  `labels = "x\" OR project = \"SECRET"`,
+		},
+		{
+			name:  "or inside and",
+			query: jql.And(jql.Project("FOO"), jql.Assignee().Eq(jql.CurrentUser()).Or(jql.Assignee().IsEmpty())),
+			want:  `project = "FOO" AND (assignee = currentUser() OR assignee is EMPTY)`,
+		},
+		{
+			name:  "flattened and",
+			query: jql.Project("FOO").And(jql.Key("FOO-1")).And(jql.Clause{}, jql.Not(jql.Resolution().IsNotEmpty())),
+			want:  `project = "FOO" AND issuekey = "FOO-1" AND NOT resolution is not EMPTY`,
+		},
+		{
+			name:  "fields and values",
+			query: jql.And(jql.Field("Story Points").Gte(3), jql.CustomField(10010).Lt(1.5), jql.Field("order").NotIn("a"), jql.Created().Gt(jql.StartOfDay("-1d"))),
+			want:  `"Story Points" >= 3 AND cf[10010] < 1.5 AND "order" not in ("a") AND created > startOfDay("-1d")`,
+		},
+		{
+			name:  "time",
+			query: jql.Updated().Lte(time.Date(2022, 10, 3, 14, 5, 0, 0, time.UTC)),
+			want:  `updated <= "2022-10-03 14:05"`,
+		},
+		{
+			name:  "order only",
+			query: jql.OrderBy("Rank", jql.Asc).OrderBy("due date", ""),
+			want:  `ORDER BY Rank ASC, "due date"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.String(); got != tt.want {
+				t.Errorf("Unexpected JQL\ngot:  %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleClause_OrderBy() {
+	query := jql.Project("FOO").
+		And(jql.Status().In("Open", "In Progress")).
+		OrderBy("created", jql.Desc)
+	fmt.Println(query)
+	// Output: project = "FOO" AND status in ("Open", "In Progress") ORDER BY created DESC
+}
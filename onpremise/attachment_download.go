@@ -0,0 +1,45 @@
+package onpremise
+
+import (
+	"context"
+	"io"
+)
+
+// AttachmentDownloadOptions specifies the optional parameters for IssueService.DownloadAttachmentTo.
+type AttachmentDownloadOptions struct {
+	// Progress is called after every chunk written to the writer.
+	// written is the number of bytes written so far, total the size of the attachment or -1 if Jira didn't report it.
+	Progress func(written, total int64)
+}
+
+// DownloadAttachmentTo streams the content of the attachment attachmentID to w.
+// The content is never held in memory completely, which makes it suitable for large attachments.
+// It returns the number of bytes written. The body of the returned Response is already closed.
+func (s *IssueService) DownloadAttachmentTo(ctx context.Context, attachmentID string, w io.Writer, options *AttachmentDownloadOptions) (int64, *Response, error) {
+	resp, err := s.DownloadAttachment(ctx, attachmentID)
+	if err != nil {
+		return 0, resp, err
+	}
+	defer resp.Body.Close()
+
+	if options != nil && options.Progress != nil {
+		w = &progressWriter{w: w, total: resp.ContentLength, progress: options.Progress}
+	}
+	n, err := io.Copy(w, resp.Body)
+	return n, resp, err
+}
+
+// progressWriter reports the number of bytes written to w.
+type progressWriter struct {
+	w        io.Writer
+	written  int64
+	total    int64
+	progress func(written, total int64)
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.written += int64(n)
+	p.progress(p.written, p.total)
+	return n, err
+}
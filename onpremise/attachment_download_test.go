@@ -0,0 +1,56 @@
+package onpremise
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestIssueService_DownloadAttachmentTo(t *testing.T) {
+	setup()
+	defer teardown()
+	content := strings.Repeat("Here is a large attachment. ", 4096)
+	testMux.HandleFunc("/secure/attachment/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/secure/attachment/10000/")
+
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		w.Write([]byte(content))
+	})
+
+	var buf bytes.Buffer
+	var lastWritten, lastTotal int64
+	n, _, err := testClient.Issue.DownloadAttachmentTo(context.Background(), "10000", &buf, &AttachmentDownloadOptions{
+		Progress: func(written, total int64) {
+			lastWritten, lastTotal = written, total
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if n != int64(len(content)) || buf.String() != content {
+		t.Errorf("Expected the complete attachment. Got %d bytes", n)
+	}
+	if lastWritten != n || lastTotal != n {
+		t.Errorf("Expected the final progress to be %d/%d. Got %d/%d", n, n, lastWritten, lastTotal)
+	}
+}
+
+func TestIssueService_DownloadAttachmentTo_BadStatus(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/secure/attachment/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	var buf bytes.Buffer
+	if _, _, err := testClient.Issue.DownloadAttachmentTo(context.Background(), "10000", &buf, nil); err == nil {
+		t.Error("Expected an error. Got none")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Expected nothing to be written. Got %q", buf.String())
+	}
+}
@@ -0,0 +1,218 @@
+package onpremise
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"time"
+)
+
+// AttachmentUploadOptions specifies the optional parameters for IssueService.UploadAttachment.
+type AttachmentUploadOptions struct {
+	// MaxAttempts is the number of times the upload is tried before giving up.
+	// Only network errors, server errors (5xx) and rate limiting (429) are retried.
+	// Defaults to 3.
+	MaxAttempts int
+
+	// RetryDelay is the delay before the second attempt.
+	// It grows linearly with every further attempt.
+	// Defaults to one second.
+	RetryDelay time.Duration
+
+	// VerifyContent downloads the attachment after the upload and compares its SHA-256 checksum with the uploaded content.
+	// Without it, only the size reported by Jira is verified.
+	VerifyContent bool
+}
+
+// AttachmentIntegrityError is returned by IssueService.UploadAttachment if the stored attachment differs from the uploaded content.
+// The stored attachment has been deleted again when this error is returned.
+type AttachmentIntegrityError struct {
+	Filename string
+	// Size and SHA256 describe the uploaded content.
+	Size   int64
+	SHA256 string
+	// StoredSize and StoredSHA256 describe the attachment stored by Jira.
+	// StoredSHA256 is only set if AttachmentUploadOptions.VerifyContent is enabled.
+	StoredSize   int64
+	StoredSHA256 string
+}
+
+func (e *AttachmentIntegrityError) Error() string {
+	if e.StoredSHA256 != "" {
+		return fmt.Sprintf("attachment %q is corrupted: uploaded sha256 %s, stored sha256 %s", e.Filename, e.SHA256, e.StoredSHA256)
+	}
+	return fmt.Sprintf("attachment %q is corrupted: uploaded %d bytes, stored %d bytes", e.Filename, e.Size, e.StoredSize)
+}
+
+// errUploadFinished stops the writer of an upload once the request is done.
+var errUploadFinished = errors.New("upload finished")
+
+// UploadAttachment uploads r as an attachment with the given name to the issue.
+// It is meant for large attachments:
+//
+//   - The content is streamed to Jira instead of being buffered completely in memory like PostAttachment does.
+//   - Failed uploads are retried from the start of r, which is why r has to be an io.ReadSeeker.
+//     Jira has no API to resume an upload at the failed offset.
+//   - The stored attachment is verified against the uploaded size (and optionally the SHA-256 checksum).
+//     If the verification fails, the stored attachment is deleted and an *AttachmentIntegrityError is returned.
+func (s *IssueService) UploadAttachment(ctx context.Context, issueID string, r io.ReadSeeker, attachmentName string, options *AttachmentUploadOptions) (*Attachment, *Response, error) {
+	opts := AttachmentUploadOptions{MaxAttempts: 3, RetryDelay: time.Second}
+	if options != nil {
+		opts.VerifyContent = options.VerifyContent
+		if options.MaxAttempts > 0 {
+			opts.MaxAttempts = options.MaxAttempts
+		}
+		if options.RetryDelay > 0 {
+			opts.RetryDelay = options.RetryDelay
+		}
+	}
+
+	var (
+		attachment *Attachment
+		resp       *Response
+		size       int64
+		checksum   string
+		err        error
+	)
+	for attempt := 1; attempt <= opts.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return nil, resp, ctx.Err()
+			case <-time.After(time.Duration(attempt-1) * opts.RetryDelay):
+			}
+		}
+
+		if _, err = r.Seek(0, io.SeekStart); err != nil {
+			return nil, nil, err
+		}
+		attachment, resp, size, checksum, err = s.uploadAttachment(ctx, issueID, r, attachmentName)
+		if err == nil || !retryableUploadError(ctx, resp) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	integrityErr := &AttachmentIntegrityError{
+		Filename:   attachmentName,
+		Size:       size,
+		SHA256:     checksum,
+		StoredSize: int64(attachment.Size),
+	}
+	if integrityErr.StoredSize == size && opts.VerifyContent {
+		integrityErr.StoredSHA256, err = s.attachmentChecksum(ctx, attachment.ID)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+	if integrityErr.StoredSize != size || (opts.VerifyContent && integrityErr.StoredSHA256 != checksum) {
+		if _, err := s.DeleteAttachment(ctx, attachment.ID); err != nil {
+			return nil, resp, fmt.Errorf("%s (deleting the corrupted attachment failed: %v)", integrityErr, err)
+		}
+		return nil, resp, integrityErr
+	}
+
+	return attachment, resp, nil
+}
+
+// AddAttachment streams r as an attachment with the given filename to the issue.
+// Unlike PostAttachment, the multipart body is written while the request is sent,
+// so even huge files are never held in memory.
+// As r is consumed by the request, failed uploads are not retried. Use UploadAttachment for retries.
+//
+// Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue/{issueIdOrKey}/attachments-addAttachment
+func (s *IssueService) AddAttachment(ctx context.Context, issueKey, filename string, r io.Reader) (*Attachment, *Response, error) {
+	attachment, resp, _, _, err := s.uploadAttachment(ctx, issueKey, r, filename)
+	return attachment, resp, err
+}
+
+// uploadAttachment sends a single upload request and streams r as multipart body.
+// It returns the number of bytes and the SHA-256 checksum of the content that was read from r.
+func (s *IssueService) uploadAttachment(ctx context.Context, issueID string, r io.Reader, attachmentName string) (*Attachment, *Response, int64, string, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/attachments", issueID)
+
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	checksum := sha256.New()
+	var size int64
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		size, _ = copyAttachment(pw, writer, io.TeeReader(r, checksum), attachmentName)
+	}()
+
+	finish := func() {
+		// Unblocks the writer if the request ended before the body was consumed completely
+		pr.CloseWithError(errUploadFinished)
+		<-done
+	}
+
+	req, err := s.client.NewRawRequest(ctx, http.MethodPost, apiEndpoint, pr)
+	if err != nil {
+		finish()
+		return nil, nil, 0, "", err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("X-Atlassian-Token", "nocheck")
+
+	attachments := []Attachment{}
+	resp, err := s.client.Do(req, &attachments)
+	finish()
+	if err != nil {
+		return nil, resp, 0, "", NewJiraError(resp, err)
+	}
+	if len(attachments) != 1 {
+		return nil, resp, 0, "", fmt.Errorf("expected 1 stored attachment, got %d", len(attachments))
+	}
+
+	return &attachments[0], resp, size, hex.EncodeToString(checksum.Sum(nil)), nil
+}
+
+// copyAttachment writes r as file part of the multipart form writer and closes the pipe pw underlying writer.
+func copyAttachment(pw *io.PipeWriter, writer *multipart.Writer, r io.Reader, attachmentName string) (int64, error) {
+	fw, err := writer.CreateFormFile("file", attachmentName)
+	if err != nil {
+		pw.CloseWithError(err)
+		return 0, err
+	}
+	n, err := io.Copy(fw, r)
+	if err == nil {
+		err = writer.Close()
+	}
+	pw.CloseWithError(err)
+	return n, err
+}
+
+// attachmentChecksum downloads an attachment and returns its SHA-256 checksum.
+func (s *IssueService) attachmentChecksum(ctx context.Context, attachmentID string) (string, error) {
+	resp, err := s.DownloadAttachment(ctx, attachmentID)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	checksum := sha256.New()
+	if _, err := io.Copy(checksum, resp.Body); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(checksum.Sum(nil)), nil
+}
+
+// retryableUploadError reports whether a failed upload should be tried again.
+func retryableUploadError(ctx context.Context, resp *Response) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if resp == nil {
+		// Network error
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,142 @@
+package onpremise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testUploadContent = "Here is a large attachment"
+
+// handleUpload serves the attachment upload endpoint of issue 10000.
+// The first failures requests are answered with 503.
+// The stored attachment reports storedSize as size.
+func handleUpload(t *testing.T, failures int, storedSize int) *int {
+	attempts := 0
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		attempts++
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Expected a multipart file. Got %s", err)
+		}
+		defer file.Close()
+		if data, _ := io.ReadAll(file); string(data) != testUploadContent {
+			t.Errorf("Expected the complete content in attempt %d. Got %q", attempts, data)
+		}
+
+		if attempts <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintf(w, `[{"id":"228924","filename":"large.txt","size":%d}]`, storedSize)
+	})
+	return &attempts
+}
+
+func TestIssueService_UploadAttachment(t *testing.T) {
+	setup()
+	defer teardown()
+	attempts := handleUpload(t, 1, len(testUploadContent))
+
+	attachment, _, err := testClient.Issue.UploadAttachment(context.Background(), "10000", strings.NewReader(testUploadContent), "large.txt", &AttachmentUploadOptions{
+		RetryDelay: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if attachment.ID != "228924" {
+		t.Errorf("Expected attachment 228924. Got %q", attachment.ID)
+	}
+	if *attempts != 2 {
+		t.Errorf("Expected the failed upload to be retried once. Got %d attempts", *attempts)
+	}
+}
+
+func TestIssueService_UploadAttachment_SizeMismatch(t *testing.T) {
+	setup()
+	defer teardown()
+	handleUpload(t, 0, 3)
+
+	deleted := false
+	testMux.HandleFunc("/rest/api/2/attachment/228924", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, _, err := testClient.Issue.UploadAttachment(context.Background(), "10000", strings.NewReader(testUploadContent), "large.txt", nil)
+	var integrityErr *AttachmentIntegrityError
+	if !errors.As(err, &integrityErr) {
+		t.Fatalf("Expected an AttachmentIntegrityError. Got %v", err)
+	}
+	if integrityErr.Size != int64(len(testUploadContent)) || integrityErr.StoredSize != 3 {
+		t.Errorf("Unexpected sizes in %+v", integrityErr)
+	}
+	if !deleted {
+		t.Error("Expected the corrupted attachment to be deleted")
+	}
+}
+
+func TestIssueService_UploadAttachment_GivesUp(t *testing.T) {
+	setup()
+	defer teardown()
+	attempts := handleUpload(t, 5, len(testUploadContent))
+
+	_, resp, err := testClient.Issue.UploadAttachment(context.Background(), "10000", strings.NewReader(testUploadContent), "large.txt", &AttachmentUploadOptions{
+		MaxAttempts: 2,
+		RetryDelay:  time.Millisecond,
+	})
+	if err == nil {
+		t.Fatal("Expected an error after all attempts failed")
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected status code 503. Got %d", resp.StatusCode)
+	}
+	if *attempts != 2 {
+		t.Errorf("Expected 2 attempts. Got %d", *attempts)
+	}
+}
+
+func TestIssueService_UploadAttachment_VerifyContent(t *testing.T) {
+	setup()
+	defer teardown()
+	handleUpload(t, 0, len(testUploadContent))
+
+	testMux.HandleFunc("/secure/attachment/228924/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, testUploadContent)
+	})
+
+	_, _, err := testClient.Issue.UploadAttachment(context.Background(), "10000", strings.NewReader(testUploadContent), "large.txt", &AttachmentUploadOptions{
+		VerifyContent: true,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_AddAttachment(t *testing.T) {
+	setup()
+	defer teardown()
+	attempts := handleUpload(t, 0, len(testUploadContent))
+
+	// A plain io.Reader without Seek or Len, like a pipe of a build artifact
+	r := io.MultiReader(strings.NewReader(testUploadContent))
+	attachment, _, err := testClient.Issue.AddAttachment(context.Background(), "10000", "large.txt", r)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if attachment.ID != "228924" {
+		t.Errorf("Expected attachment 228924. Got %q", attachment.ID)
+	}
+	if *attempts != 1 {
+		t.Errorf("Expected a single upload. Got %d attempts", *attempts)
+	}
+}
@@ -2,9 +2,12 @@ package onpremise
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"sync"
 	"time"
 )
 
@@ -14,6 +17,9 @@ import (
 // Note that it is generally preferable to use HTTP BASIC authentication with the REST API.
 // However, this resource may be used to mimic the behaviour of Jira's log-in page (e.g. to display log-in errors to a user).
 //
+// If the session expired, the transport logs in again and retries the request once.
+// Concurrent requests share a single login.
+//
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#auth/1/session
 type CookieAuthTransport struct {
 	Username string
@@ -27,26 +33,93 @@ type CookieAuthTransport struct {
 	// Transport is the underlying HTTP transport to use when making requests.
 	// It will default to http.DefaultTransport if nil.
 	Transport http.RoundTripper
+
+	// mu protects SessionObject and generation
+	mu sync.Mutex
+	// generation is incremented with every login
+	generation int
 }
 
 // RoundTrip adds the session object to the request.
+// If Jira rejects the session, a new session is created and the request is retried once.
 func (t *CookieAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	if t.SessionObject == nil {
-		err := t.setSessionObject()
+	session, generation, err := t.session(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("cookieauth: no session object has been set: %w", err)
+	}
+
+	resp, err := t.transport().RoundTrip(withCookies(req, session))
+	if err != nil || !sessionExpired(resp) {
+		return resp, err
+	}
+	// A consumed body can't be sent again
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	session, err = t.renewSession(req.Context(), generation)
+	if err != nil {
+		return nil, fmt.Errorf("cookieauth: could not renew the session: %w", err)
+	}
+
+	retry := req
+	if req.GetBody != nil {
+		body, err := req.GetBody()
 		if err != nil {
-			return nil, fmt.Errorf("cookieauth: no session object has been set: %w", err)
+			return nil, err
+		}
+		retry = req.Clone(req.Context())
+		retry.Body = body
+	}
+	return t.transport().RoundTrip(withCookies(retry, session))
+}
+
+// session returns the current session and its generation.
+// If there is none yet, the user is logged in.
+func (t *CookieAuthTransport) session(ctx context.Context) ([]*http.Cookie, int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.SessionObject == nil {
+		if err := t.setSessionObject(ctx); err != nil {
+			return nil, 0, err
+		}
+		t.generation++
+	}
+	return t.SessionObject, t.generation, nil
+}
+
+// renewSession logs in again, unless the session of generation was already renewed by a concurrent request.
+func (t *CookieAuthTransport) renewSession(ctx context.Context, generation int) ([]*http.Cookie, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.generation == generation {
+		if err := t.setSessionObject(ctx); err != nil {
+			return nil, err
 		}
+		t.generation++
 	}
+	return t.SessionObject, nil
+}
 
+// sessionExpired reports whether Jira rejected the session cookie of the request leading to resp.
+func sessionExpired(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusUnauthorized || resp.Header.Get("X-Seraph-LoginReason") == "AUTHENTICATED_FAILED"
+}
+
+// withCookies returns a copy of req carrying the cookies of session.
+func withCookies(req *http.Request, session []*http.Cookie) *http.Request {
 	req2 := cloneRequest(req) // per RoundTripper contract
-	for _, cookie := range t.SessionObject {
+	for _, cookie := range session {
 		// Don't add an empty value cookie to the request
 		if cookie.Value != "" {
 			req2.AddCookie(cookie)
 		}
 	}
-
-	return t.transport().RoundTrip(req2)
+	return req2
 }
 
 // Client returns an *http.Client that makes requests that are authenticated
@@ -57,8 +130,8 @@ func (t *CookieAuthTransport) Client() *http.Client {
 
 // setSessionObject attempts to authenticate the user and set
 // the session object (e.g. cookie)
-func (t *CookieAuthTransport) setSessionObject() error {
-	req, err := t.buildAuthRequest()
+func (t *CookieAuthTransport) setSessionObject(ctx context.Context) error {
+	req, err := t.buildAuthRequest(ctx)
 	if err != nil {
 		return err
 	}
@@ -71,13 +144,16 @@ func (t *CookieAuthTransport) setSessionObject() error {
 		return err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("login failed with status code %d", resp.StatusCode)
+	}
 
 	t.SessionObject = resp.Cookies()
 	return nil
 }
 
 // getAuthRequest assembles the request to get the authenticated cookie
-func (t *CookieAuthTransport) buildAuthRequest() (*http.Request, error) {
+func (t *CookieAuthTransport) buildAuthRequest(ctx context.Context) (*http.Request, error) {
 	body := struct {
 		Username string `json:"username"`
 		Password string `json:"password"`
@@ -89,8 +165,7 @@ func (t *CookieAuthTransport) buildAuthRequest() (*http.Request, error) {
 	b := new(bytes.Buffer)
 	json.NewEncoder(b).Encode(body)
 
-	// TODO Use a context here
-	req, err := http.NewRequest(http.MethodPost, t.AuthURL, b)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.AuthURL, b)
 	if err != nil {
 		return nil, err
 	}
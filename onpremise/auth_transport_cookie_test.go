@@ -2,8 +2,11 @@ package onpremise
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 )
 
@@ -118,3 +121,70 @@ func TestCookieAuthTransport_SessionObject_DoesNotExist(t *testing.T) {
 	req, _ := basicAuthClient.NewRequest(context.Background(), http.MethodGet, ".", nil)
 	basicAuthClient.Do(req, nil)
 }
+
+// Test that an expired session is renewed once and the request is retried
+func TestCookieAuthTransport_RenewsExpiredSession(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var logins int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&logins, 1)
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "fresh"})
+		w.Write([]byte(`OK`))
+	}))
+	defer ts.Close()
+
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("JSESSIONID")
+		if err != nil || cookie.Value != "fresh" {
+			w.Header().Set("X-Seraph-LoginReason", "AUTHENTICATED_FAILED")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "{}\n" {
+			t.Errorf("Expected the body to be sent again. Got %q", body)
+		}
+		w.Write([]byte(`{"key":"EX-1"}`))
+	})
+
+	tp := &CookieAuthTransport{
+		Username:      "username",
+		Password:      "password",
+		AuthURL:       ts.URL,
+		SessionObject: []*http.Cookie{{Name: "JSESSIONID", Value: "expired"}},
+	}
+	client, _ := NewClient(testServer.URL, tp.Client())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := client.Issue.Create(context.Background(), &Issue{}); err != nil {
+				t.Errorf("Error given: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&logins); got != 1 {
+		t.Errorf("Expected a single login. Got %d", got)
+	}
+}
+
+// Test that a failed login is reported
+func TestCookieAuthTransport_LoginFailed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	tp := &CookieAuthTransport{Username: "username", Password: "wrong", AuthURL: ts.URL}
+	client, _ := NewClient(ts.URL, tp.Client())
+	req, _ := client.NewRequest(context.Background(), http.MethodGet, "rest/api/2/myself", nil)
+	if _, err := client.Do(req, nil); err == nil {
+		t.Error("Expected an error. Got none")
+	}
+}
@@ -12,6 +12,8 @@ const (
 	authTypeBasic = 1
 	// HTTP Session Authentication
 	authTypeSession = 2
+	// Personal Access Token Authentication
+	authTypePAT = 3
 )
 
 // AuthenticationService handles authentication for the Jira instance / API.
@@ -28,6 +30,9 @@ type AuthenticationService struct {
 
 	// Basic auth password
 	password string
+
+	// Personal Access Token
+	token string
 }
 
 // Session represents a Session JSON response by the Jira API.
@@ -99,6 +104,17 @@ func (s *AuthenticationService) SetBasicAuth(username, password string) {
 	s.authType = authTypeBasic
 }
 
+// SetPersonalAccessToken sets the Personal Access Token (PAT) used to authenticate against the Jira instance.
+// PATs are supported by Jira Server / Data Center 8.14 and later and are sent as bearer token.
+//
+// PATAuthTransport is an alternative that doesn't require to configure the client after its creation.
+//
+// Jira docs: https://confluence.atlassian.com/enterprise/using-personal-access-tokens-1026032365.html
+func (s *AuthenticationService) SetPersonalAccessToken(token string) {
+	s.token = token
+	s.authType = authTypePAT
+}
+
 // Authenticated reports if the current Client has authentication details for Jira
 func (s *AuthenticationService) Authenticated() bool {
 	if s != nil {
@@ -106,6 +122,8 @@ func (s *AuthenticationService) Authenticated() bool {
 			return s.client.session != nil
 		} else if s.authType == authTypeBasic {
 			return s.username != ""
+		} else if s.authType == authTypePAT {
+			return s.token != ""
 		}
 
 	}
@@ -134,6 +134,27 @@ func TestAuthenticationService_Authenticated_WithBasicAuthButNoUsername(t *testi
 	}
 }
 
+func TestAuthenticationService_SetPersonalAccessToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer my-token" {
+			t.Errorf("Expected bearer token my-token. Got %q", got)
+		}
+		fmt.Fprint(w, `{"name":"test-user"}`)
+	})
+
+	testClient.Authentication.SetPersonalAccessToken("my-token")
+
+	if testClient.Authentication.Authenticated() != true {
+		t.Error("Expected true, but result was false")
+	}
+	if _, _, err := testClient.User.GetSelf(context.Background()); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
 func TestAuthenticationService_GetUserInfo_AccessForbidden_Fail(t *testing.T) {
 	setup()
 	defer teardown()
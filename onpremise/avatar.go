@@ -0,0 +1,181 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// AvatarService handles avatars for the Jira instance / API.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/universal_avatar
+type AvatarService service
+
+// AvatarType represents the kind of entity an avatar belongs to.
+type AvatarType string
+
+const (
+	AvatarTypeIssueType AvatarType = "issuetype"
+	AvatarTypeProject   AvatarType = "project"
+	AvatarTypeUser      AvatarType = "user"
+)
+
+// IsValid reports whether t is an avatar type known to Jira.
+func (t AvatarType) IsValid() bool {
+	switch t {
+	case AvatarTypeIssueType, AvatarTypeProject, AvatarTypeUser:
+		return true
+	}
+	return false
+}
+
+// Avatars is a list of system and custom avatars.
+type Avatars struct {
+	System []Avatar `json:"system,omitempty" structs:"system,omitempty"`
+	Custom []Avatar `json:"custom,omitempty" structs:"custom,omitempty"`
+}
+
+// AvatarUploadOptions specifies the optional parameters for AvatarService.Upload.
+// The image is cropped to the square at X and Y with the length Size.
+// By default, the cropping suggested by Jira is used.
+type AvatarUploadOptions struct {
+	X    int
+	Y    int
+	Size int
+}
+
+// GetSystemAvatars returns the system avatars of an avatar type.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/avatar-getAllSystemAvatars
+func (s *AvatarService) GetSystemAvatars(ctx context.Context, avatarType AvatarType) ([]Avatar, *Response, error) {
+	if !avatarType.IsValid() {
+		return nil, nil, fmt.Errorf("jira: invalid avatar type %q", avatarType)
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/2/avatar/%s/system", avatarType)
+	avatars := new(Avatars)
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, avatars)
+	if err != nil {
+		return nil, resp, err
+	}
+	return avatars.System, resp, nil
+}
+
+// GetOwnerAvatars returns the system and custom avatars available for an entity.
+// ownerID is the ID of the project or issue type, or the name of the user.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/universal_avatar-getAvatars
+func (s *AvatarService) GetOwnerAvatars(ctx context.Context, avatarType AvatarType, ownerID string) (*Avatars, *Response, error) {
+	if !avatarType.IsValid() {
+		return nil, nil, fmt.Errorf("jira: invalid avatar type %q", avatarType)
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/2/universal_avatar/type/%s/owner/%s", avatarType, url.PathEscape(ownerID))
+	avatars := new(Avatars)
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, avatars)
+	if err != nil {
+		return nil, resp, err
+	}
+	return avatars, resp, nil
+}
+
+// Upload uploads image as custom avatar of an entity and returns the created avatar.
+// ownerID is the ID of the project or issue type, or the name of the user.
+// filename is used to determine the format of the image and size is its length in bytes.
+// Jira first stores a temporary avatar, which is then cropped as given by options.
+// The avatar is not selected automatically, see SetProjectAvatar, SetIssueTypeAvatar and SetUserAvatar.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/universal_avatar-storeTemporaryAvatar
+func (s *AvatarService) Upload(ctx context.Context, avatarType AvatarType, ownerID, filename string, size int64, image io.Reader, options *AvatarUploadOptions) (*Avatar, *Response, error) {
+	if !avatarType.IsValid() {
+		return nil, nil, fmt.Errorf("jira: invalid avatar type %q", avatarType)
+	}
+
+	ownerEndpoint := fmt.Sprintf("rest/api/2/universal_avatar/type/%s/owner/%s", avatarType, url.PathEscape(ownerID))
+	apiEndpoint := fmt.Sprintf("%s/temp?filename=%s&size=%d", ownerEndpoint, url.QueryEscape(filename), size)
+	req, err := s.client.NewRawRequest(ctx, http.MethodPost, apiEndpoint, image)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("X-Atlassian-Token", "nocheck")
+
+	cropping := new(AvatarCropping)
+	resp, err := s.client.Do(req, cropping)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	if options != nil {
+		cropping.CropperOffsetX, cropping.CropperOffsetY, cropping.CropperWidth = options.X, options.Y, options.Size
+		cropping.NeedsCropping = true
+	}
+
+	avatar := new(Avatar)
+	resp, err = s.do(ctx, http.MethodPost, ownerEndpoint+"/avatar", cropping, avatar)
+	if err != nil {
+		return nil, resp, err
+	}
+	return avatar, resp, nil
+}
+
+// Delete deletes the custom avatar avatarID of an entity.
+// ownerID is the ID of the project or issue type, or the name of the user. System avatars can't be deleted.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/universal_avatar-deleteAvatar
+func (s *AvatarService) Delete(ctx context.Context, avatarType AvatarType, ownerID, avatarID string) (*Response, error) {
+	if !avatarType.IsValid() {
+		return nil, fmt.Errorf("jira: invalid avatar type %q", avatarType)
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/2/universal_avatar/type/%s/owner/%s/avatar/%s", avatarType, url.PathEscape(ownerID), url.PathEscape(avatarID))
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// SetProjectAvatar selects the system or custom avatar avatarID as avatar of the project projectIDOrKey.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-updateProjectAvatar
+func (s *AvatarService) SetProjectAvatar(ctx context.Context, projectIDOrKey, avatarID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/avatar", url.PathEscape(projectIDOrKey))
+	body := struct {
+		ID string `json:"id"`
+	}{avatarID}
+	return s.do(ctx, http.MethodPut, apiEndpoint, &body, nil)
+}
+
+// SetIssueTypeAvatar selects the system or custom avatar avatarID as avatar of the issue type issueTypeID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-updateIssueType
+func (s *AvatarService) SetIssueTypeAvatar(ctx context.Context, issueTypeID, avatarID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s", url.PathEscape(issueTypeID))
+	body := struct {
+		AvatarID string `json:"avatarId"`
+	}{avatarID}
+	return s.do(ctx, http.MethodPut, apiEndpoint, &body, nil)
+}
+
+// SetUserAvatar selects the system or custom avatar avatarID as avatar of the user username.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user-updateUserAvatar
+func (s *AvatarService) SetUserAvatar(ctx context.Context, username, avatarID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/user/avatar?username=%s", url.QueryEscape(username))
+	body := struct {
+		ID string `json:"id"`
+	}{avatarID}
+	return s.do(ctx, http.MethodPut, apiEndpoint, &body, nil)
+}
+
+// do sends a request with the optional body to apiEndpoint and decodes the response into v, if not nil.
+func (s *AvatarService) do(ctx context.Context, method, apiEndpoint string, body, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,146 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAvatarService_GetSystemAvatars(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/avatar/project/system"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"system":[{"id":"10011","isSystemAvatar":true,"isSelected":false,"isDeletable":false,"urls":{"16x16":"https://jira.example.com/secure/projectavatar?size=xsmall&avatarId=10011"}}]}`)
+	})
+
+	avatars, _, err := testClient.Avatar.GetSystemAvatars(context.Background(), AvatarTypeProject)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(avatars) != 1 || avatars[0].ID != "10011" || avatars[0].URLs["16x16"] == "" {
+		t.Errorf("Unexpected avatars %+v", avatars)
+	}
+
+	if _, _, err := testClient.Avatar.GetSystemAvatars(context.Background(), "board"); err == nil {
+		t.Error("Expected an error for an invalid avatar type")
+	}
+}
+
+func TestAvatarService_GetOwnerAvatars(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/universal_avatar/type/user/owner/fred"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"system":[{"id":"10122","isSystemAvatar":true}],"custom":[{"id":"10600","owner":"fred","isDeletable":true}]}`)
+	})
+
+	avatars, _, err := testClient.Avatar.GetOwnerAvatars(context.Background(), AvatarTypeUser, "fred")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(avatars.System) != 1 || len(avatars.Custom) != 1 || avatars.Custom[0].Owner != "fred" {
+		t.Errorf("Unexpected avatars %+v", avatars)
+	}
+}
+
+func TestAvatarService_Upload(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/universal_avatar/type/project/owner/10000/temp", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, "/rest/api/2/universal_avatar/type/project/owner/10000/temp?filename=logo.png&size=4")
+		if got := r.Header.Get("X-Atlassian-Token"); got != "nocheck" {
+			t.Errorf("Expected X-Atlassian-Token nocheck, got %q", got)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "\x89PNG" {
+			t.Errorf("Unexpected image %q", body)
+		}
+		fmt.Fprint(w, `{"cropperWidth":48,"cropperOffsetX":0,"cropperOffsetY":0,"url":"https://jira.example.com/secure/temporaryavatar","needsCropping":true}`)
+	})
+	testMux.HandleFunc("/rest/api/2/universal_avatar/type/project/owner/10000/avatar", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		cropping := new(AvatarCropping)
+		if err := json.NewDecoder(r.Body).Decode(cropping); err != nil {
+			t.Errorf("Error given: %s", err)
+			return
+		}
+		if cropping.CropperWidth != 32 || cropping.CropperOffsetX != 8 || cropping.CropperOffsetY != 16 {
+			t.Errorf("Unexpected cropping %+v", cropping)
+		}
+		fmt.Fprint(w, `{"id":"10600","owner":"10000","isSystemAvatar":false,"isSelected":false,"isDeletable":true}`)
+	})
+
+	avatar, _, err := testClient.Avatar.Upload(context.Background(), AvatarTypeProject, "10000", "logo.png", 4, strings.NewReader("\x89PNG"), &AvatarUploadOptions{X: 8, Y: 16, Size: 32})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if avatar.ID != "10600" {
+		t.Errorf("Expected avatar 10600. Got %+v", avatar)
+	}
+}
+
+func TestAvatarService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/universal_avatar/type/issuetype/owner/10001/avatar/10600"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Avatar.Delete(context.Background(), AvatarTypeIssueType, "10001", "10600"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestAvatarService_SetAvatar(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, tc := range []struct {
+		endpoint, want string
+		set            func() (*Response, error)
+	}{
+		{"/rest/api/2/project/EX/avatar", `{"id":"10600"}`, func() (*Response, error) {
+			return testClient.Avatar.SetProjectAvatar(context.Background(), "EX", "10600")
+		}},
+		{"/rest/api/2/issuetype/10001", `{"avatarId":"10600"}`, func() (*Response, error) {
+			return testClient.Avatar.SetIssueTypeAvatar(context.Background(), "10001", "10600")
+		}},
+		{"/rest/api/2/user/avatar", `{"id":"10600"}`, func() (*Response, error) {
+			return testClient.Avatar.SetUserAvatar(context.Background(), "fred", "10600")
+		}},
+	} {
+		tc := tc
+		testMux.HandleFunc(tc.endpoint, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPut)
+			if tc.endpoint == "/rest/api/2/user/avatar" && r.URL.Query().Get("username") != "fred" {
+				t.Errorf("Expected username fred, got %q", r.URL.Query().Get("username"))
+			}
+			body, _ := io.ReadAll(r.Body)
+			if got := string(body); got != tc.want+"\n" {
+				t.Errorf("Expected body %s, got %s", tc.want, got)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		if _, err := tc.set(); err != nil {
+			t.Errorf("%s: Error given: %s", tc.endpoint, err)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -12,6 +13,51 @@ import (
 // Jira API docs: https://docs.atlassian.com/jira-software/REST/server/
 type BoardService service
 
+// BoardType represents the type of an agile board.
+type BoardType string
+
+const (
+	BoardTypeScrum  BoardType = "scrum"
+	BoardTypeKanban BoardType = "kanban"
+	BoardTypeSimple BoardType = "simple"
+)
+
+// IsValid reports whether t is a board type known to Jira.
+func (t BoardType) IsValid() bool {
+	switch t {
+	case BoardTypeScrum, BoardTypeKanban, BoardTypeSimple:
+		return true
+	}
+	return false
+}
+
+// SprintState represents the state of a sprint.
+type SprintState string
+
+const (
+	SprintStateFuture SprintState = "future"
+	SprintStateActive SprintState = "active"
+	SprintStateClosed SprintState = "closed"
+)
+
+// IsValid reports whether s is a sprint state known to Jira.
+func (s SprintState) IsValid() bool {
+	switch s {
+	case SprintStateFuture, SprintStateActive, SprintStateClosed:
+		return true
+	}
+	return false
+}
+
+// SprintStates joins states to the comma-separated list expected by GetAllSprintsOptions.State.
+func SprintStates(states ...SprintState) string {
+	values := make([]string, 0, len(states))
+	for _, state := range states {
+		values = append(values, string(state))
+	}
+	return strings.Join(values, ",")
+}
+
 // BoardsList reflects a list of agile boards
 type BoardsList struct {
 	MaxResults int     `json:"maxResults" structs:"maxResults"`
@@ -23,18 +69,18 @@ type BoardsList struct {
 
 // Board represents a Jira agile board
 type Board struct {
-	ID       int    `json:"id,omitempty" structs:"id,omitempty"`
-	Self     string `json:"self,omitempty" structs:"self,omitempty"`
-	Name     string `json:"name,omitempty" structs:"name,omitemtpy"`
-	Type     string `json:"type,omitempty" structs:"type,omitempty"`
-	FilterID int    `json:"filterId,omitempty" structs:"filterId,omitempty"`
+	ID       int       `json:"id,omitempty" structs:"id,omitempty"`
+	Self     string    `json:"self,omitempty" structs:"self,omitempty"`
+	Name     string    `json:"name,omitempty" structs:"name,omitemtpy"`
+	Type     BoardType `json:"type,omitempty" structs:"type,omitempty"`
+	FilterID int       `json:"filterId,omitempty" structs:"filterId,omitempty"`
 }
 
 // BoardListOptions specifies the optional parameters to the BoardService.GetList
 type BoardListOptions struct {
 	// BoardType filters results to boards of the specified type.
-	// Valid values: scrum, kanban.
-	BoardType string `url:"type,omitempty"`
+	// Valid values: BoardTypeScrum, BoardTypeKanban, BoardTypeSimple.
+	BoardType BoardType `url:"type,omitempty"`
 	// Name filters results to boards that match or partially match the specified name.
 	Name string `url:"name,omitempty"`
 	// ProjectKeyOrID filters results to boards that are relevant to a project.
@@ -44,9 +90,30 @@ type BoardListOptions struct {
 	SearchOptions
 }
 
+// BoardCreatePayload is the request body of BoardService.CreateBoard.
+type BoardCreatePayload struct {
+	// Name must be less than 255 characters.
+	Name string `json:"name" structs:"name"`
+	// Type is BoardTypeScrum or BoardTypeKanban.
+	Type BoardType `json:"type" structs:"type"`
+	// FilterID is the ID of a filter that the user has permissions to view.
+	FilterID int `json:"filterId" structs:"filterId"`
+	// Location is the container of the board. If nil, the board is located in the user's profile.
+	Location *BoardCreateLocation `json:"location,omitempty" structs:"location,omitempty"`
+}
+
+// BoardCreateLocation is the container a board is created in.
+type BoardCreateLocation struct {
+	// Type is "project" or "user".
+	Type string `json:"type" structs:"type"`
+	// ProjectKeyOrID is required if Type is "project".
+	ProjectKeyOrID string `json:"projectKeyOrId,omitempty" structs:"projectKeyOrId,omitempty"`
+}
+
 // GetAllSprintsOptions specifies the optional parameters to the BoardService.GetList
 type GetAllSprintsOptions struct {
-	// State filters results to sprints in the specified states, comma-separate list
+	// State filters results to sprints in the specified states, comma-separate list.
+	// Use SprintStates to build it from SprintState values.
 	State string `url:"state,omitempty"`
 
 	SearchOptions
@@ -63,14 +130,14 @@ type SprintsList struct {
 
 // Sprint represents a sprint on Jira agile board
 type Sprint struct {
-	ID            int        `json:"id" structs:"id"`
-	Name          string     `json:"name" structs:"name"`
-	CompleteDate  *time.Time `json:"completeDate" structs:"completeDate"`
-	EndDate       *time.Time `json:"endDate" structs:"endDate"`
-	StartDate     *time.Time `json:"startDate" structs:"startDate"`
-	OriginBoardID int        `json:"originBoardId" structs:"originBoardId"`
-	Self          string     `json:"self" structs:"self"`
-	State         string     `json:"state" structs:"state"`
+	ID            int         `json:"id" structs:"id"`
+	Name          string      `json:"name" structs:"name"`
+	CompleteDate  *time.Time  `json:"completeDate" structs:"completeDate"`
+	EndDate       *time.Time  `json:"endDate" structs:"endDate"`
+	StartDate     *time.Time  `json:"startDate" structs:"startDate"`
+	OriginBoardID int         `json:"originBoardId" structs:"originBoardId"`
+	Self          string      `json:"self" structs:"self"`
+	State         SprintState `json:"state" structs:"state"`
 }
 
 // BoardConfiguration represents a boardConfiguration of a jira board
@@ -82,6 +149,26 @@ type BoardConfiguration struct {
 	Filter       BoardConfigurationFilter       `json:"filter"`
 	SubQuery     BoardConfigurationSubQuery     `json:"subQuery"`
 	ColumnConfig BoardConfigurationColumnConfig `json:"columnConfig"`
+	Estimation   *BoardEstimation               `json:"estimation,omitempty"`
+	Ranking      *BoardRanking                  `json:"ranking,omitempty"`
+}
+
+// BoardEstimation represents the estimation statistic of a board, like story points or the original estimate.
+type BoardEstimation struct {
+	// Type is "field" if estimation is based on a field and "none" if estimation is disabled.
+	Type  string                `json:"type"`
+	Field *BoardEstimationField `json:"field,omitempty"`
+}
+
+// BoardEstimationField references the field used for estimation.
+type BoardEstimationField struct {
+	FieldID     string `json:"fieldId"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// BoardRanking references the field used to rank the issues of a board.
+type BoardRanking struct {
+	RankCustomFieldID int `json:"rankCustomFieldId"`
 }
 
 // BoardConfigurationFilter reference to the filter used by the given board.
@@ -132,6 +219,10 @@ type BoardConfigurationColumnStatus struct {
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *BoardService) GetAllBoards(ctx context.Context, opt *BoardListOptions) (*BoardsList, *Response, error) {
+	if opt != nil && opt.BoardType != "" && !opt.BoardType.IsValid() {
+		return nil, nil, fmt.Errorf("invalid board type %q", opt.BoardType)
+	}
+
 	apiEndpoint := "rest/agile/1.0/board"
 	url, err := addOptions(apiEndpoint, opt)
 	if err != nil {
@@ -176,18 +267,12 @@ func (s *BoardService) GetBoard(ctx context.Context, boardID int) (*Board, *Resp
 	return board, resp, nil
 }
 
-// CreateBoard creates a new board. Board name, type and filter Id is required.
-// name - Must be less than 255 characters.
-// type - Valid values: scrum, kanban
-// filterId - Id of a filter that the user has permissions to view.
+// CreateBoard creates a new board. Board name, type and filter ID are required.
 // Note, if the user does not have the 'Create shared objects' permission and tries to create a shared board, a private
 // board will be created instead (remember that board sharing depends on the filter sharing).
 //
-// Jira API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-createBoard
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *BoardService) CreateBoard(ctx context.Context, board *Board) (*Board, *Response, error) {
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/latest/#agile/1.0/board-createBoard
+func (s *BoardService) CreateBoard(ctx context.Context, board *BoardCreatePayload) (*Board, *Response, error) {
 	apiEndpoint := "rest/agile/1.0/board"
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, board)
 	if err != nil {
@@ -252,16 +337,13 @@ func (s *BoardService) GetAllSprints(ctx context.Context, boardID int, options *
 	return result, resp, err
 }
 
-// GetBoardConfiguration will return a board configuration for a given board Id
-// Jira API docs:https://developer.atlassian.com/cloud/jira/software/rest/#api-rest-agile-1-0-board-boardId-configuration-get
+// GetBoardConfiguration returns the configuration of the board boardID:
+// its columns with their statuses, the estimation field and the custom field used for ranking.
 //
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/latest/#agile/1.0/board-getConfiguration
 func (s *BoardService) GetBoardConfiguration(ctx context.Context, boardID int) (*BoardConfiguration, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/configuration", boardID)
-
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
-
 	if err != nil {
 		return nil, nil, err
 	}
@@ -269,9 +351,32 @@ func (s *BoardService) GetBoardConfiguration(ctx context.Context, boardID int) (
 	result := new(BoardConfiguration)
 	resp, err := s.client.Do(req, result)
 	if err != nil {
-		err = NewJiraError(resp, err)
+		return nil, resp, NewJiraError(resp, err)
 	}
 
-	return result, resp, err
+	return result, resp, nil
+}
+
+// GetBoardByFilterID returns the boards using the filter filterID.
+// Only the ID, name and self link of the boards are returned.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/latest/#agile/1.0/board/filter-getBoardByFilterId
+func (s *BoardService) GetBoardByFilterID(ctx context.Context, filterID int, options *SearchOptions) (*BoardsList, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/filter/%d", filterID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	boards := new(BoardsList)
+	resp, err := s.client.Do(req, boards)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
 
+	return boards, resp, nil
 }
@@ -3,6 +3,7 @@ package onpremise
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"testing"
@@ -117,14 +118,20 @@ func TestBoardService_CreateBoard(t *testing.T) {
 		testMethod(t, r, http.MethodPost)
 		testRequestURL(t, r, "/rest/agile/1.0/board")
 
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"name":"Test","type":"kanban","filterId":17,"location":{"type":"project","projectKeyOrId":"TEST"}}`+"\n"; got != want {
+			t.Errorf("Request body = %s, want %s", got, want)
+		}
+
 		w.WriteHeader(http.StatusCreated)
 		fmt.Fprint(w, `{"id":17,"self":"https://test.jira.org/rest/agile/1.0/board/17","name":"Test","type":"kanban"}`)
 	})
 
-	b := &Board{
+	b := &BoardCreatePayload{
 		Name:     "Test",
-		Type:     "kanban",
+		Type:     BoardTypeKanban,
 		FilterID: 17,
+		Location: &BoardCreateLocation{Type: "project", ProjectKeyOrID: "TEST"},
 	}
 	issue, _, err := testClient.Board.CreateBoard(context.Background(), b)
 	if issue == nil {
@@ -232,4 +239,50 @@ func TestBoardService_GetBoardConfigoration(t *testing.T) {
 	if inProgressColumn.Max != 0 {
 		t.Errorf("Expected a max of 0 issues in progress. Got %d", inProgressColumn.Max)
 	}
+
+	if boardConfiguration.Ranking == nil || boardConfiguration.Ranking.RankCustomFieldID != 10002 {
+		t.Errorf("Expected rank custom field 10002. Got %+v", boardConfiguration.Ranking)
+	}
+}
+
+func TestBoardService_GetBoardByFilterID(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/agile/1.0/board/filter/10040"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?maxResults=10")
+		fmt.Fprint(w, `{"maxResults":10,"startAt":0,"total":1,"isLast":true,"values":[{"id":84,"self":"https://test.jira.org/rest/agile/1.0/board/84","name":"Scrum Board"}]}`)
+	})
+
+	boards, _, err := testClient.Board.GetBoardByFilterID(context.Background(), 10040, &SearchOptions{MaxResults: 10})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if boards == nil {
+		t.Fatal("Expected boards list. Boards list is nil")
+	}
+	if len(boards.Values) != 1 || boards.Values[0].ID != 84 || boards.Values[0].Name != "Scrum Board" {
+		t.Errorf("Unexpected boards: %+v", boards.Values)
+	}
+}
+
+func TestBoardService_GetAllBoards_InvalidBoardType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, _, err := testClient.Board.GetAllBoards(context.Background(), &BoardListOptions{BoardType: "unknown"})
+	if err == nil {
+		t.Error("Expected an error for an invalid board type. Got none")
+	}
+}
+
+func TestSprintStates(t *testing.T) {
+	if got, want := SprintStates(SprintStateActive, SprintStateFuture), "active,future"; got != want {
+		t.Errorf("SprintStates() = %q, want %q", got, want)
+	}
+	if SprintState("open").IsValid() {
+		t.Error("Expected sprint state open to be invalid")
+	}
 }
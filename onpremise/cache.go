@@ -0,0 +1,145 @@
+package onpremise
+
+import (
+	"bytes"
+	"container/list"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// CachedResponse is a response stored by a Cache.
+type CachedResponse struct {
+	// ETag is the entity tag Jira returned for the response.
+	ETag string
+	// Body is the unparsed response body.
+	Body []byte
+}
+
+// Cache stores responses of GET requests that were returned with an ETag.
+// Implementations have to be safe for concurrent use.
+type Cache interface {
+	Get(key string) (CachedResponse, bool)
+	Set(key string, r CachedResponse)
+}
+
+// WithCache makes the client cache the responses of GET requests carrying an ETag in cache.
+// Following requests to the same URL are sent with an If-None-Match header.
+// If Jira responds with 304 Not Modified, the cached body is decoded instead and Response.FromCache is set.
+// Only responses decoded by Client.Do are cached, streamed responses like attachment downloads are not.
+//
+// The cache key is the request URL. Don't share a cache between clients with different credentials,
+// as the responses may differ per user.
+func WithCache(cache Cache) ClientOption {
+	return func(c *Client) error {
+		if cache == nil {
+			return errors.New("cache must not be nil")
+		}
+		c.cache = cache
+		return nil
+	}
+}
+
+// cacheRequest adds the ETag of a cached response for req as If-None-Match header.
+// It returns the cached response, if any.
+func (c *Client) cacheRequest(req *http.Request) (*http.Request, *CachedResponse) {
+	if c.cache == nil || req.Method != http.MethodGet || req.Header.Get("If-None-Match") != "" {
+		return req, nil
+	}
+	cached, found := c.cache.Get(req.URL.String())
+	if !found {
+		return req, nil
+	}
+
+	// Don't modify the request of the caller
+	req = req.Clone(req.Context())
+	req.Header.Set("If-None-Match", cached.ETag)
+	return req, &cached
+}
+
+// cacheResponse replaces the body of a 304 response with the cached body
+// or stores the body of a successful response carrying an ETag.
+// It reports whether resp was served from the cache.
+func (c *Client) cacheResponse(req *http.Request, resp *http.Response, cached *CachedResponse) (bool, error) {
+	if c.cache == nil || req.Method != http.MethodGet {
+		return false, nil
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		resp.Body.Close()
+		resp.StatusCode = http.StatusOK
+		resp.Status = "200 OK"
+		resp.Body = io.NopCloser(bytes.NewReader(cached.Body))
+		return true, nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || etag == "" {
+		return false, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return false, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	c.cache.Set(req.URL.String(), CachedResponse{ETag: etag, Body: body})
+	return false, nil
+}
+
+// MemoryCache is an in-memory Cache holding a limited number of responses.
+// The least recently used response is evicted first.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List
+	entries    map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	key      string
+	response CachedResponse
+}
+
+// NewMemoryCache returns a MemoryCache holding up to maxEntries responses.
+// A maxEntries of 0 means no limit.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    map[string]*list.Element{},
+	}
+}
+
+// Get returns the response stored for key.
+func (m *MemoryCache) Get(key string) (CachedResponse, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, found := m.entries[key]
+	if !found {
+		return CachedResponse{}, false
+	}
+	m.order.MoveToFront(e)
+	return e.Value.(*memoryCacheEntry).response, true
+}
+
+// Set stores r for key.
+func (m *MemoryCache) Set(key string, r CachedResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, found := m.entries[key]; found {
+		e.Value.(*memoryCacheEntry).response = r
+		m.order.MoveToFront(e)
+		return
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryCacheEntry{key: key, response: r})
+	if m.maxEntries > 0 && m.order.Len() > m.maxEntries {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
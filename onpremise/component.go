@@ -2,7 +2,9 @@ package onpremise
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // ComponentService handles components for the Jira instance / API.//
@@ -41,3 +43,89 @@ func (s *ComponentService) Create(ctx context.Context, options *CreateComponentO
 
 	return component, resp, nil
 }
+
+// Update updates the component componentID. Only the non-empty values of options are changed.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/component-updateComponent
+func (s *ComponentService) Update(ctx context.Context, componentID string, options *CreateComponentOptions) (*ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s", url.PathEscape(componentID))
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	component := new(ProjectComponent)
+	resp, err := s.client.Do(req, component)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return component, resp, nil
+}
+
+// Delete deletes the component componentID.
+// If moveIssuesTo is set, the issues of the component are moved to the component moveIssuesTo,
+// otherwise the component is removed from them.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/component-delete
+func (s *ComponentService) Delete(ctx context.Context, componentID, moveIssuesTo string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s", url.PathEscape(componentID))
+	if moveIssuesTo != "" {
+		apiEndpoint += "?moveIssuesTo=" + url.QueryEscape(moveIssuesTo)
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// ComponentIssueCount is the number of issues of a component.
+type ComponentIssueCount struct {
+	Self       string `json:"self,omitempty" structs:"self,omitempty"`
+	IssueCount int    `json:"issueCount" structs:"issueCount"`
+}
+
+// GetRelatedIssueCount returns the number of issues of the component componentID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/component-getComponentRelatedIssues
+func (s *ComponentService) GetRelatedIssueCount(ctx context.Context, componentID string) (*ComponentIssueCount, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s/relatedIssueCounts", url.PathEscape(componentID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	count := new(ComponentIssueCount)
+	resp, err := s.client.Do(req, count)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return count, resp, nil
+}
+
+// GetProjectComponents returns all components of the project projectIDOrKey.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-getProjectComponents
+func (s *ComponentService) GetProjectComponents(ctx context.Context, projectIDOrKey string) ([]ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/components", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	components := []ProjectComponent{}
+	resp, err := s.client.Do(req, &components)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return components, resp, nil
+}
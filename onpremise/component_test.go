@@ -3,6 +3,7 @@ package onpremise
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 )
@@ -28,3 +29,82 @@ func TestComponentService_Create_Success(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestComponentService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/component/10000"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint)
+		if b, _ := io.ReadAll(r.Body); string(b) != `{"name":"Backend","description":"Server side"}`+"\n" {
+			t.Errorf("Unexpected request body %s", b)
+		}
+		fmt.Fprint(w, `{"id":"10000","name":"Backend","description":"Server side","project":"EX","projectId":10000}`)
+	})
+
+	component, _, err := testClient.Component.Update(context.Background(), "10000", &CreateComponentOptions{Name: "Backend", Description: "Server side"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if component.Name != "Backend" {
+		t.Errorf("Expected component Backend. Got %+v", component)
+	}
+}
+
+func TestComponentService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/component/10000"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testapiEndpoint+"?moveIssuesTo=10001")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Component.Delete(context.Background(), "10000", "10001"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestComponentService_GetRelatedIssueCount(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/component/10000/relatedIssueCounts"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"self":"https://jira.example.com/rest/api/2/component/10000","issueCount":23}`)
+	})
+
+	count, _, err := testClient.Component.GetRelatedIssueCount(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if count.IssueCount != 23 {
+		t.Errorf("Expected 23 issues. Got %+v", count)
+	}
+}
+
+func TestComponentService_GetProjectComponents(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/project/EX/components"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `[{"id":"10000","name":"Backend","project":"EX","projectId":10000},{"id":"10001","name":"Legacy","project":"EX","projectId":10000}]`)
+	})
+
+	components, _, err := testClient.Component.GetProjectComponents(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(components) != 2 || components[1].Name != "Legacy" {
+		t.Errorf("Unexpected components %+v", components)
+	}
+}
@@ -0,0 +1,127 @@
+package onpremise
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultRateLimitPause is the pause after a 429 response without Retry-After or X-RateLimit-Reset header.
+const defaultRateLimitPause = time.Second
+
+// adaptiveConcurrency limits the number of requests in flight.
+// The window is halved for every rate limited (429) response and grows by one
+// for every window of successful responses (additive increase, multiplicative decrease).
+type adaptiveConcurrency struct {
+	mu sync.Mutex
+	// max is the upper bound of the window.
+	max int
+	// window is the current number of requests allowed in flight.
+	window float64
+	// inFlight is the number of requests currently sent.
+	inFlight int
+	// pausedUntil holds back all requests after a rate limited response.
+	pausedUntil time.Time
+	// released is closed and replaced whenever a request finished or the window changed.
+	released chan struct{}
+}
+
+// WithAdaptiveConcurrency limits the number of concurrent requests of the client to max.
+// If Jira rejects a request because of rate limiting (429), all requests are paused
+// until the time reported by the Retry-After or X-RateLimit-Reset header and the limit is halved.
+// With every successful response the limit recovers gradually up to max again.
+//
+// This is meant for long running processes, which share a client between many goroutines.
+// Combine it with WithRetry to retry the rejected requests.
+func WithAdaptiveConcurrency(max int) ClientOption {
+	return func(c *Client) error {
+		if max < 1 {
+			return errors.New("max concurrency must be at least 1")
+		}
+		c.concurrency = &adaptiveConcurrency{
+			max:      max,
+			window:   float64(max),
+			released: make(chan struct{}),
+		}
+		return nil
+	}
+}
+
+// acquire blocks until a request may be sent or ctx is done.
+// Every successful call has to be followed by a call of release.
+func (a *adaptiveConcurrency) acquire(ctx context.Context) error {
+	if a == nil {
+		return nil
+	}
+
+	for {
+		a.mu.Lock()
+		pause := time.Until(a.pausedUntil)
+		if pause <= 0 && a.inFlight < int(a.window) {
+			a.inFlight++
+			a.mu.Unlock()
+			return nil
+		}
+		released := a.released
+		a.mu.Unlock()
+
+		if pause > 0 {
+			if err := sleep(ctx, pause); err != nil {
+				return err
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+// release marks a request as finished and adapts the window to resp.
+func (a *adaptiveConcurrency) release(resp *http.Response) {
+	if a == nil {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.inFlight--
+	switch {
+	case resp == nil:
+		// Network errors say nothing about the rate limit
+	case resp.StatusCode == http.StatusTooManyRequests:
+		a.window /= 2
+		if a.window < 1 {
+			a.window = 1
+		}
+		pause, ok := retryAfter(resp.Header)
+		if !ok {
+			pause = defaultRateLimitPause
+		}
+		if until := time.Now().Add(pause); until.After(a.pausedUntil) {
+			a.pausedUntil = until
+		}
+	case resp.Header.Get("X-RateLimit-NearLimit") == "true":
+		// Jira asks to slow down, so don't grow any further
+	case resp.StatusCode < http.StatusInternalServerError:
+		a.window += 1 / a.window
+		if a.window > float64(a.max) {
+			a.window = float64(a.max)
+		}
+	}
+
+	close(a.released)
+	a.released = make(chan struct{})
+}
+
+// limit returns the number of requests currently allowed in flight.
+func (a *adaptiveConcurrency) limit() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return int(a.window)
+}
@@ -0,0 +1,87 @@
+package onpremise
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAdaptiveConcurrency_ShrinksAndRecovers(t *testing.T) {
+	c, _ := NewClient("https://jira.example.com/", nil, WithAdaptiveConcurrency(8))
+	a := c.concurrency
+
+	limited := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"0"}}}
+	for i := 0; i < 2; i++ {
+		if err := a.acquire(context.Background()); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		a.release(limited)
+	}
+	if got := a.limit(); got != 2 {
+		t.Errorf("Expected the limit to be halved twice to 2. Got %d", got)
+	}
+
+	ok := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	for i := 0; i < 100; i++ {
+		if err := a.acquire(context.Background()); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		a.release(ok)
+	}
+	if got := a.limit(); got != 8 {
+		t.Errorf("Expected the limit to recover to 8. Got %d", got)
+	}
+}
+
+func TestAdaptiveConcurrency_PausesAfterRateLimit(t *testing.T) {
+	c, _ := NewClient("https://jira.example.com/", nil, WithAdaptiveConcurrency(1))
+	a := c.concurrency
+
+	_ = a.acquire(context.Background())
+	a.release(&http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"10"}}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := a.acquire(ctx); err == nil {
+		t.Error("Expected the request to be held back until the rate limit resets")
+	}
+}
+
+func TestClient_Do_AdaptiveConcurrency(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var inFlight, maxInFlight int32
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	})
+
+	client, _ := NewClient(testServer.URL, nil, WithAdaptiveConcurrency(2))
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := client.NewRequest(context.Background(), http.MethodGet, "/", nil)
+			if _, err := client.Do(req, nil); err != nil {
+				t.Errorf("Error given: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&maxInFlight); got > 2 {
+		t.Errorf("Expected at most 2 concurrent requests. Got %d", got)
+	}
+}
@@ -0,0 +1,263 @@
+package onpremise
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/trivago/tgo/tcontainer"
+)
+
+// CustomFieldValue is the value of a custom field of an issue, as returned by IssueFields.CustomField.
+// The As methods convert the raw JSON value into the type of the field.
+type CustomFieldValue struct {
+	// ID is the ID of the field, like "customfield_10010".
+	ID    string
+	value interface{}
+	found bool
+}
+
+// CustomField returns the value of the custom field id, like "customfield_10010".
+func (i *IssueFields) CustomField(id string) CustomFieldValue {
+	v := CustomFieldValue{ID: id}
+	if i != nil && i.Unknowns != nil {
+		v.value, v.found = i.Unknowns[id]
+	}
+	return v
+}
+
+// SetCustomField sets the custom field id to value for a create or update payload.
+// value is encoded as JSON, so it has to have the shape Jira expects for the field type.
+// The SetCustomField* helpers build the common shapes.
+func (i *IssueFields) SetCustomField(id string, value interface{}) {
+	if i.Unknowns == nil {
+		i.Unknowns = tcontainer.NewMarshalMap()
+	}
+	i.Unknowns[id] = value
+}
+
+// SetCustomFieldOption sets the single select custom field id to the option value.
+func (i *IssueFields) SetCustomFieldOption(id, value string) {
+	i.SetCustomField(id, Option{Value: value})
+}
+
+// SetCustomFieldOptions sets the multi select or checkbox custom field id to the option values.
+func (i *IssueFields) SetCustomFieldOptions(id string, values ...string) {
+	options := make([]Option, 0, len(values))
+	for _, value := range values {
+		options = append(options, Option{Value: value})
+	}
+	i.SetCustomField(id, options)
+}
+
+// SetCustomFieldUser sets the user picker custom field id to user.
+// Only the identifying attributes of user are sent.
+func (i *IssueFields) SetCustomFieldUser(id string, user User) {
+	ref := map[string]string{}
+	for key, value := range map[string]string{"accountId": user.AccountID, "name": user.Name, "key": user.Key} {
+		if value != "" {
+			ref[key] = value
+		}
+	}
+	i.SetCustomField(id, ref)
+}
+
+// SetCustomFieldSprint sets the sprint custom field id to the sprint sprintID.
+func (i *IssueFields) SetCustomFieldSprint(id string, sprintID int) {
+	i.SetCustomField(id, sprintID)
+}
+
+// IsSet reports whether the issue has a non-null value for the field.
+func (v CustomFieldValue) IsSet() bool {
+	return v.found && v.value != nil
+}
+
+// Raw returns the value as decoded by encoding/json, like a string, float64, map or slice.
+func (v CustomFieldValue) Raw() interface{} {
+	return v.value
+}
+
+// Decode converts the value into out, which has to be a pointer.
+// A missing or null value leaves out untouched.
+func (v CustomFieldValue) Decode(out interface{}) error {
+	if !v.IsSet() {
+		return nil
+	}
+	data, err := json.Marshal(v.value)
+	if err != nil {
+		return fmt.Errorf("custom field %s: %w", v.ID, err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("custom field %s: %w", v.ID, err)
+	}
+	return nil
+}
+
+// AsString returns the value of a text, URL or single select field.
+// Single select values are returned by their option value.
+// A missing or null value returns an empty string.
+func (v CustomFieldValue) AsString() (string, error) {
+	switch value := v.value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	case map[string]interface{}:
+		if s, ok := value["value"].(string); ok {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("custom field %s: can not convert %T to string", v.ID, v.value)
+}
+
+// AsStrings returns the values of a labels field or the option values of a multi select field.
+func (v CustomFieldValue) AsStrings() ([]string, error) {
+	values, ok := v.value.([]interface{})
+	if !ok {
+		if v.value == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("custom field %s: can not convert %T to []string", v.ID, v.value)
+	}
+
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		s, err := CustomFieldValue{ID: v.ID, value: value, found: true}.AsString()
+		if err != nil {
+			return nil, err
+		}
+		strs = append(strs, s)
+	}
+	return strs, nil
+}
+
+// AsFloat returns the value of a number field.
+// A missing or null value returns 0.
+func (v CustomFieldValue) AsFloat() (float64, error) {
+	switch value := v.value.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return value, nil
+	case string:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, fmt.Errorf("custom field %s: %w", v.ID, err)
+		}
+		return f, nil
+	}
+	return 0, fmt.Errorf("custom field %s: can not convert %T to float64", v.ID, v.value)
+}
+
+// AsUser returns the value of a single user picker field.
+// A missing or null value returns nil.
+func (v CustomFieldValue) AsUser() (*User, error) {
+	if !v.IsSet() {
+		return nil, nil
+	}
+	user := new(User)
+	if err := v.Decode(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// AsUsers returns the value of a multi user picker field.
+func (v CustomFieldValue) AsUsers() ([]User, error) {
+	var users []User
+	err := v.Decode(&users)
+	return users, err
+}
+
+// AsOption returns the value of a single select or radio button field.
+// A missing or null value returns nil.
+func (v CustomFieldValue) AsOption() (*CustomFieldOption, error) {
+	if !v.IsSet() {
+		return nil, nil
+	}
+	option := new(CustomFieldOption)
+	if err := v.Decode(option); err != nil {
+		return nil, err
+	}
+	return option, nil
+}
+
+// AsOptions returns the value of a multi select or checkbox field.
+func (v CustomFieldValue) AsOptions() ([]CustomFieldOption, error) {
+	var options []CustomFieldOption
+	err := v.Decode(&options)
+	return options, err
+}
+
+// AsSprints returns the value of the sprint field.
+// Besides sprint objects, the legacy string representation of older Jira versions
+// ("com.atlassian.greenhopper.service.sprint.Sprint@1[id=1,rapidViewId=2,state=ACTIVE,name=Sprint 1,...]") is understood.
+// Only ID, state and name are available from the legacy representation.
+func (v CustomFieldValue) AsSprints() ([]Sprint, error) {
+	values, ok := v.value.([]interface{})
+	if !ok {
+		if v.value == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("custom field %s: can not convert %T to []Sprint", v.ID, v.value)
+	}
+
+	sprints := make([]Sprint, 0, len(values))
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			sprint, err := parseLegacySprint(s)
+			if err != nil {
+				return nil, fmt.Errorf("custom field %s: %w", v.ID, err)
+			}
+			sprints = append(sprints, sprint)
+			continue
+		}
+
+		var sprint Sprint
+		if err := (CustomFieldValue{ID: v.ID, value: value, found: true}).Decode(&sprint); err != nil {
+			return nil, err
+		}
+		sprints = append(sprints, sprint)
+	}
+	return sprints, nil
+}
+
+// CustomFieldOption is a selected option of a select, radio button or checkbox field.
+type CustomFieldOption struct {
+	Self  string `json:"self,omitempty" structs:"self,omitempty"`
+	ID    string `json:"id,omitempty" structs:"id,omitempty"`
+	Value string `json:"value" structs:"value"`
+	// Child is the selected child option of a cascading select field.
+	Child *CustomFieldOption `json:"child,omitempty" structs:"child,omitempty"`
+}
+
+// legacySprintKeyRegex matches the start of an attribute in the legacy sprint representation, like ",name=".
+var legacySprintKeyRegex = regexp.MustCompile(`(?:^|,)([a-zA-Z]+)=`)
+
+// parseLegacySprint parses the legacy string representation of a sprint.
+func parseLegacySprint(s string) (Sprint, error) {
+	start, end := strings.Index(s, "["), strings.LastIndex(s, "]")
+	if start < 0 || end < start {
+		return Sprint{}, fmt.Errorf("invalid sprint %q", s)
+	}
+	attrs := s[start+1 : end]
+
+	// Values may contain commas, so they reach until the next attribute
+	values := map[string]string{}
+	keys := legacySprintKeyRegex.FindAllStringSubmatchIndex(attrs, -1)
+	for i, k := range keys {
+		valueEnd := len(attrs)
+		if i+1 < len(keys) {
+			valueEnd = keys[i+1][0]
+		}
+		values[attrs[k[2]:k[3]]] = attrs[k[1]:valueEnd]
+	}
+
+	id, err := strconv.Atoi(values["id"])
+	if err != nil {
+		return Sprint{}, fmt.Errorf("invalid sprint %q", s)
+	}
+	return Sprint{ID: id, Name: values["name"], State: SprintState(strings.ToLower(values["state"]))}, nil
+}
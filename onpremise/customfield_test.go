@@ -0,0 +1,90 @@
+package onpremise
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const testCustomFieldsJSON = `{
+	"summary": "Custom fields",
+	"customfield_10001": "Some text",
+	"customfield_10002": {"self": "https://example.atlassian.net/rest/api/2/customFieldOption/1", "id": "1", "value": "High"},
+	"customfield_10003": [{"id": "2", "value": "Red"}, {"id": "3", "value": "Blue"}],
+	"customfield_10004": {"accountId": "5b10a2844c20165700ede21g", "displayName": "Mia Krystof"},
+	"customfield_10005": [{"id": 1, "name": "Sprint 1", "state": "closed"}],
+	"customfield_10006": ["com.atlassian.greenhopper.service.sprint.Sprint@1b3[id=2,rapidViewId=3,state=ACTIVE,name=Sprint 2, the second,startDate=2015-04-11T15:22:00.000+10:00,sequence=2]"],
+	"customfield_10007": 13.5,
+	"customfield_10008": null,
+	"customfield_10009": ["backend", "api"]
+}`
+
+func TestIssueFields_CustomField(t *testing.T) {
+	fields := new(IssueFields)
+	if err := json.Unmarshal([]byte(testCustomFieldsJSON), fields); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if s, err := fields.CustomField("customfield_10001").AsString(); err != nil || s != "Some text" {
+		t.Errorf("AsString() = %q, %v", s, err)
+	}
+	if s, err := fields.CustomField("customfield_10002").AsString(); err != nil || s != "High" {
+		t.Errorf("AsString() of an option = %q, %v", s, err)
+	}
+	if o, err := fields.CustomField("customfield_10002").AsOption(); err != nil || o.ID != "1" || o.Value != "High" {
+		t.Errorf("AsOption() = %+v, %v", o, err)
+	}
+	if o, err := fields.CustomField("customfield_10003").AsOptions(); err != nil || len(o) != 2 || o[1].Value != "Blue" {
+		t.Errorf("AsOptions() = %+v, %v", o, err)
+	}
+	if u, err := fields.CustomField("customfield_10004").AsUser(); err != nil || u.DisplayName != "Mia Krystof" {
+		t.Errorf("AsUser() = %+v, %v", u, err)
+	}
+	if s, err := fields.CustomField("customfield_10005").AsSprints(); err != nil || len(s) != 1 || s[0].Name != "Sprint 1" || s[0].State != SprintStateClosed {
+		t.Errorf("AsSprints() = %+v, %v", s, err)
+	}
+	if s, err := fields.CustomField("customfield_10006").AsSprints(); err != nil || len(s) != 1 || s[0].ID != 2 || s[0].Name != "Sprint 2, the second" || s[0].State != SprintStateActive {
+		t.Errorf("AsSprints() of the legacy representation = %+v, %v", s, err)
+	}
+	if f, err := fields.CustomField("customfield_10007").AsFloat(); err != nil || f != 13.5 {
+		t.Errorf("AsFloat() = %v, %v", f, err)
+	}
+	if s, err := fields.CustomField("customfield_10009").AsStrings(); err != nil || strings.Join(s, ",") != "backend,api" {
+		t.Errorf("AsStrings() = %v, %v", s, err)
+	}
+	if fields.CustomField("customfield_10008").IsSet() || fields.CustomField("customfield_99999").IsSet() {
+		t.Error("Expected null and missing fields to be unset")
+	}
+	if u, err := fields.CustomField("customfield_10008").AsUser(); err != nil || u != nil {
+		t.Errorf("AsUser() of null = %+v, %v", u, err)
+	}
+	if _, err := fields.CustomField("customfield_10003").AsString(); err == nil {
+		t.Error("Expected an error converting a list to a string")
+	}
+}
+
+func TestIssueFields_SetCustomField(t *testing.T) {
+	fields := &IssueFields{Summary: "Payload"}
+	fields.SetCustomFieldOption("customfield_10002", "High")
+	fields.SetCustomFieldOptions("customfield_10003", "Red", "Blue")
+	fields.SetCustomFieldUser("customfield_10004", User{AccountID: "5b10a2844c20165700ede21g", DisplayName: "Mia Krystof"})
+	fields.SetCustomFieldSprint("customfield_10005", 7)
+	fields.SetCustomField("customfield_10007", 13.5)
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	payload := string(data)
+	for _, want := range []string{
+		`"customfield_10002":{"value":"High"}`,
+		`"customfield_10003":[{"value":"Red"},{"value":"Blue"}]`,
+		`"customfield_10004":{"accountId":"5b10a2844c20165700ede21g"}`,
+		`"customfield_10005":7`,
+		`"customfield_10007":13.5`,
+	} {
+		if !strings.Contains(payload, want) {
+			t.Errorf("Expected %s in payload %s", want, payload)
+		}
+	}
+}
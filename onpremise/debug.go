@@ -0,0 +1,146 @@
+package onpremise
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"sync"
+)
+
+// maxDumpBody is the maximum number of bytes of a body written by the debug dump.
+const maxDumpBody = 64 << 10
+
+// redacted replaces secrets in debug dumps.
+const redacted = "REDACTED"
+
+var (
+	// sensitiveHeaders carry credentials and are masked in debug dumps.
+	sensitiveHeaders = map[string]bool{
+		"Authorization":       true,
+		"Proxy-Authorization": true,
+		"Cookie":              true,
+		"Set-Cookie":          true,
+	}
+	// sensitiveFieldRegex matches JSON fields carrying credentials, like the password of a session login.
+	sensitiveFieldRegex = regexp.MustCompile(`(?i)("(?:password|token|apiToken|accessToken|access_token|refreshToken|refresh_token|client_secret|secret)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+)
+
+// debugDumper writes requests and responses to a writer.
+type debugDumper struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// WithDebugDump makes the client write every request and response to w, for debugging.
+// Credentials are masked: the Authorization and cookie headers, the jwt query parameter,
+// user info of the URL and password or token fields of JSON bodies.
+// Bodies are cut after 64 KiB.
+//
+// Transports like BasicAuthTransport add their headers after the dump was written,
+// so their credentials never show up in the dump.
+func WithDebugDump(w io.Writer) ClientOption {
+	return func(c *Client) error {
+		if w == nil {
+			return errors.New("debug writer must not be nil")
+		}
+		c.debug = &debugDumper{w: w}
+		return nil
+	}
+}
+
+// dumpRequest writes req.
+// The body of req stays untouched, it is only dumped if it can be obtained via GetBody.
+func (d *debugDumper) dumpRequest(req *http.Request) {
+	if d == nil {
+		return
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "> %s %s\n", req.Method, redactURL(req.URL))
+	writeHeader(&msg, "> ", req.Header)
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			prefix, _ := io.ReadAll(io.LimitReader(body, maxDumpBody+1))
+			body.Close()
+			writeBody(&msg, prefix)
+		}
+	}
+	d.write(msg.Bytes())
+}
+
+// dumpResponse writes resp or err.
+// The body of resp stays readable for the caller.
+func (d *debugDumper) dumpResponse(resp *http.Response, err error) {
+	if d == nil {
+		return
+	}
+
+	var msg bytes.Buffer
+	if err != nil {
+		fmt.Fprintf(&msg, "< error: %v\n\n", err)
+		d.write(msg.Bytes())
+		return
+	}
+
+	fmt.Fprintf(&msg, "< %s %s\n", resp.Proto, resp.Status)
+	writeHeader(&msg, "< ", resp.Header)
+	if resp.Body != nil {
+		prefix, _ := io.ReadAll(io.LimitReader(resp.Body, maxDumpBody+1))
+		resp.Body = &readerCloser{Reader: io.MultiReader(bytes.NewReader(prefix), resp.Body), Closer: resp.Body}
+		writeBody(&msg, prefix)
+	}
+	d.write(msg.Bytes())
+}
+
+func (d *debugDumper) write(p []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, _ = d.w.Write(p)
+}
+
+// readerCloser combines a Reader with the Closer of the original body.
+type readerCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// writeHeader writes h in a stable order with masked credentials.
+func writeHeader(msg *bytes.Buffer, prefix string, h http.Header) {
+	keys := make([]string, 0, len(h))
+	for key := range h {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range h[key] {
+			if sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+				value = redacted
+			}
+			fmt.Fprintf(msg, "%s%s: %s\n", prefix, key, value)
+		}
+	}
+}
+
+// writeBody writes body with masked credentials, cut after maxDumpBody bytes.
+func writeBody(msg *bytes.Buffer, body []byte) {
+	truncated := len(body) > maxDumpBody
+	if truncated {
+		body = body[:maxDumpBody]
+	}
+	if len(body) > 0 {
+		msg.WriteString("\n")
+		msg.Write(sensitiveFieldRegex.ReplaceAll(body, []byte(`${1}"`+redacted+`"`)))
+		if truncated {
+			msg.WriteString("...")
+		}
+		if !bytes.HasSuffix(msg.Bytes(), []byte("\n")) {
+			msg.WriteString("\n")
+		}
+	}
+	msg.WriteString("\n")
+}
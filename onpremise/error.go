@@ -3,17 +3,31 @@ package onpremise
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
 	"strings"
+	"unicode/utf8"
 )
 
 // Error message from Jira
 // See https://docs.atlassian.com/jira/REST/cloud/#error-responses
 type Error struct {
-	HTTPError     error
-	ErrorMessages []string          `json:"errorMessages"`
-	Errors        map[string]string `json:"errors"`
+	HTTPError error
+	// ErrorMessages are the general errors of the request.
+	ErrorMessages []string `json:"errorMessages"`
+	// Errors maps field names to the validation error of the field.
+	Errors map[string]string `json:"errors"`
+	// WarningMessages are reported by some endpoints, like the bulk operations, next to the errors.
+	WarningMessages []string `json:"warningMessages"`
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"-"`
+	// Body is the unparsed response body.
+	Body []byte `json:"-"`
 }
 
 // NewJiraError creates a new jira Error
@@ -27,7 +41,7 @@ func NewJiraError(resp *Response, httpError error) error {
 	if err != nil {
 		return fmt.Errorf("%s: %w", httpError.Error(), err)
 	}
-	jerr := Error{HTTPError: httpError}
+	jerr := Error{HTTPError: httpError, StatusCode: resp.StatusCode, Body: body}
 	contentType := resp.Header.Get("Content-Type")
 	if strings.HasPrefix(contentType, "application/json") {
 		err = json.Unmarshal(body, &jerr)
@@ -38,6 +52,11 @@ func NewJiraError(resp *Response, httpError error) error {
 		if httpError == nil {
 			return fmt.Errorf("got response status %s:%s", resp.Status, string(body))
 		}
+		// A ResponseError already carries an excerpt of the body
+		var respErr *ResponseError
+		if errors.As(httpError, &respErr) {
+			return fmt.Errorf("%s: %w", resp.Status, httpError)
+		}
 		return fmt.Errorf("%s: %s: %w", resp.Status, string(body), httpError)
 	}
 
@@ -51,13 +70,36 @@ func (e *Error) Error() string {
 		return fmt.Sprintf("%s: %v", e.ErrorMessages[0], e.HTTPError)
 	}
 	if len(e.Errors) > 0 {
-		for key, value := range e.Errors {
-			return fmt.Sprintf("%s - %s: %v", key, value, e.HTTPError)
-		}
+		key := e.fieldNames()[0]
+		return fmt.Sprintf("%s - %s: %v", key, e.Errors[key], e.HTTPError)
+	}
+	if e.HTTPError == nil {
+		return fmt.Sprintf("request failed with status code %d", e.StatusCode)
 	}
 	return e.HTTPError.Error()
 }
 
+// FieldError returns the validation error of the field name, like "summary" or "customfield_10000".
+// It returns an empty string if Jira reported no error for the field.
+func (e *Error) FieldError(name string) string {
+	return e.Errors[name]
+}
+
+// fieldNames returns the names of all fields with an error in alphabetical order.
+func (e *Error) fieldNames() []string {
+	names := make([]string, 0, len(e.Errors))
+	for name := range e.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Unwrap returns the underlying HTTP error.
+func (e *Error) Unwrap() error {
+	return e.HTTPError
+}
+
 // LongError is a full representation of the error as a string
 func (e *Error) LongError() string {
 	var msg bytes.Buffer
@@ -75,13 +117,129 @@ func (e *Error) LongError() string {
 		}
 	}
 	if len(e.Errors) > 0 {
-		for key, value := range e.Errors {
+		for _, key := range e.fieldNames() {
 			msg.WriteString(" - ")
 			msg.WriteString(key)
 			msg.WriteString(" - ")
-			msg.WriteString(value)
+			msg.WriteString(e.Errors[key])
+			msg.WriteString("\n")
+		}
+	}
+	if len(e.WarningMessages) > 0 {
+		msg.WriteString("Warnings:\n")
+		for _, v := range e.WarningMessages {
+			msg.WriteString(" - ")
+			msg.WriteString(v)
 			msg.WriteString("\n")
 		}
 	}
 	return msg.String()
 }
+
+// maxBodyExcerpt is the maximum number of bytes of the response body kept in a ResponseError.
+const maxBodyExcerpt = 512
+
+// ResponseError is returned if the Jira API responds with a status code outside the 200 range.
+// It carries enough context to diagnose a failure from a log line.
+// The request URL is only included as template, to avoid leaking issue keys, IDs or query parameters.
+type ResponseError struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// URLTemplate is the path of the request URL with all IDs and keys replaced by "{id}".
+	URLTemplate string
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// RequestID is the ID Jira assigned to the request.
+	RequestID string
+	// BodyExcerpt is the beginning of the response body.
+	BodyExcerpt string
+}
+
+// newResponseError collects the error context of r.
+// The body of r is read, but stays available for further inspection.
+func newResponseError(r *http.Response) *ResponseError {
+	e := &ResponseError{
+		StatusCode: r.StatusCode,
+		RequestID:  requestID(r.Header),
+	}
+	if r.Request != nil {
+		e.Method = r.Request.Method
+		if r.Request.URL != nil {
+			e.URLTemplate = urlTemplate(r.Request.URL)
+		}
+	}
+
+	if r.Body != nil {
+		body, err := io.ReadAll(r.Body)
+		r.Body.Close()
+		// Hand the body back, so that the caller can still analyze it
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if err == nil {
+			e.BodyExcerpt = bodyExcerpt(body)
+		}
+	}
+
+	return e
+}
+
+// Error is a short string representing the error
+func (e *ResponseError) Error() string {
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "request failed. Please analyze the request body for more details. Status code: %d", e.StatusCode)
+	if e.Method != "" || e.URLTemplate != "" {
+		fmt.Fprintf(&msg, ", request: %s %s", e.Method, e.URLTemplate)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&msg, ", request ID: %s", e.RequestID)
+	}
+	if e.BodyExcerpt != "" {
+		fmt.Fprintf(&msg, ", body: %s", e.BodyExcerpt)
+	}
+	return msg.String()
+}
+
+// requestID returns the ID Jira assigned to a request.
+// Jira returns it via the X-AREQUESTID header, Atlassian's edge via Atl-Traceid.
+func requestID(h http.Header) string {
+	if id := h.Get("X-Arequestid"); id != "" {
+		return id
+	}
+	return h.Get("Atl-Traceid")
+}
+
+// bodyExcerpt returns the first maxBodyExcerpt bytes of body.
+func bodyExcerpt(body []byte) string {
+	excerpt := bytes.TrimSpace(body)
+	if len(excerpt) <= maxBodyExcerpt {
+		return string(excerpt)
+	}
+
+	excerpt = excerpt[:maxBodyExcerpt]
+	// Don't cut a multi-byte character in half
+	for len(excerpt) > 0 && !utf8.Valid(excerpt) {
+		excerpt = excerpt[:len(excerpt)-1]
+	}
+	return string(excerpt) + "..."
+}
+
+var (
+	// apiVersionRegex matches the version part of an API path, like "2", "1.0" or "latest".
+	apiVersionRegex = regexp.MustCompile(`^(\d+(\.\d+)*|latest)$`)
+	// staticSegmentRegex matches path segments that are part of the API itself, like "issue" or "issueLinkType".
+	staticSegmentRegex = regexp.MustCompile(`^[a-z][a-zA-Z]*$`)
+)
+
+// urlTemplate returns the path of u with all IDs and keys replaced by "{id}".
+// The query string is dropped as it may contain JQL, user names or other sensitive values.
+func urlTemplate(u *url.URL) string {
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i, segment := range segments {
+		if i >= 2 && segments[i-2] == "rest" && apiVersionRegex.MatchString(segment) {
+			continue
+		}
+		if !staticSegmentRegex.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
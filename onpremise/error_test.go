@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"testing"
 )
@@ -31,6 +32,42 @@ func TestError_NewJiraError(t *testing.T) {
 	}
 }
 
+func TestError_NewJiraErrorDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	body := `{"errorMessages":[],"errors":{"summary":"You must specify a summary of the issue.","customfield_10000":"Sprint is invalid"},"warningMessages":["Field is deprecated"]}`
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, body)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodPost, "/", nil)
+	resp, err := testClient.Do(req, nil)
+
+	var jerr *Error
+	if !errors.As(NewJiraError(resp, err), &jerr) {
+		t.Fatalf("Expected jira Error. Got %v", err)
+	}
+	if jerr.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status code %d. Got %d", http.StatusBadRequest, jerr.StatusCode)
+	}
+	if got := jerr.FieldError("summary"); got != "You must specify a summary of the issue." {
+		t.Errorf("Unexpected field error %q", got)
+	}
+	if len(jerr.WarningMessages) != 1 || jerr.WarningMessages[0] != "Field is deprecated" {
+		t.Errorf("Unexpected warnings %v", jerr.WarningMessages)
+	}
+	if string(jerr.Body) != body {
+		t.Errorf("Expected the raw body. Got %s", jerr.Body)
+	}
+	// Field errors are reported in a stable order
+	if !strings.HasPrefix(jerr.Error(), "customfield_10000 - Sprint is invalid") {
+		t.Errorf("Unexpected message %s", jerr.Error())
+	}
+}
+
 func TestError_NoResponse(t *testing.T) {
 	err := NewJiraError(nil, errors.New("Original http error"))
 
@@ -204,3 +241,72 @@ func TestError_LongMessage(t *testing.T) {
 		t.Errorf("Expected the error map: Got\n%s\n", msg)
 	}
 }
+
+func TestError_ResponseErrorContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/PROJ-123", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-AREQUESTID", "1234x5678x1")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `Issue does not exist`)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "/rest/api/2/issue/PROJ-123?fields=summary", nil)
+	resp, err := testClient.Do(req, nil)
+
+	var respErr *ResponseError
+	if !errors.As(err, &respErr) {
+		t.Fatalf("Expected a ResponseError. Got %v", err)
+	}
+	if respErr.Method != http.MethodGet {
+		t.Errorf("Expected method GET. Got %s", respErr.Method)
+	}
+	if want := "/rest/api/2/issue/{id}"; respErr.URLTemplate != want {
+		t.Errorf("Expected URL template %s. Got %s", want, respErr.URLTemplate)
+	}
+	if respErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status code 404. Got %d", respErr.StatusCode)
+	}
+	if respErr.RequestID != "1234x5678x1" {
+		t.Errorf("Expected request ID 1234x5678x1. Got %s", respErr.RequestID)
+	}
+	if respErr.BodyExcerpt != "Issue does not exist" {
+		t.Errorf("Expected body excerpt. Got %s", respErr.BodyExcerpt)
+	}
+	if strings.Contains(err.Error(), "PROJ-123") {
+		t.Errorf("Expected the issue key not to be part of the error message. Got %s", err.Error())
+	}
+
+	// The body is still available for NewJiraError
+	jerr := NewJiraError(resp, err)
+	if !strings.Contains(jerr.Error(), "Issue does not exist") {
+		t.Errorf("Expected the body in the error message. Got %s", jerr.Error())
+	}
+	if !errors.As(jerr, &respErr) {
+		t.Errorf("Expected NewJiraError to wrap the ResponseError. Got %v", jerr)
+	}
+}
+
+func TestError_ResponseErrorBodyExcerptIsTruncated(t *testing.T) {
+	body := strings.Repeat("a", 2*maxBodyExcerpt)
+	if got := bodyExcerpt([]byte(body)); len(got) != maxBodyExcerpt+len("...") {
+		t.Errorf("Expected excerpt of %d bytes. Got %d", maxBodyExcerpt+len("..."), len(got))
+	}
+}
+
+func TestError_URLTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/rest/api/2/issue/PROJ-123/transitions":      "/rest/api/2/issue/{id}/transitions",
+		"/rest/agile/1.0/board/42/sprint":             "/rest/agile/1.0/board/{id}/sprint",
+		"/rest/api/3/field/customfield_10000/context": "/rest/api/3/field/{id}/context",
+		"/rest/servicedeskapi/organization/7/user":    "/rest/servicedeskapi/organization/{id}/user",
+		"/rest/api/2/issueLinkType":                   "/rest/api/2/issueLinkType",
+	}
+	for in, want := range tests {
+		u, _ := url.Parse(in)
+		if got := urlTemplate(u); got != want {
+			t.Errorf("urlTemplate(%s) = %s, want %s", in, got, want)
+		}
+	}
+}
@@ -52,3 +52,100 @@ func (s *FieldService) GetList(ctx context.Context) ([]Field, *Response, error)
 	}
 	return fieldList, resp, nil
 }
+
+// epicLinkSchema is the schema of the "Epic Link" custom field of Jira Software.
+const epicLinkSchema = "com.pyxis.greenhopper.jira:gh-epic-link"
+
+// GetEpicLinkField returns the "Epic Link" custom field.
+// If the instance has no such field, like without Jira Software, nil is returned.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/field-getFields
+func (s *FieldService) GetEpicLinkField(ctx context.Context) (*Field, *Response, error) {
+	fields, resp, err := s.GetList(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	for i := range fields {
+		if fields[i].Schema.Custom == epicLinkSchema {
+			return &fields[i], resp, nil
+		}
+	}
+	return nil, resp, nil
+}
+
+// CustomFieldType is the type of a custom field, for CustomFieldPayload.Type.
+type CustomFieldType string
+
+// Types of the custom fields shipped with Jira
+const (
+	CustomFieldTypeCascadingSelect  CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:cascadingselect"
+	CustomFieldTypeDatePicker       CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:datepicker"
+	CustomFieldTypeDateTime         CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:datetime"
+	CustomFieldTypeFloat            CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:float"
+	CustomFieldTypeGroupPicker      CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:grouppicker"
+	CustomFieldTypeImportID         CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:importid"
+	CustomFieldTypeLabels           CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:labels"
+	CustomFieldTypeMultiCheckboxes  CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:multicheckboxes"
+	CustomFieldTypeMultiGroupPicker CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:multigrouppicker"
+	CustomFieldTypeMultiSelect      CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:multiselect"
+	CustomFieldTypeMultiUserPicker  CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:multiuserpicker"
+	CustomFieldTypeMultiVersion     CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:multiversion"
+	CustomFieldTypeProject          CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:project"
+	CustomFieldTypeRadioButtons     CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:radiobuttons"
+	CustomFieldTypeReadOnly         CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:readonlyfield"
+	CustomFieldTypeSelect           CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:select"
+	CustomFieldTypeTextArea         CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:textarea"
+	CustomFieldTypeTextField        CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:textfield"
+	CustomFieldTypeURL              CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:url"
+	CustomFieldTypeUserPicker       CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:userpicker"
+	CustomFieldTypeVersion          CustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:version"
+)
+
+// CustomFieldSearcher is the searcher of a custom field, for CustomFieldPayload.SearcherKey.
+// The searcher has to match the type of the field.
+type CustomFieldSearcher string
+
+// Searchers of the custom fields shipped with Jira
+const (
+	CustomFieldSearcherCascadingSelect CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:cascadingselectsearcher"
+	CustomFieldSearcherDateRange       CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:daterange"
+	CustomFieldSearcherDateTimeRange   CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:datetimerange"
+	CustomFieldSearcherExactNumber     CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:exactnumber"
+	CustomFieldSearcherExactText       CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:exacttextsearcher"
+	CustomFieldSearcherGroupPicker     CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:grouppickersearcher"
+	CustomFieldSearcherLabel           CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:labelsearcher"
+	CustomFieldSearcherMultiSelect     CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:multiselectsearcher"
+	CustomFieldSearcherNumberRange     CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:numberrange"
+	CustomFieldSearcherProject         CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:projectsearcher"
+	CustomFieldSearcherText            CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:textsearcher"
+	CustomFieldSearcherUserPickerGroup CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:userpickergroupsearcher"
+	CustomFieldSearcherVersion         CustomFieldSearcher = "com.atlassian.jira.plugin.system.customfieldtypes:versionsearcher"
+)
+
+// CustomFieldPayload is a custom field created by FieldService.Create.
+type CustomFieldPayload struct {
+	Name        string          `json:"name" structs:"name"`
+	Description string          `json:"description,omitempty" structs:"description,omitempty"`
+	Type        CustomFieldType `json:"type" structs:"type"`
+	// SearcherKey makes the field searchable via JQL. Without it, the field can't be searched.
+	SearcherKey CustomFieldSearcher `json:"searcherKey,omitempty" structs:"searcherKey,omitempty"`
+}
+
+// Create creates a custom field.
+// The field is not added to any screen yet.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/field-createCustomField
+func (s *FieldService) Create(ctx context.Context, payload *CustomFieldPayload) (*Field, *Response, error) {
+	apiEndpoint := "rest/api/2/field"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	field := new(Field)
+	resp, err := s.client.Do(req, field)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return field, resp, nil
+}
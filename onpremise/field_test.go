@@ -3,6 +3,7 @@ package onpremise
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"testing"
@@ -31,3 +32,34 @@ func TestFieldService_GetList(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestFieldService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/field"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testapiEndpoint)
+		body, _ := io.ReadAll(r.Body)
+		want := `{"name":"New custom field","description":"Custom field for picking groups","type":"com.atlassian.jira.plugin.system.customfieldtypes:grouppicker","searcherKey":"com.atlassian.jira.plugin.system.customfieldtypes:grouppickersearcher"}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body\ngot:  %s\nwant: %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"customfield_10101","key":"customfield_10101","name":"New custom field","custom":true,"navigable":true,"searchable":true,"clauseNames":["cf[10101]","New custom field"],"schema":{"type":"group","custom":"com.atlassian.jira.plugin.system.customfieldtypes:grouppicker","customId":10101}}`)
+	})
+
+	field, _, err := testClient.Field.Create(context.Background(), &CustomFieldPayload{
+		Name:        "New custom field",
+		Description: "Custom field for picking groups",
+		Type:        CustomFieldTypeGroupPicker,
+		SearcherKey: CustomFieldSearcherGroupPicker,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if field.ID != "customfield_10101" || field.Schema.CustomID != 10101 {
+		t.Errorf("Unexpected field %+v", field)
+	}
+}
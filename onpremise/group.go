@@ -30,6 +30,12 @@ type Group struct {
 	AdditionalProperties bool            `json:"additionalProperties"`
 }
 
+// GroupDetails is the name and URL of a group, as returned by GroupService.Create.
+type GroupDetails struct {
+	Name string `json:"name" structs:"name"`
+	Self string `json:"self,omitempty" structs:"self,omitempty"`
+}
+
 type groupProperties struct {
 	Name groupPropertiesName `json:"name"`
 }
@@ -102,7 +108,7 @@ func (s *GroupService) Get(ctx context.Context, name string, options *GroupSearc
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *GroupService) Add(ctx context.Context, groupname string, username string) (*Group, *Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/2/group/user?groupname=%s", groupname)
+	apiEndpoint := fmt.Sprintf("/rest/api/2/group/user?groupname=%s", url.QueryEscape(groupname))
 	var user struct {
 		Name string `json:"name"`
 	}
@@ -130,7 +136,7 @@ func (s *GroupService) Add(ctx context.Context, groupname string, username strin
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *GroupService) Remove(ctx context.Context, groupname string, username string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/2/group/user?groupname=%s&username=%s", groupname, username)
+	apiEndpoint := fmt.Sprintf("/rest/api/2/group/user?groupname=%s&username=%s", url.QueryEscape(groupname), url.QueryEscape(username))
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
 	if err != nil {
 		return nil, err
@@ -144,3 +150,44 @@ func (s *GroupService) Remove(ctx context.Context, groupname string, username st
 
 	return resp, nil
 }
+
+// Create creates the group name and returns it.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/group-createGroup
+func (s *GroupService) Create(ctx context.Context, name string) (*GroupDetails, *Response, error) {
+	body := struct {
+		Name string `json:"name"`
+	}{name}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "/rest/api/2/group", &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(GroupDetails)
+	resp, err := s.client.Do(req, group)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return group, resp, nil
+}
+
+// Delete deletes the group groupname.
+// If swapGroup is not empty, comments and worklogs restricted to the deleted group are restricted to the swap group instead.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/group-removeGroup
+func (s *GroupService) Delete(ctx context.Context, groupname, swapGroup string) (*Response, error) {
+	query := url.Values{"groupname": {groupname}}
+	if swapGroup != "" {
+		query.Set("swapGroup", swapGroup)
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, "/rest/api/2/group?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -3,6 +3,7 @@ package onpremise
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 )
@@ -95,3 +96,39 @@ func TestGroupService_Remove(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestGroupService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"name":"power-users"}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"power-users","self":"https://jira.example.com/rest/api/2/group?groupname=power-users"}`)
+	})
+
+	group, _, err := testClient.Group.Create(context.Background(), "power-users")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if group.Name != "power-users" {
+		t.Errorf("Expected group power-users, got %s", group.Name)
+	}
+}
+
+func TestGroupService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/api/2/group?groupname=power-users&swapGroup=jira-users")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if _, err := testClient.Group.Delete(context.Background(), "power-users", "jira-users"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -24,6 +24,40 @@ const (
 	AssigneeAutomatic = "-1"
 )
 
+// IssueExpand represents a value of the expand parameter of issue related endpoints.
+type IssueExpand string
+
+const (
+	IssueExpandRenderedFields           IssueExpand = "renderedFields"
+	IssueExpandNames                    IssueExpand = "names"
+	IssueExpandSchema                   IssueExpand = "schema"
+	IssueExpandTransitions              IssueExpand = "transitions"
+	IssueExpandOperations               IssueExpand = "operations"
+	IssueExpandEditMeta                 IssueExpand = "editmeta"
+	IssueExpandChangelog                IssueExpand = "changelog"
+	IssueExpandVersionedRepresentations IssueExpand = "versionedRepresentations"
+)
+
+// IsValid reports whether e is an expand value known to Jira.
+func (e IssueExpand) IsValid() bool {
+	switch e {
+	case IssueExpandRenderedFields, IssueExpandNames, IssueExpandSchema, IssueExpandTransitions,
+		IssueExpandOperations, IssueExpandEditMeta, IssueExpandChangelog, IssueExpandVersionedRepresentations:
+		return true
+	}
+	return false
+}
+
+// IssueExpands joins values to the comma-separated list expected by the Expand fields of
+// GetQueryOptions and SearchOptions.
+func IssueExpands(values ...IssueExpand) string {
+	expands := make([]string, 0, len(values))
+	for _, value := range values {
+		expands = append(expands, string(value))
+	}
+	return strings.Join(expands, ",")
+}
+
 // IssueService handles Issues for the Jira instance / API.
 //
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue
@@ -47,6 +81,71 @@ type Issue struct {
 	Changelog      *Changelog           `json:"changelog,omitempty" structs:"changelog,omitempty"`
 	Transitions    []Transition         `json:"transitions,omitempty" structs:"transitions,omitempty"`
 	Names          map[string]string    `json:"names,omitempty" structs:"names,omitempty"`
+
+	// Extra holds all top-level attributes of the issue that are not modelled by this struct,
+	// like "properties", "operations" or "versionedRepresentations".
+	// They are kept as they were received and written back when the issue is marshalled again.
+	// Unmodelled fields inside "fields" are available via IssueFields.Unknowns.
+	Extra map[string]json.RawMessage `json:"-" structs:"-"`
+}
+
+// MarshalJSON is a custom JSON marshal function for the Issue struct.
+// It writes the attributes of Extra next to the modelled ones.
+func (i Issue) MarshalJSON() ([]byte, error) {
+	type Alias Issue
+	data, err := json.Marshal(Alias(i))
+	if err != nil || len(i.Extra) == 0 {
+		return data, err
+	}
+
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for key, value := range i.Extra {
+		// Modelled attributes take precedence
+		if _, found := m[key]; !found {
+			m[key] = value
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom JSON unmarshal function for the Issue struct.
+// It collects all attributes that are not modelled by the struct in Extra.
+func (i *Issue) UnmarshalJSON(data []byte) error {
+	type Alias Issue
+	aux := (*Alias)(i)
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	for key := range jsonKeys(reflect.TypeOf(*i)) {
+		delete(m, key)
+	}
+
+	i.Extra = nil
+	if len(m) > 0 {
+		i.Extra = m
+	}
+	return nil
+}
+
+// jsonKeys returns the JSON keys of all fields of the struct type t.
+func jsonKeys(t reflect.Type) map[string]struct{} {
+	keys := make(map[string]struct{}, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		keys[strings.Split(tag, ",")[0]] = struct{}{}
+	}
+	return keys
 }
 
 // ChangelogItems reflects one single changelog item of a history item
@@ -113,6 +212,7 @@ type IssueFields struct {
 	Created                       Time              `json:"created,omitempty" structs:"created,omitempty"`
 	Duedate                       Date              `json:"duedate,omitempty" structs:"duedate,omitempty"`
 	Watches                       *Watches          `json:"watches,omitempty" structs:"watches,omitempty"`
+	Votes                         *Votes            `json:"votes,omitempty" structs:"votes,omitempty"`
 	Assignee                      *User             `json:"assignee,omitempty" structs:"assignee,omitempty"`
 	Updated                       Time              `json:"updated,omitempty" structs:"updated,omitempty"`
 	Description                   string            `json:"description,omitempty" structs:"description,omitempty"`
@@ -209,21 +309,60 @@ func (i *IssueFields) UnmarshalJSON(data []byte) error {
 }
 
 // IssueRenderedFields represents rendered fields of a Jira issue.
+// They are returned if the expand "renderedFields" is requested: Text fields, like the description and comments,
+// are rendered as HTML, dates and durations as human readable text, like "2 hours ago" or "3 days".
 // Not all IssueFields are rendered.
 type IssueRenderedFields struct {
-	// TODO Missing fields
-	//      * "aggregatetimespent": null,
-	//      * "workratio": -1,
-	//      * "lastViewed": null,
-	//      * "aggregatetimeoriginalestimate": null,
-	//      * "aggregatetimeestimate": null,
-	//      * "environment": null,
-	Resolutiondate string    `json:"resolutiondate,omitempty" structs:"resolutiondate,omitempty"`
-	Created        string    `json:"created,omitempty" structs:"created,omitempty"`
-	Duedate        string    `json:"duedate,omitempty" structs:"duedate,omitempty"`
-	Updated        string    `json:"updated,omitempty" structs:"updated,omitempty"`
-	Comments       *Comments `json:"comment,omitempty" structs:"comment,omitempty"`
-	Description    string    `json:"description,omitempty" structs:"description,omitempty"`
+	Resolutiondate                string           `json:"resolutiondate,omitempty" structs:"resolutiondate,omitempty"`
+	Created                       string           `json:"created,omitempty" structs:"created,omitempty"`
+	Duedate                       string           `json:"duedate,omitempty" structs:"duedate,omitempty"`
+	Updated                       string           `json:"updated,omitempty" structs:"updated,omitempty"`
+	LastViewed                    string           `json:"lastViewed,omitempty" structs:"lastViewed,omitempty"`
+	Comments                      *Comments        `json:"comment,omitempty" structs:"comment,omitempty"`
+	Description                   string           `json:"description,omitempty" structs:"description,omitempty"`
+	Environment                   string           `json:"environment,omitempty" structs:"environment,omitempty"`
+	Worklog                       *RenderedWorklog `json:"worklog,omitempty" structs:"worklog,omitempty"`
+	TimeSpent                     string           `json:"timespent,omitempty" structs:"timespent,omitempty"`
+	TimeEstimate                  string           `json:"timeestimate,omitempty" structs:"timeestimate,omitempty"`
+	TimeOriginalEstimate          string           `json:"timeoriginalestimate,omitempty" structs:"timeoriginalestimate,omitempty"`
+	AggregateTimeSpent            string           `json:"aggregatetimespent,omitempty" structs:"aggregatetimespent,omitempty"`
+	AggregateTimeEstimate         string           `json:"aggregatetimeestimate,omitempty" structs:"aggregatetimeestimate,omitempty"`
+	AggregateTimeOriginalEstimate string           `json:"aggregatetimeoriginalestimate,omitempty" structs:"aggregatetimeoriginalestimate,omitempty"`
+
+	// CustomFields contains the rendered custom fields, like the HTML of text custom fields, by field ID.
+	// Custom fields Jira doesn't render are left out.
+	CustomFields map[string]string `json:"-" structs:"-"`
+}
+
+// UnmarshalJSON decodes the rendered fields and collects the rendered custom fields in CustomFields.
+func (f *IssueRenderedFields) UnmarshalJSON(data []byte) error {
+	type Alias IssueRenderedFields
+	if err := json.Unmarshal(data, (*Alias)(f)); err != nil {
+		return err
+	}
+
+	var all map[string]interface{}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	f.CustomFields = nil
+	for key, value := range all {
+		rendered, ok := value.(string)
+		if !ok || !strings.HasPrefix(key, "customfield_") {
+			continue
+		}
+		if f.CustomFields == nil {
+			f.CustomFields = map[string]string{}
+		}
+		f.CustomFields[key] = rendered
+	}
+	return nil
+}
+
+// CustomField returns the rendered value of the custom field id, like "customfield_10010".
+// It returns an empty string if the field is not set or not rendered by Jira.
+func (f *IssueRenderedFields) CustomField(id string) string {
+	return f.CustomFields[id]
 }
 
 // IssueType represents a type of a Jira issue.
@@ -255,6 +394,15 @@ type Watcher struct {
 	Active      bool   `json:"active,omitempty" structs:"active,omitempty"`
 }
 
+// Votes represents the votes of a Jira issue.
+// Voters is only returned by IssueService.GetVotes and only if the user has the permission to view the voters.
+type Votes struct {
+	Self     string `json:"self,omitempty" structs:"self,omitempty"`
+	Votes    int    `json:"votes" structs:"votes"`
+	HasVoted bool   `json:"hasVoted" structs:"hasVoted"`
+	Voters   []User `json:"voters,omitempty" structs:"voters,omitempty"`
+}
+
 // AvatarUrls represents different dimensions of avatars / images
 type AvatarUrls struct {
 	Four8X48  string `json:"48x48,omitempty" structs:"48x48,omitempty"`
@@ -323,6 +471,20 @@ type CreateTransitionPayload struct {
 // TransitionPayloadUpdate represents the updates of Transition calls like DoTransition
 type TransitionPayloadUpdate struct {
 	Comment []TransitionPayloadComment `json:"comment,omitempty" structs:"comment,omitempty"`
+	// Operations are the update operations of other fields by field ID, like {"labels": [{"add": "triaged"}]}.
+	Operations map[string][]map[string]interface{} `json:"-" structs:"-"`
+}
+
+// MarshalJSON adds the Operations next to the comment.
+func (u TransitionPayloadUpdate) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, len(u.Operations)+1)
+	for field, operations := range u.Operations {
+		m[field] = operations
+	}
+	if len(u.Comment) > 0 {
+		m["comment"] = u.Comment
+	}
+	return json.Marshal(m)
 }
 
 // TransitionPayloadComment represents comment in Transition payload
@@ -343,6 +505,20 @@ type TransitionPayload struct {
 // TransitionPayloadFields represents the fields that can be set when executing a transition
 type TransitionPayloadFields struct {
 	Resolution *Resolution `json:"resolution,omitempty" structs:"resolution,omitempty"`
+	// Unknowns are other fields set by the transition by field ID, like custom fields of the transition screen.
+	Unknowns tcontainer.MarshalMap `json:"-" structs:"-"`
+}
+
+// MarshalJSON adds the Unknowns next to the resolution.
+func (f TransitionPayloadFields) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, len(f.Unknowns)+1)
+	for field, value := range f.Unknowns {
+		m[field] = value
+	}
+	if f.Resolution != nil {
+		m["resolution"] = f.Resolution
+	}
+	return json.Marshal(m)
 }
 
 // Option represents an option value in a SelectList or MultiSelect
@@ -421,11 +597,45 @@ type WorklogRecord struct {
 	Properties       []EntityProperty `json:"properties,omitempty"`
 }
 
+// RenderedWorklog is the rendered worklog of an issue, see IssueRenderedFields.
+type RenderedWorklog struct {
+	StartAt    int                     `json:"startAt" structs:"startAt"`
+	MaxResults int                     `json:"maxResults" structs:"maxResults"`
+	Total      int                     `json:"total" structs:"total"`
+	Worklogs   []RenderedWorklogRecord `json:"worklogs" structs:"worklogs"`
+}
+
+// RenderedWorklogRecord is a rendered worklog record.
+// The comment is rendered as HTML, dates as human readable text, like "16/Mar/16 4:22 AM".
+type RenderedWorklogRecord struct {
+	Self             string `json:"self,omitempty" structs:"self,omitempty"`
+	Author           *User  `json:"author,omitempty" structs:"author,omitempty"`
+	UpdateAuthor     *User  `json:"updateAuthor,omitempty" structs:"updateAuthor,omitempty"`
+	Comment          string `json:"comment,omitempty" structs:"comment,omitempty"`
+	Created          string `json:"created,omitempty" structs:"created,omitempty"`
+	Updated          string `json:"updated,omitempty" structs:"updated,omitempty"`
+	Started          string `json:"started,omitempty" structs:"started,omitempty"`
+	TimeSpent        string `json:"timeSpent,omitempty" structs:"timeSpent,omitempty"`
+	TimeSpentSeconds int    `json:"timeSpentSeconds,omitempty" structs:"timeSpentSeconds,omitempty"`
+	ID               string `json:"id,omitempty" structs:"id,omitempty"`
+	IssueID          string `json:"issueId,omitempty" structs:"issueId,omitempty"`
+}
+
+// EntityProperty is a property of a Jira entity, like an issue or a worklog.
 type EntityProperty struct {
 	Key   string      `json:"key"`
 	Value interface{} `json:"value"`
 }
 
+// Decode stores the value of the property in the value pointed to by v, like json.Unmarshal.
+func (p *EntityProperty) Decode(v interface{}) error {
+	data, err := json.Marshal(p.Value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
 // TimeTracking represents the timetracking fields of a Jira issue.
 type TimeTracking struct {
 	OriginalEstimate         string `json:"originalEstimate,omitempty" structs:"originalEstimate,omitempty"`
@@ -465,8 +675,12 @@ type IssueLinkType struct {
 }
 
 // Comments represents a list of Comment.
+// The pagination information is returned by IssueService.GetComments and as part of the comment field of an issue.
 type Comments struct {
-	Comments []*Comment `json:"comments,omitempty" structs:"comments,omitempty"`
+	StartAt    int        `json:"startAt,omitempty" structs:"startAt,omitempty"`
+	MaxResults int        `json:"maxResults,omitempty" structs:"maxResults,omitempty"`
+	Total      int        `json:"total,omitempty" structs:"total,omitempty"`
+	Comments   []*Comment `json:"comments,omitempty" structs:"comments,omitempty"`
 }
 
 // Comment represents a comment by a person to an issue in Jira.
@@ -509,6 +723,24 @@ type CommentVisibility struct {
 	Value string `json:"value,omitempty" structs:"value,omitempty"`
 }
 
+// Types of CommentVisibility
+const (
+	// CommentVisibilityRole restricts a comment to the members of a project role.
+	CommentVisibilityRole = "role"
+	// CommentVisibilityGroup restricts a comment to the members of a group.
+	CommentVisibilityGroup = "group"
+)
+
+// GetCommentsOptions specifies the optional parameters for IssueService.GetComments.
+type GetCommentsOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// OrderBy orders the comments by creation date: "created" (oldest first) or "-created" (newest first).
+	OrderBy string `url:"orderBy,omitempty"`
+	// Expand can be "renderedBody" to return the comment bodies as HTML as well.
+	Expand string `url:"expand,omitempty"`
+}
+
 // SearchOptions specifies the optional parameters to various List methods that
 // support pagination.
 // Pagination is used for the Jira REST APIs to conserve server resources and limit
@@ -566,6 +798,16 @@ type AddWorklogQueryOptions struct {
 	OverrideEditableFlag bool   `url:"overrideEditableFlag,omitempty"`
 }
 
+// DeleteWorklogQueryOptions specifies the optional parameters for the Delete Worklog method.
+// AdjustEstimate is one of "new" (requires NewEstimate), "leave", "manual" (requires IncreaseBy) or "auto".
+type DeleteWorklogQueryOptions struct {
+	NotifyUsers          bool   `url:"notifyUsers,omitempty"`
+	AdjustEstimate       string `url:"adjustEstimate,omitempty"`
+	NewEstimate          string `url:"newEstimate,omitempty"`
+	IncreaseBy           string `url:"increaseBy,omitempty"`
+	OverrideEditableFlag bool   `url:"overrideEditableFlag,omitempty"`
+}
+
 // CustomFields represents custom fields of Jira
 // This can heavily differ between Jira instances
 type CustomFields map[string]string
@@ -882,7 +1124,32 @@ func (s *IssueService) UpdateIssue(ctx context.Context, jiraID string, data map[
 	return resp, nil
 }
 
+// GetComments returns a page of the comments of issueID.
+// Unlike the comment field of an issue, which is limited by Jira, it gives access to all comments.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getComments
+func (s *IssueService) GetComments(ctx context.Context, issueID string, options *GetCommentsOptions) (*Comments, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment", issueID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comments := new(Comments)
+	resp, err := s.client.Do(req, comments)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return comments, resp, nil
+}
+
 // AddComment adds a new comment to issueID.
+// The comment can be restricted to a project role or group via comment.Visibility.
 //
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-addComment
 //
@@ -905,7 +1172,7 @@ func (s *IssueService) AddComment(ctx context.Context, issueID string, comment *
 	return responseComment, resp, nil
 }
 
-// UpdateComment updates the body of a comment, identified by comment.ID, on the issueID.
+// UpdateComment updates the body and, if set, the visibility of a comment, identified by comment.ID, on the issueID.
 //
 // Jira API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/issue/{issueIdOrKey}/comment-updateComment
 //
@@ -913,10 +1180,14 @@ func (s *IssueService) AddComment(ctx context.Context, issueID string, comment *
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) UpdateComment(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error) {
 	reqBody := struct {
-		Body string `json:"body"`
+		Body       string             `json:"body"`
+		Visibility *CommentVisibility `json:"visibility,omitempty"`
 	}{
 		Body: comment.Body,
 	}
+	if comment.Visibility != (CommentVisibility{}) {
+		reqBody.Visibility = &comment.Visibility
+	}
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment/%s", issueID, comment.ID)
 	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, reqBody)
 	if err != nil {
@@ -926,7 +1197,7 @@ func (s *IssueService) UpdateComment(ctx context.Context, issueID string, commen
 	responseComment := new(Comment)
 	resp, err := s.client.Do(req, responseComment)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, NewJiraError(resp, err)
 	}
 
 	return responseComment, resp, nil
@@ -1015,7 +1286,203 @@ func (s *IssueService) UpdateWorklogRecord(ctx context.Context, issueID, worklog
 	return responseRecord, resp, nil
 }
 
+// GetWorklogRecord returns the worklog record worklogID of issueID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getWorklog
+func (s *IssueService) GetWorklogRecord(ctx context.Context, issueID, worklogID string, options ...func(*http.Request) error) (*WorklogRecord, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog/%s", issueID, worklogID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, option := range options {
+		err = option(req)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	record := new(WorklogRecord)
+	resp, err := s.client.Do(req, record)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return record, resp, nil
+}
+
+// DeleteWorklogRecord deletes the worklog record worklogID of issueID.
+// How the remaining estimate is adjusted can be set via WithQueryOptions(&DeleteWorklogQueryOptions{...}).
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-deleteWorklog
+func (s *IssueService) DeleteWorklogRecord(ctx context.Context, issueID, worklogID string, options ...func(*http.Request) error) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog/%s", issueID, worklogID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, option := range options {
+		err = option(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GetWorklogPropertyKeys returns the keys of all properties of a worklog.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklog-properties/#api-rest-api-2-issue-issueidorkey-worklog-worklogid-properties-get
+func (s *IssueService) GetWorklogPropertyKeys(ctx context.Context, issueID, worklogID string) (*PropertyKeys, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog/%s/properties", issueID, worklogID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(PropertyKeys)
+	resp, err := s.client.Do(req, keys)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return keys, resp, nil
+}
+
+// GetWorklogProperty returns a property of a worklog.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklog-properties/#api-rest-api-2-issue-issueidorkey-worklog-worklogid-properties-propertykey-get
+func (s *IssueService) GetWorklogProperty(ctx context.Context, issueID, worklogID, propertyKey string) (*EntityProperty, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog/%s/properties/%s", issueID, worklogID, propertyKey)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return property, resp, nil
+}
+
+// SetWorklogProperty creates or updates a property of a worklog, like a billing code or an external ID.
+// value is encoded as JSON and may not be larger than 32 KB.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklog-properties/#api-rest-api-2-issue-issueidorkey-worklog-worklogid-properties-propertykey-put
+func (s *IssueService) SetWorklogProperty(ctx context.Context, issueID, worklogID, propertyKey string, value interface{}) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog/%s/properties/%s", issueID, worklogID, propertyKey)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteWorklogProperty deletes a property of a worklog.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklog-properties/#api-rest-api-2-issue-issueidorkey-worklog-worklogid-properties-propertykey-delete
+func (s *IssueService) DeleteWorklogProperty(ctx context.Context, issueID, worklogID, propertyKey string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog/%s/properties/%s", issueID, worklogID, propertyKey)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GetPropertyKeys returns the keys of all properties of the issue issueIDOrKey.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/properties-getPropertiesKeys
+func (s *IssueService) GetPropertyKeys(ctx context.Context, issueIDOrKey string) (*PropertyKeys, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/properties", url.PathEscape(issueIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(PropertyKeys)
+	resp, err := s.client.Do(req, keys)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return keys, resp, nil
+}
+
+// GetProperty returns a property of the issue issueIDOrKey.
+// Its value can be decoded into a typed value via EntityProperty.Decode.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/properties-getProperty
+func (s *IssueService) GetProperty(ctx context.Context, issueIDOrKey, propertyKey string) (*EntityProperty, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, issuePropertyEndpoint(issueIDOrKey, propertyKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return property, resp, nil
+}
+
+// SetProperty creates or updates a property of the issue issueIDOrKey.
+// value is encoded as JSON and may not be larger than 32 KB.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/properties-setProperty
+func (s *IssueService) SetProperty(ctx context.Context, issueIDOrKey, propertyKey string, value interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPut, issuePropertyEndpoint(issueIDOrKey, propertyKey), value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteProperty deletes a property of the issue issueIDOrKey.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/properties-deleteProperty
+func (s *IssueService) DeleteProperty(ctx context.Context, issueIDOrKey, propertyKey string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, issuePropertyEndpoint(issueIDOrKey, propertyKey), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// issuePropertyEndpoint returns the endpoint of a single issue property.
+func issuePropertyEndpoint(issueIDOrKey, propertyKey string) string {
+	return fmt.Sprintf("rest/api/2/issue/%s/properties/%s", url.PathEscape(issueIDOrKey), url.PathEscape(propertyKey))
+}
+
 // AddLink adds a link between two issues.
+// If issueLink.Comment is set, the comment is added to the outward issue.
 //
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issueLink
 // Caller must close resp.Body
@@ -1037,6 +1504,25 @@ func (s *IssueService) AddLink(ctx context.Context, issueLink *IssueLink) (*Resp
 	return resp, err
 }
 
+// GetLink returns the issue link linkID, including its type and both linked issues.
+// The IDs of the links of an issue are part of IssueFields.IssueLinks.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issueLink-getIssueLink
+func (s *IssueService) GetLink(ctx context.Context, linkID string) (*IssueLink, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issueLink/%s", url.PathEscape(linkID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	link := new(IssueLink)
+	resp, err := s.client.Do(req, link)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return link, resp, nil
+}
+
 // Search will search for tickets according to the jql
 //
 // Jira API docs: https://developer.atlassian.com/jiradev/jira-apis/jira-rest-apis/jira-rest-api-tutorials/jira-rest-api-example-query-issues
@@ -1086,33 +1572,35 @@ func (s *IssueService) Search(ctx context.Context, jql string, options *SearchOp
 }
 
 // SearchPages will get issues from all pages in a search
+// and calls f for every issue, until f returns an error.
+// options.StartAt is the index of the first issue, options.MaxResults the page size (default: 50).
+// The pages are requested one after another, so the walk stops as soon as ctx is done.
+// If issues are added or removed while paging, the walk ends according to the total of the latest page.
 //
 // Jira API docs: https://developer.atlassian.com/jiradev/jira-apis/jira-rest-apis/jira-rest-api-tutorials/jira-rest-api-example-query-issues
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) SearchPages(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error) error {
-	if options == nil {
-		options = &SearchOptions{
-			StartAt:    0,
-			MaxResults: 50,
-		}
+	// Don't modify the options of the caller
+	opts := SearchOptions{}
+	if options != nil {
+		opts = *options
 	}
-
-	if options.MaxResults == 0 {
-		options.MaxResults = 50
+	if opts.MaxResults == 0 {
+		opts.MaxResults = 50
 	}
 
-	issues, resp, err := s.Search(ctx, jql, options)
-	if err != nil {
-		return err
-	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 
-	if len(issues) == 0 {
-		return nil
-	}
+		issues, resp, err := s.Search(ctx, jql, &opts)
+		if err != nil {
+			return err
+		}
 
-	for {
 		for _, issue := range issues {
 			err = f(issue)
 			if err != nil {
@@ -1120,15 +1608,11 @@ func (s *IssueService) SearchPages(ctx context.Context, jql string, options *Sea
 			}
 		}
 
-		if resp.StartAt+resp.MaxResults >= resp.Total {
+		// Jira may return less issues than requested, so continue after the last received one
+		opts.StartAt += len(issues)
+		if len(issues) == 0 || opts.StartAt >= resp.Total {
 			return nil
 		}
-
-		options.StartAt += resp.MaxResults
-		issues, resp, err = s.Search(ctx, jql, options)
-		if err != nil {
-			return err
-		}
 	}
 }
 
@@ -1203,11 +1687,31 @@ func (s *IssueService) GetTransitions(ctx context.Context, id string) ([]Transit
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) DoTransition(ctx context.Context, ticketID, transitionID string) (*Response, error) {
+	return s.DoTransitionWithOptions(ctx, ticketID, transitionID, nil)
+}
+
+// DoTransitionWithOptions performs the transition transitionID on an issue.
+// options sets a comment, the resolution, other fields and performs update operations while transitioning.
+//
+// Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-doTransition
+// Caller must close resp.Body
+func (s *IssueService) DoTransitionWithOptions(ctx context.Context, ticketID, transitionID string, options *TransitionOptions) (*Response, error) {
 	payload := CreateTransitionPayload{
 		Transition: TransitionPayload{
 			ID: transitionID,
 		},
 	}
+	if options != nil {
+		if options.Comment != "" {
+			payload.Update.Comment = []TransitionPayloadComment{
+				{Add: TransitionPayloadCommentBody{Body: options.Comment}},
+			}
+		}
+		payload.Fields.Resolution = options.Resolution
+		payload.Fields.Unknowns = options.Fields
+		payload.Update.Operations = options.Update
+	}
+
 	return s.DoTransitionWithPayload(ctx, ticketID, payload)
 }
 
@@ -1235,6 +1739,73 @@ func (s *IssueService) DoTransitionWithPayload(ctx context.Context, ticketID, pa
 	return resp, err
 }
 
+// TransitionOptions specifies the optional parameters for IssueService.DoTransitionWithOptions and IssueService.TransitionToStatus
+type TransitionOptions struct {
+	// Comment is added to the issue while performing the transition.
+	Comment string
+
+	// Resolution is set on the issue while performing the transition.
+	Resolution *Resolution
+
+	// Fields are other fields set while performing the transition, by field ID.
+	Fields map[string]interface{}
+
+	// Update are update operations performed while performing the transition, by field ID,
+	// like {"labels": [{"add": "triaged"}]}.
+	Update map[string][]map[string]interface{}
+}
+
+// NoTransitionError is returned by IssueService.TransitionToStatus
+// if none of the available transitions leads to the requested status.
+type NoTransitionError struct {
+	IssueID   string
+	Status    string
+	Available []Transition
+}
+
+// Error lists the transitions that are available for the issue.
+func (e *NoTransitionError) Error() string {
+	available := make([]string, 0, len(e.Available))
+	for _, t := range e.Available {
+		available = append(available, fmt.Sprintf("%q (to %q)", t.Name, t.To.Name))
+	}
+	return fmt.Sprintf("no transition of issue %s leads to status %q, available transitions: %s", e.IssueID, e.Status, strings.Join(available, ", "))
+}
+
+// TransitionToStatus moves an issue into the status with the given name.
+// It fetches the transitions that are available for the issue and performs the one leading to status.
+// The status name is compared case-insensitively.
+// If no transition leads to status, a *NoTransitionError is returned.
+//
+// Caller must close resp.Body
+func (s *IssueService) TransitionToStatus(ctx context.Context, issueID, status string, options *TransitionOptions) (*Response, error) {
+	transitions, resp, err := s.GetTransitions(ctx, issueID)
+	if err != nil {
+		return resp, err
+	}
+
+	var transition *Transition
+	for i := range transitions {
+		if strings.EqualFold(transitions[i].To.Name, status) {
+			transition = &transitions[i]
+			break
+		}
+	}
+	if transition == nil {
+		return resp, &NoTransitionError{IssueID: issueID, Status: status, Available: transitions}
+	}
+
+	return s.DoTransitionWithOptions(ctx, issueID, transition.ID, options)
+}
+
+// DoTransitionToStatus moves the issue key into the status statusName without setting other fields.
+// It is a shorthand for TransitionToStatus without options.
+//
+// Caller must close resp.Body
+func (s *IssueService) DoTransitionToStatus(ctx context.Context, key, statusName string) (*Response, error) {
+	return s.TransitionToStatus(ctx, key, statusName, nil)
+}
+
 // InitIssueWithMetaAndFields returns Issue with with values from fieldsConfig properly set.
 //   - metaProject should contain metaInformation about the project where the issue should be created.
 //   - metaIssuetype is the MetaInformation about the Issuetype that needs to be created.
@@ -1341,16 +1912,13 @@ func (s *IssueService) Delete(ctx context.Context, issueID string) (*Response, e
 	return resp, err
 }
 
-// GetWatchers wil return all the users watching/observing the given issue
+// GetWatches returns the watch information of the given issue: the number of watchers,
+// whether the current user is watching and the watchers themselves.
 //
 // Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getIssueWatchers
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *IssueService) GetWatchers(ctx context.Context, issueID string) (*[]User, *Response, error) {
-	watchesAPIEndpoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
-
-	req, err := s.client.NewRequest(ctx, http.MethodGet, watchesAPIEndpoint, nil)
+func (s *IssueService) GetWatches(ctx context.Context, issueID string) (*Watches, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", url.PathEscape(issueID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1358,33 +1926,40 @@ func (s *IssueService) GetWatchers(ctx context.Context, issueID string) (*[]User
 	watches := new(Watches)
 	resp, err := s.client.Do(req, watches)
 	if err != nil {
-		return nil, nil, NewJiraError(resp, err)
+		return nil, resp, NewJiraError(resp, err)
 	}
+	return watches, resp, nil
+}
 
-	result := []User{}
-	for _, watcher := range watches.Watchers {
-		var user *User
-		if watcher.AccountID != "" {
-			user, resp, err = s.client.User.GetByAccountID(context.Background(), watcher.AccountID)
-			if err != nil {
-				return nil, resp, NewJiraError(resp, err)
-			}
-		}
-		result = append(result, *user)
+// GetWatchers returns all the users watching/observing the given issue.
+// Jira Server returns complete users as watchers, so no further requests are needed.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getIssueWatchers
+func (s *IssueService) GetWatchers(ctx context.Context, issueID string) (*[]User, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", url.PathEscape(issueID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	watches := new(struct {
+		Watchers []User `json:"watchers"`
+	})
+	resp, err := s.client.Do(req, watches)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
 	}
 
+	result := append([]User{}, watches.Watchers...)
 	return &result, resp, nil
 }
 
-// AddWatcher adds watcher to the given issue
+// AddWatcher adds the user userName as watcher of the given issue.
 //
 // Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-addWatcher
 // Caller must close resp.Body
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) AddWatcher(ctx context.Context, issueID string, userName string) (*Response, error) {
-	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
+	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", url.PathEscape(issueID))
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndPoint, userName)
 	if err != nil {
@@ -1399,17 +1974,14 @@ func (s *IssueService) AddWatcher(ctx context.Context, issueID string, userName
 	return resp, err
 }
 
-// RemoveWatcher removes given user from given issue
+// RemoveWatcher removes the user userName as watcher of the given issue.
 //
 // Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-removeWatcher
 // Caller must close resp.Body
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) RemoveWatcher(ctx context.Context, issueID string, userName string) (*Response, error) {
-	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
+	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/watchers?username=%s", url.PathEscape(issueID), url.QueryEscape(userName))
 
-	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndPoint, userName)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndPoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1422,6 +1994,58 @@ func (s *IssueService) RemoveWatcher(ctx context.Context, issueID string, userNa
 	return resp, err
 }
 
+// GetVotes returns the vote count of the given issue and, if the user has the permission to view them, its voters.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/votes-getVotes
+func (s *IssueService) GetVotes(ctx context.Context, issueID string) (*Votes, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/votes", url.PathEscape(issueID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	votes := new(Votes)
+	resp, err := s.client.Do(req, votes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return votes, resp, nil
+}
+
+// AddVote adds the vote of the current user to the given issue.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/votes-addVote
+func (s *IssueService) AddVote(ctx context.Context, issueID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/votes", url.PathEscape(issueID))
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// RemoveVote removes the vote of the current user from the given issue.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/votes-removeVote
+func (s *IssueService) RemoveVote(ctx context.Context, issueID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/votes", url.PathEscape(issueID))
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
 // UpdateAssignee updates the user assigned to work on the given issue
 //
 // Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.2/#api/2/issue-assign
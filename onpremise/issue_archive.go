@@ -0,0 +1,80 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Archive archives the issues keys one after another.
+// Archived issues are read-only and left out of searches, until they are restored via IssueService.Restore.
+// Archiving requires Jira Data Center 8.1 or newer and stops at the first issue that can't be archived.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-archiveIssue
+func (s *IssueService) Archive(ctx context.Context, keys ...string) (*Response, error) {
+	var resp *Response
+	for _, key := range keys {
+		var err error
+		resp, err = s.putArchiveState(ctx, key, "archive")
+		if err != nil {
+			return resp, fmt.Errorf("archiving issue %s: %w", key, err)
+		}
+	}
+	return resp, nil
+}
+
+// Restore restores the archived issue key.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-restoreIssue
+func (s *IssueService) Restore(ctx context.Context, key string) (*Response, error) {
+	return s.putArchiveState(ctx, key, "restore")
+}
+
+// ArchivedIssuesExportOptions filters the archived issues exported by IssueService.ExportArchived.
+type ArchivedIssuesExportOptions struct {
+	// Projects limits the export to the projects with these keys.
+	Projects []string `url:"projects,comma,omitempty"`
+	// ArchivedBy limits the export to issues archived by these users.
+	ArchivedBy []string `url:"archivedBy,comma,omitempty"`
+	// ArchivedAfter and ArchivedBefore limit the export to issues archived in this date range (yyyy-MM-dd).
+	ArchivedAfter  string `url:"archivedAfter,omitempty"`
+	ArchivedBefore string `url:"archivedBefore,omitempty"`
+}
+
+// ExportArchived exports the archived issues matching the options as CSV.
+// The CSV is in the Response.Body of the response, which is an io.ReadCloser.
+// Caller must close resp.Body.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issues/archive-exportArchivedIssues
+func (s *IssueService) ExportArchived(ctx context.Context, options *ArchivedIssuesExportOptions) (*Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/issues/archive/export", options)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/csv")
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+func (s *IssueService) putArchiveState(ctx context.Context, key, action string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/%s", url.PathEscape(key), action)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
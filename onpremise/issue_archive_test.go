@@ -0,0 +1,94 @@
+package onpremise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIssueService_Archive(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var archived []string
+	for _, key := range []string{"EX-1", "EX-2"} {
+		key := key
+		testMux.HandleFunc(fmt.Sprintf("/rest/api/2/issue/%s/archive", key), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPut)
+			archived = append(archived, key)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+	testMux.HandleFunc("/rest/api/2/issue/EX-3/archive", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errorMessages":["You don't have permission to archive this issue."]}`)
+	})
+
+	if _, err := testClient.Issue.Archive(context.Background(), "EX-1", "EX-2"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !reflect.DeepEqual(archived, []string{"EX-1", "EX-2"}) {
+		t.Errorf("Expected EX-1 and EX-2 to be archived, got %v", archived)
+	}
+
+	archived = nil
+	_, err := testClient.Issue.Archive(context.Background(), "EX-3", "EX-1")
+	var jerr *Error
+	if !errors.As(err, &jerr) {
+		t.Fatalf("Expected a Jira error, got %v", err)
+	}
+	if len(archived) != 0 {
+		t.Errorf("Expected archiving to stop at the first error, got %v", archived)
+	}
+}
+
+func TestIssueService_Restore(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issue/EX-1/restore"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.Restore(context.Background(), "EX-1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_ExportArchived(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issues/archive/export"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint+"?archivedAfter=2024-01-01&projects=EX%2CABC")
+		w.Header().Set("Content-Type", "text/csv")
+		fmt.Fprint(w, "Issue key,Archived by\nEX-1,fred\n")
+	})
+
+	resp, err := testClient.Issue.ExportArchived(context.Background(), &ArchivedIssuesExportOptions{
+		Projects:      []string{"EX", "ABC"},
+		ArchivedAfter: "2024-01-01",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	defer resp.Body.Close()
+
+	csv, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if string(csv) != "Issue key,Archived by\nEX-1,fred\n" {
+		t.Errorf("Unexpected export %q", csv)
+	}
+}
@@ -0,0 +1,160 @@
+package onpremise
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/trivago/tgo/tcontainer"
+)
+
+// IssueBuilder assembles the payload for IssueService.Create step by step.
+// It removes the need to hand craft the nested Issue and IssueFields structs.
+//
+// Example:
+//
+//	issue, err := jira.NewIssueBuilder("PROJ", "Bug").
+//		Summary("Login fails").
+//		Description("Steps to reproduce ...").
+//		Label("backend").
+//		CustomField("customfield_10016", 5).
+//		Build()
+type IssueBuilder struct {
+	fields       *IssueFields
+	customFields map[string]interface{}
+	meta         *MetaIssueType
+}
+
+// NewIssueBuilder returns a builder for an issue of the given issue type (by name) in the project (by key).
+func NewIssueBuilder(projectKey, issueTypeName string) *IssueBuilder {
+	return &IssueBuilder{
+		fields: &IssueFields{
+			Project: Project{Key: projectKey},
+			Type:    IssueType{Name: issueTypeName},
+		},
+		customFields: map[string]interface{}{},
+	}
+}
+
+// Summary sets the summary of the issue.
+func (b *IssueBuilder) Summary(summary string) *IssueBuilder {
+	b.fields.Summary = summary
+	return b
+}
+
+// Description sets the description of the issue.
+func (b *IssueBuilder) Description(description string) *IssueBuilder {
+	b.fields.Description = description
+	return b
+}
+
+// Label adds one or more labels to the issue.
+func (b *IssueBuilder) Label(labels ...string) *IssueBuilder {
+	b.fields.Labels = append(b.fields.Labels, labels...)
+	return b
+}
+
+// Component adds one or more components (by name) to the issue.
+func (b *IssueBuilder) Component(names ...string) *IssueBuilder {
+	for _, name := range names {
+		b.fields.Components = append(b.fields.Components, &Component{Name: name})
+	}
+	return b
+}
+
+// FixVersion adds one or more fix versions (by name) to the issue.
+func (b *IssueBuilder) FixVersion(names ...string) *IssueBuilder {
+	for _, name := range names {
+		b.fields.FixVersions = append(b.fields.FixVersions, &FixVersion{Name: name})
+	}
+	return b
+}
+
+// Priority sets the priority (by name) of the issue.
+func (b *IssueBuilder) Priority(name string) *IssueBuilder {
+	b.fields.Priority = &Priority{Name: name}
+	return b
+}
+
+// Assignee sets the assignee (by username) of the issue.
+func (b *IssueBuilder) Assignee(username string) *IssueBuilder {
+	b.fields.Assignee = &User{Name: username}
+	return b
+}
+
+// Reporter sets the reporter (by username) of the issue.
+func (b *IssueBuilder) Reporter(username string) *IssueBuilder {
+	b.fields.Reporter = &User{Name: username}
+	return b
+}
+
+// Parent sets the parent issue (by key).
+// This is required when creating a sub-task.
+func (b *IssueBuilder) Parent(issueKey string) *IssueBuilder {
+	b.fields.Parent = &Parent{Key: issueKey}
+	return b
+}
+
+// CustomField sets the value of a custom field.
+// field is either the field ID (like "customfield_10016") or the name of the field (like "Story Points").
+// Field names are resolved while building and require the create metadata that was set via Meta.
+func (b *IssueBuilder) CustomField(field string, value interface{}) *IssueBuilder {
+	b.customFields[field] = value
+	return b
+}
+
+// Meta sets the create metadata of the issue type.
+// It is used to resolve custom field names to their field IDs.
+// See IssueService.GetCreateMeta.
+func (b *IssueBuilder) Meta(meta *MetaIssueType) *IssueBuilder {
+	b.meta = meta
+	return b
+}
+
+// Build validates the collected values and returns the issue payload for IssueService.Create.
+func (b *IssueBuilder) Build() (*Issue, error) {
+	if b.fields.Project.Key == "" {
+		return nil, errors.New("issue builder: project key must not be empty")
+	}
+	if b.fields.Type.Name == "" {
+		return nil, errors.New("issue builder: issue type must not be empty")
+	}
+	if b.fields.Summary == "" {
+		return nil, errors.New("issue builder: summary must not be empty")
+	}
+
+	fields := *b.fields
+	if len(b.customFields) > 0 {
+		fields.Unknowns = tcontainer.NewMarshalMap()
+		for field, value := range b.customFields {
+			id, err := b.resolveField(field)
+			if err != nil {
+				return nil, err
+			}
+			fields.Unknowns[id] = value
+		}
+	}
+
+	return &Issue{Fields: &fields}, nil
+}
+
+// resolveField maps a field name to its field ID.
+// Field IDs are returned as they are.
+func (b *IssueBuilder) resolveField(field string) (string, error) {
+	if strings.HasPrefix(field, "customfield_") {
+		return field, nil
+	}
+	if b.meta == nil {
+		return "", fmt.Errorf("issue builder: can not resolve field %q without create metadata, set it via Meta", field)
+	}
+
+	allFields, err := b.meta.GetAllFields()
+	if err != nil {
+		return "", err
+	}
+	id, found := allFields[field]
+	if !found {
+		return "", fmt.Errorf("issue builder: field %q is not available for issue type %q", field, b.fields.Type.Name)
+	}
+	return id, nil
+}
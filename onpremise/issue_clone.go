@@ -0,0 +1,290 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// CloneOptions specifies the optional parameters for IssueService.Clone.
+type CloneOptions struct {
+	// ProjectKey is the project the clone is created in. By default, it is the project of the source issue.
+	// The issue type of the source issue has to be available in the project.
+	ProjectKey string
+	// SummaryPrefix is put in front of the summary of the clone, like "CLONE - ".
+	SummaryPrefix string
+
+	// Fields is the allow list of fields (by ID) copied to the clone. By default, all fields are copied.
+	// The project, issue type and parent of the clone are always set.
+	Fields []string
+	// ExcludeFields is the deny list of fields (by ID) that are not copied to the clone.
+	ExcludeFields []string
+
+	// Comments copies the comments. Their author and creation date are not preserved.
+	Comments bool
+	// Attachments copies the attachments. They are streamed from the source to the clone.
+	Attachments bool
+	// Links copies the issue links.
+	Links bool
+	// Subtasks clones the sub-tasks of the source issue as sub-tasks of the clone, with the same options.
+	Subtasks bool
+	// LinkType is the name of the issue link type linking the clone to the source issue, like "Cloners".
+	// By default, no link is created.
+	LinkType string
+}
+
+// Clone creates a copy of the issue sourceKey and returns it.
+//
+// Only fields that can be set on the create screen of the clone are copied.
+// Users are referenced by username, other objects like components or options by ID.
+//
+// Cloning requires several requests. If copying comments, attachments, links or sub-tasks fails after
+// the clone was created, the clone is returned together with the error, so that callers can clean up.
+func (s *IssueService) Clone(ctx context.Context, sourceKey string, options *CloneOptions) (*Issue, error) {
+	opts := CloneOptions{}
+	if options != nil {
+		opts = *options
+	}
+	return s.clone(ctx, sourceKey, &opts, "")
+}
+
+func (s *IssueService) clone(ctx context.Context, sourceKey string, opts *CloneOptions, parentKey string) (*Issue, error) {
+	source, sourceFields, err := s.getCloneSource(ctx, sourceKey)
+	if err != nil {
+		return nil, err
+	}
+	if source.Fields == nil {
+		return nil, fmt.Errorf("issue %s has no fields", sourceKey)
+	}
+
+	projectKey := opts.ProjectKey
+	if projectKey == "" {
+		projectKey = source.Fields.Project.Key
+	}
+	creatable, err := s.creatableFields(ctx, projectKey, source.Fields.Type.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]interface{}{}
+	for id, value := range sourceFields {
+		if value == nil || !creatable[id] || !opts.copiesField(id) {
+			continue
+		}
+		fields[id] = cloneValue(id, value)
+	}
+	fields["project"] = map[string]interface{}{"key": projectKey}
+	fields["issuetype"] = map[string]interface{}{"id": source.Fields.Type.ID}
+	if parentKey != "" {
+		fields["parent"] = map[string]interface{}{"key": parentKey}
+	}
+	if summary, ok := fields["summary"].(string); ok {
+		fields["summary"] = opts.SummaryPrefix + summary
+	}
+
+	clone, _, err := s.Create(ctx, &Issue{Fields: &IssueFields{Unknowns: fields}})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Comments {
+		if err := s.cloneComments(ctx, sourceKey, clone.Key); err != nil {
+			return clone, err
+		}
+	}
+	if opts.Attachments {
+		for _, attachment := range source.Fields.Attachments {
+			if err := s.cloneAttachment(ctx, attachment, clone.Key); err != nil {
+				return clone, err
+			}
+		}
+	}
+	if opts.Links {
+		for _, link := range source.Fields.IssueLinks {
+			if err := s.addCloneLink(ctx, cloneLink(link, clone.Key)); err != nil {
+				return clone, err
+			}
+		}
+	}
+	if opts.LinkType != "" {
+		// In the issue link payload, the inward issue is described by the outward description of the type, like "clones".
+		link := &IssueLink{
+			Type:         IssueLinkType{Name: opts.LinkType},
+			InwardIssue:  &Issue{Key: clone.Key},
+			OutwardIssue: &Issue{Key: source.Key},
+		}
+		if err := s.addCloneLink(ctx, link); err != nil {
+			return clone, err
+		}
+	}
+	if opts.Subtasks && parentKey == "" {
+		for _, subtask := range source.Fields.Subtasks {
+			if _, err := s.clone(ctx, subtask.Key, opts, clone.Key); err != nil {
+				return clone, err
+			}
+		}
+	}
+	return clone, nil
+}
+
+// getCloneSource returns the issue key, as well as its fields as they were returned by Jira.
+func (s *IssueService) getCloneSource(ctx context.Context, key string) (*Issue, map[string]interface{}, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", url.PathEscape(key))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var raw json.RawMessage
+	resp, err := s.client.Do(req, &raw)
+	if err != nil {
+		return nil, nil, NewJiraError(resp, err)
+	}
+
+	issue := new(Issue)
+	if err := json.Unmarshal(raw, issue); err != nil {
+		return nil, nil, err
+	}
+	var fields struct {
+		Fields map[string]interface{} `json:"fields"`
+	}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, nil, err
+	}
+	return issue, fields.Fields, nil
+}
+
+// creatableFields returns the IDs of the fields that can be set when creating an issue of the issue type in the project.
+func (s *IssueService) creatableFields(ctx context.Context, projectKey, issueTypeID string) (map[string]bool, error) {
+	creatable := map[string]bool{}
+	opts := &CreateMetaOptions{}
+	for {
+		page, _, err := s.GetCreateMetaFields(ctx, projectKey, issueTypeID, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range page.Fields {
+			creatable[field.FieldID] = true
+		}
+
+		opts.StartAt += len(page.Fields)
+		if len(page.Fields) == 0 || opts.StartAt >= page.Total {
+			return creatable, nil
+		}
+	}
+}
+
+// copiesField reports whether the field id is copied according to the allow and deny lists.
+func (o *CloneOptions) copiesField(id string) bool {
+	switch id {
+	case "project", "issuetype", "parent":
+		// set by IssueService.Clone itself
+		return false
+	}
+	for _, excluded := range o.ExcludeFields {
+		if excluded == id {
+			return false
+		}
+	}
+	if len(o.Fields) == 0 {
+		return true
+	}
+	for _, allowed := range o.Fields {
+		if allowed == id {
+			return true
+		}
+	}
+	return false
+}
+
+// cloneValue reduces a field value as returned by Jira to a value accepted when creating an issue.
+func cloneValue(field string, value interface{}) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		values := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			values = append(values, cloneValue(field, item))
+		}
+		return values
+	case map[string]interface{}:
+		if field == "timetracking" {
+			estimates := map[string]interface{}{}
+			for _, key := range []string{"originalEstimate", "remainingEstimate"} {
+				if estimate, ok := v[key]; ok {
+					estimates[key] = estimate
+				}
+			}
+			return estimates
+		}
+		for _, key := range []string{"accountId", "id", "name", "value"} {
+			if ref, ok := v[key]; ok {
+				reduced := map[string]interface{}{key: ref}
+				// the child of a cascading select
+				if child, ok := v["child"]; ok {
+					reduced["child"] = cloneValue(field, child)
+				}
+				return reduced
+			}
+		}
+	}
+	return value
+}
+
+// cloneLink returns link of the source issue as link of the issue cloneKey.
+func cloneLink(link *IssueLink, cloneKey string) *IssueLink {
+	clone := &IssueLink{Type: IssueLinkType{Name: link.Type.Name}}
+	if link.OutwardIssue != nil {
+		clone.InwardIssue = &Issue{Key: cloneKey}
+		clone.OutwardIssue = &Issue{Key: link.OutwardIssue.Key}
+	} else if link.InwardIssue != nil {
+		clone.InwardIssue = &Issue{Key: link.InwardIssue.Key}
+		clone.OutwardIssue = &Issue{Key: cloneKey}
+	}
+	return clone
+}
+
+// addCloneLink adds link and closes the response, which has no content.
+func (s *IssueService) addCloneLink(ctx context.Context, link *IssueLink) error {
+	resp, err := s.AddLink(ctx, link)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.Body.Close()
+}
+
+// cloneComments adds all comments of the issue sourceKey to the issue cloneKey, oldest first.
+func (s *IssueService) cloneComments(ctx context.Context, sourceKey, cloneKey string) error {
+	opts := &GetCommentsOptions{OrderBy: "created"}
+	for {
+		page, _, err := s.GetComments(ctx, sourceKey, opts)
+		if err != nil {
+			return err
+		}
+		for _, comment := range page.Comments {
+			if _, _, err := s.AddComment(ctx, cloneKey, &Comment{Body: comment.Body, Visibility: comment.Visibility}); err != nil {
+				return err
+			}
+		}
+
+		opts.StartAt += len(page.Comments)
+		if len(page.Comments) == 0 || opts.StartAt >= page.Total {
+			return nil
+		}
+	}
+}
+
+// cloneAttachment streams the content of attachment to a new attachment of the issue cloneKey.
+func (s *IssueService) cloneAttachment(ctx context.Context, attachment *Attachment, cloneKey string) error {
+	resp, err := s.DownloadAttachment(ctx, attachment.ID)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _, err = s.AddAttachment(ctx, cloneKey, attachment.Filename, resp.Body)
+	return err
+}
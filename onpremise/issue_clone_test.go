@@ -0,0 +1,155 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIssueService_Clone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":"10001","key":"EX-1","fields":{
+			"summary":"Login fails","project":{"id":"10000","key":"EX"},"issuetype":{"id":"10004","name":"Bug"},
+			"status":{"id":"1","name":"Open"},"labels":["auth"],"environment":null,
+			"assignee":{"key":"JIRAUSER10100","name":"fred","displayName":"Fred"},
+			"components":[{"self":"https://jira.example.com/rest/api/2/component/10100","id":"10100","name":"Web"}],
+			"customfield_10010":{"self":"https://jira.example.com/rest/api/2/customFieldOption/10200","value":"Hardware","id":"10200","child":{"value":"Keyboard","id":"10201"}},
+			"customfield_10011":"internal",
+			"timetracking":{"originalEstimate":"1d","remainingEstimate":"4h","originalEstimateSeconds":28800},
+			"attachment":[{"id":"10300","filename":"screenshot.png"}],
+			"issuelinks":[{"id":"10400","type":{"name":"Blocks"},"outwardIssue":{"key":"EX-9"}}],
+			"subtasks":[{"id":"10002","key":"EX-2"}]}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":"10002","key":"EX-2","fields":{"summary":"Fix it","project":{"key":"EX"},"issuetype":{"id":"10005","name":"Sub-task"},"parent":{"key":"EX-1"}}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/createmeta/EX/issuetypes/10004", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":9,"isLast":true,"values":[{"fieldId":"summary"},{"fieldId":"project"},{"fieldId":"issuetype"},
+			{"fieldId":"labels"},{"fieldId":"assignee"},{"fieldId":"components"},{"fieldId":"customfield_10010"},{"fieldId":"customfield_10011"},{"fieldId":"timetracking"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/createmeta/EX/issuetypes/10005", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":4,"isLast":true,"values":[{"fieldId":"summary"},{"fieldId":"project"},{"fieldId":"issuetype"},{"fieldId":"parent"}]}`)
+	})
+
+	var created []map[string]interface{}
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body map[string]map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		created = append(created, body["fields"])
+		fmt.Fprintf(w, `{"id":"%d","key":"EX-%d"}`, 10010+len(created), 10+len(created))
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/comment?orderBy=created")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"comments":[{"id":"1","body":"Reproduced","visibility":{"type":"role","value":"Developers"}}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-2/comment", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":0,"comments":[]}`)
+	})
+	var comments []Comment
+	testMux.HandleFunc("/rest/api/2/issue/EX-11/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var comment Comment
+		if err := json.NewDecoder(r.Body).Decode(&comment); err != nil {
+			t.Fatal(err)
+		}
+		comments = append(comments, comment)
+		fmt.Fprint(w, `{"id":"2"}`)
+	})
+	testMux.HandleFunc("/secure/attachment/10300/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, "PNG")
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-11/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := io.ReadAll(file)
+		if header.Filename != "screenshot.png" || string(content) != "PNG" {
+			t.Errorf("Unexpected attachment %s: %s", header.Filename, content)
+		}
+		fmt.Fprint(w, `[{"id":"10301","filename":"screenshot.png"}]`)
+	})
+	var links []IssueLink
+	testMux.HandleFunc("/rest/api/2/issueLink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var link IssueLink
+		if err := json.NewDecoder(r.Body).Decode(&link); err != nil {
+			t.Fatal(err)
+		}
+		links = append(links, link)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	clone, err := testClient.Issue.Clone(context.Background(), "EX-1", &CloneOptions{
+		SummaryPrefix: "CLONE - ",
+		ExcludeFields: []string{"customfield_10011"},
+		Comments:      true,
+		Attachments:   true,
+		Links:         true,
+		Subtasks:      true,
+		LinkType:      "Cloners",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if clone.Key != "EX-11" {
+		t.Errorf("Expected clone EX-11, got %s", clone.Key)
+	}
+
+	if len(created) != 2 {
+		t.Fatalf("Expected the clone and its sub-task to be created, got %d issues", len(created))
+	}
+	want := map[string]interface{}{
+		"summary":           "CLONE - Login fails",
+		"project":           map[string]interface{}{"key": "EX"},
+		"issuetype":         map[string]interface{}{"id": "10004"},
+		"labels":            []interface{}{"auth"},
+		"assignee":          map[string]interface{}{"name": "fred"},
+		"components":        []interface{}{map[string]interface{}{"id": "10100"}},
+		"customfield_10010": map[string]interface{}{"id": "10200", "child": map[string]interface{}{"id": "10201"}},
+		"timetracking":      map[string]interface{}{"originalEstimate": "1d", "remainingEstimate": "4h"},
+	}
+	if !reflect.DeepEqual(created[0], want) {
+		t.Errorf("Unexpected fields of the clone\ngot:  %v\nwant: %v", created[0], want)
+	}
+	wantSubtask := map[string]interface{}{
+		"summary":   "CLONE - Fix it",
+		"project":   map[string]interface{}{"key": "EX"},
+		"issuetype": map[string]interface{}{"id": "10005"},
+		"parent":    map[string]interface{}{"key": "EX-11"},
+	}
+	if !reflect.DeepEqual(created[1], wantSubtask) {
+		t.Errorf("Unexpected fields of the sub-task clone\ngot:  %v\nwant: %v", created[1], wantSubtask)
+	}
+
+	if len(comments) != 1 || comments[0].Body != "Reproduced" || comments[0].Visibility.Value != "Developers" {
+		t.Errorf("Unexpected comments %+v", comments)
+	}
+	if len(links) != 3 {
+		t.Fatalf("Expected 3 links, got %+v", links)
+	}
+	if links[0].Type.Name != "Blocks" || links[0].InwardIssue.Key != "EX-11" || links[0].OutwardIssue.Key != "EX-9" {
+		t.Errorf("Unexpected copied link %+v", links[0])
+	}
+	if links[1].Type.Name != "Cloners" || links[1].InwardIssue.Key != "EX-11" || links[1].OutwardIssue.Key != "EX-1" {
+		t.Errorf("Unexpected link to the source %+v", links[1])
+	}
+	if links[2].InwardIssue.Key != "EX-12" || links[2].OutwardIssue.Key != "EX-2" {
+		t.Errorf("Unexpected link of the sub-task to its source %+v", links[2])
+	}
+}
@@ -0,0 +1,64 @@
+package onpremise
+
+import (
+	"testing"
+)
+
+func TestDiffIssues(t *testing.T) {
+	a := &Issue{
+		Key: "EX-1",
+		Fields: &IssueFields{
+			Summary:  "Login fails",
+			Labels:   []string{"backend"},
+			Priority: &Priority{Name: "High"},
+			Unknowns: map[string]interface{}{"customfield_10016": 3, "customfield_10020": "old"},
+		},
+	}
+	b := &Issue{
+		Key: "EX-1",
+		Fields: &IssueFields{
+			Summary:     "Login fails",
+			Description: "Steps to reproduce",
+			Labels:      []string{"backend", "auth"},
+			Unknowns:    map[string]interface{}{"customfield_10016": 5, "customfield_10020": nil},
+		},
+	}
+
+	changes, err := DiffIssues(a, b)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	want := []string{"customfield_10016", "customfield_10020", "description", "labels", "priority"}
+	if len(changes) != len(want) {
+		t.Fatalf("Expected %d changes. Got %+v", len(want), changes)
+	}
+	for i, field := range want {
+		if changes[i].Field != field {
+			t.Errorf("Expected change %d for field %s. Got %s", i, field, changes[i].Field)
+		}
+	}
+
+	if changes[0].From != float64(3) || changes[0].To != float64(5) {
+		t.Errorf("Unexpected change of customfield_10016: %+v", changes[0])
+	}
+	if changes[1].To != nil {
+		t.Errorf("Expected customfield_10020 to be removed. Got %+v", changes[1])
+	}
+	if changes[2].From != nil || changes[2].To != "Steps to reproduce" {
+		t.Errorf("Expected description to be added. Got %+v", changes[2])
+	}
+}
+
+func TestDiffIssues_NoChanges(t *testing.T) {
+	a := &Issue{Fields: &IssueFields{Summary: "Login fails"}}
+	b := &Issue{Fields: &IssueFields{Summary: "Login fails"}}
+
+	changes, err := DiffIssues(a, b)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("Expected no changes. Got %+v", changes)
+	}
+}
@@ -0,0 +1,34 @@
+package onpremise
+
+import (
+	"context"
+)
+
+// EpicFields returns the fields that link an issue to the epic epicKey.
+// Issues reference their epic via the "Epic Link" custom field of Jira Software,
+// or via the parent field, if the instance has no such field.
+//
+// The fields can be set via IssueBuilder.CustomField on creation or via IssueService.UpdateIssue.
+func (s *IssueService) EpicFields(ctx context.Context, epicKey string) (map[string]interface{}, error) {
+	field, _, err := s.client.Field.GetEpicLinkField(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if field != nil {
+		return map[string]interface{}{field.ID: epicKey}, nil
+	}
+	return map[string]interface{}{"parent": map[string]interface{}{"key": epicKey}}, nil
+}
+
+// SetEpic links the issue issueKey to the epic epicKey,
+// using the mechanism described at IssueService.EpicFields.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-editIssue
+// Caller must close resp.Body
+func (s *IssueService) SetEpic(ctx context.Context, issueKey, epicKey string) (*Response, error) {
+	fields, err := s.EpicFields(ctx, epicKey)
+	if err != nil {
+		return nil, err
+	}
+	return s.UpdateIssue(ctx, issueKey, map[string]interface{}{"fields": fields})
+}
@@ -0,0 +1,50 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIssueService_EpicFields(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":"summary","name":"Summary","schema":{"type":"string","system":"summary"}}]`)
+	})
+
+	fields, err := testClient.Issue.EpicFields(context.Background(), "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := map[string]interface{}{"parent": map[string]interface{}{"key": "EX-1"}}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("Expected the parent field without epic link field, got %v", fields)
+	}
+}
+
+func TestIssueService_SetEpic(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"customfield_10100","name":"Epic Link","custom":true,"schema":{"type":"any","custom":"com.pyxis.greenhopper.jira:gh-epic-link","customId":10100}}]`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var body map[string]map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if got := body["fields"]["customfield_10100"]; got != "EX-1" {
+			t.Errorf("Expected the epic link to be set, got %v", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.SetEpic(context.Background(), "EX-2", "EX-1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,38 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIssueService_GetPickerSuggestions(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issue/picker"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint+"?currentJQL=project+%3D+EX&currentProjectId=10000&query=login")
+		fmt.Fprint(w, `{"sections":[
+			{"label":"History Search","sub":"Showing 1 of 1 matching issues","id":"hs","issues":[{"id":10001,"key":"EX-1","keyHtml":"EX-1","img":"/images/icons/issuetypes/bug.svg","summary":"<b>Login</b> fails","summaryText":"Login fails"}]},
+			{"label":"Current Search","id":"cs","msg":"No issues found","issues":[]}
+		]}`)
+	})
+
+	sections, _, err := testClient.Issue.GetPickerSuggestions(context.Background(), &IssuePickerOptions{
+		Query:            "login",
+		CurrentJQL:       "project = EX",
+		CurrentProjectID: "10000",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(sections) != 2 || sections[0].ID != "hs" || sections[1].Msg != "No issues found" {
+		t.Fatalf("Unexpected sections %+v", sections)
+	}
+	if issue := sections[0].Issues[0]; issue.Key != "EX-1" || issue.ID != 10001 || issue.SummaryText != "Login fails" || issue.SummaryHTML != "<b>Login</b> fails" {
+		t.Errorf("Unexpected issue %+v", issue)
+	}
+}
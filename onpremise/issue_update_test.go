@@ -0,0 +1,142 @@
+package onpremise
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNewUpdatePayload(t *testing.T) {
+	original := &Issue{
+		Fields: &IssueFields{
+			Summary:    "Login fails",
+			Labels:     []string{"backend", "legacy"},
+			Components: []*Component{{ID: "10000", Name: "API"}},
+			Status:     &Status{Name: "Open"},
+			Unknowns:   map[string]interface{}{"customfield_10016": 3},
+		},
+	}
+	modified := &Issue{
+		Fields: &IssueFields{
+			Summary:    "Login fails on Safari",
+			Labels:     []string{"backend", "auth"},
+			Components: []*Component{{ID: "10000", Name: "API"}, {Name: "Web"}},
+			Status:     &Status{Name: "Done"},
+			Unknowns:   map[string]interface{}{"customfield_10016": 5},
+		},
+	}
+
+	payload, err := NewUpdatePayload(original, modified)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	want := map[string]interface{}{
+		"fields": map[string]interface{}{
+			"summary":           "Login fails on Safari",
+			"customfield_10016": float64(5),
+		},
+		"update": map[string]interface{}{
+			"labels": []map[string]interface{}{
+				{"remove": "legacy"},
+				{"add": "auth"},
+			},
+			"components": []map[string]interface{}{
+				{"add": map[string]interface{}{"name": "Web"}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Errorf("NewUpdatePayload() = %+v, want %+v", payload, want)
+	}
+}
+
+func TestNewUpdatePayload_FetchedIssues(t *testing.T) {
+	// Two snapshots of the same issue, as returned by IssueService.Get.
+	// Besides the labels, only fields maintained by Jira changed.
+	snapshots := []string{
+		`{"id":"10002","key":"EX-1","fields":{"summary":"Login fails","labels":["backend"],"lastViewed":"2023-03-01T10:00:00.000+0000","workratio":-1,` +
+			`"votes":{"self":"https://example.atlassian.net/rest/api/2/issue/EX-1/votes","votes":0,"hasVoted":false},` +
+			`"watches":{"self":"https://example.atlassian.net/rest/api/2/issue/EX-1/watchers","watchCount":1,"isWatching":false},` +
+			`"updated":"2023-03-01T09:00:00.000+0000"}}`,
+		`{"id":"10002","key":"EX-1","fields":{"summary":"Login fails","labels":["backend","auth"],"lastViewed":"2023-03-02T11:30:00.000+0000","workratio":20,` +
+			`"votes":{"self":"https://example.atlassian.net/rest/api/2/issue/EX-1/votes","votes":1,"hasVoted":true},` +
+			`"watches":{"self":"https://example.atlassian.net/rest/api/2/issue/EX-1/watchers","watchCount":2,"isWatching":true},` +
+			`"updated":"2023-03-02T11:00:00.000+0000"}}`,
+	}
+	issues := make([]*Issue, len(snapshots))
+	for i, snapshot := range snapshots {
+		issues[i] = new(Issue)
+		if err := json.Unmarshal([]byte(snapshot), issues[i]); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+	}
+
+	payload, err := NewUpdatePayload(issues[0], issues[1])
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	want := map[string]interface{}{
+		"update": map[string]interface{}{
+			"labels": []map[string]interface{}{{"add": "auth"}},
+		},
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Errorf("NewUpdatePayload() = %+v, want %+v", payload, want)
+	}
+}
+
+func TestNewUpdatePayload_NoChanges(t *testing.T) {
+	issue := &Issue{Fields: &IssueFields{Summary: "Login fails"}}
+
+	payload, err := NewUpdatePayload(issue, issue)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(payload) != 0 {
+		t.Errorf("Expected an empty payload. Got %+v", payload)
+	}
+}
+
+func TestUpdateBuilder_Build(t *testing.T) {
+	payload, err := NewUpdateBuilder().
+		AddLabel("x").
+		RemoveLabel("y").
+		AddComponent("Web").
+		Edit("timetracking", map[string]interface{}{"remainingEstimate": "4d"}).
+		Field("summary", "Login fails on Safari").
+		Build()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	got, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := `{"fields":{"summary":"Login fails on Safari"},` +
+		`"update":{"components":[{"add":{"name":"Web"}}],"labels":[{"add":"x"},{"remove":"y"}],"timetracking":[{"edit":{"remainingEstimate":"4d"}}]}}`
+	if string(got) != want {
+		t.Errorf("Unexpected payload\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+func TestUpdateBuilder_Build_Empty(t *testing.T) {
+	payload, err := NewUpdateBuilder().Build()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(payload) != 0 {
+		t.Errorf("Expected an empty payload, got %v", payload)
+	}
+}
+
+func TestUpdateBuilder_Build_Invalid(t *testing.T) {
+	if _, err := NewUpdateBuilder().Add("", "x").Build(); err == nil {
+		t.Error("Expected an error for an operation without field")
+	}
+	if _, err := NewUpdateBuilder().AddLabel("x").Field("labels", []string{"y"}).Build(); err == nil {
+		t.Error("Expected an error for a field changed in both notations")
+	}
+}
@@ -3,7 +3,9 @@ package onpremise
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -12,6 +14,19 @@ import (
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-group-Issue-link-types
 type IssueLinkTypeService service
 
+// IssueLinkDirection represents the direction of an issue link.
+type IssueLinkDirection string
+
+const (
+	IssueLinkDirectionInward  IssueLinkDirection = "inward"
+	IssueLinkDirectionOutward IssueLinkDirection = "outward"
+)
+
+// IsValid reports whether d is an issue link direction known to Jira.
+func (d IssueLinkDirection) IsValid() bool {
+	return d == IssueLinkDirectionInward || d == IssueLinkDirectionOutward
+}
+
 // GetList gets all of the issue link types from Jira.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-issueLinkType-get
@@ -55,41 +70,39 @@ func (s *IssueLinkTypeService) Get(ctx context.Context, ID string) (*IssueLinkTy
 }
 
 // Create creates an issue link type in Jira.
+// Name, Inward and Outward of linkType are required.
+// The returned issue link type is the one created by Jira, including its ID.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-issueLinkType-post
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueLinkTypeService) Create(ctx context.Context, linkType *IssueLinkType) (*IssueLinkType, *Response, error) {
-	apiEndpoint := "/rest/api/2/issueLinkType"
-	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, linkType)
-	if err != nil {
-		return nil, nil, err
+	if linkType == nil || linkType.Name == "" || linkType.Inward == "" || linkType.Outward == "" {
+		return nil, nil, errors.New("jira: issue link type requires a name, an inward and an outward description")
 	}
 
-	resp, err := s.client.Do(req, nil)
+	apiEndpoint := "rest/api/2/issueLinkType"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, linkType)
 	if err != nil {
-		return nil, resp, err
+		return nil, nil, err
 	}
-	defer resp.Body.Close()
 
 	responseLinkType := new(IssueLinkType)
-	err = json.NewDecoder(resp.Body).Decode(&responseLinkType)
+	resp, err := s.client.Do(req, responseLinkType)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, NewJiraError(resp, err)
 	}
 
-	return linkType, resp, nil
+	return responseLinkType, resp, nil
 }
 
-// Update updates an issue link type.  The issue is found by key.
+// Update updates an issue link type. The issue link type is found by linkType.ID.
+// The returned issue link type is the one stored by Jira.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-issueLinkType-issueLinkTypeId-put
-// Caller must close resp.Body
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueLinkTypeService) Update(ctx context.Context, linkType *IssueLinkType) (*IssueLinkType, *Response, error) {
+	if linkType == nil || linkType.ID == "" {
+		return nil, nil, errors.New("jira: issue link type requires an ID to be updated")
+	}
+
 	apiEndpoint := fmt.Sprintf("rest/api/2/issueLinkType/%s", linkType.ID)
 	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, linkType)
 	if err != nil {
@@ -99,17 +112,19 @@ func (s *IssueLinkTypeService) Update(ctx context.Context, linkType *IssueLinkTy
 	if err != nil {
 		return nil, resp, NewJiraError(resp, err)
 	}
+	defer resp.Body.Close()
+
+	// Older Jira versions answer without a body
 	ret := *linkType
+	if err := json.NewDecoder(resp.Body).Decode(&ret); err != nil && err != io.EOF {
+		return nil, resp, err
+	}
 	return &ret, resp, nil
 }
 
 // Delete deletes an issue link type based on provided ID.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-issueLinkType-issueLinkTypeId-delete
-// Caller must close resp.Body
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueLinkTypeService) Delete(ctx context.Context, ID string) (*Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issueLinkType/%s", ID)
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
@@ -118,5 +133,8 @@ func (s *IssueLinkTypeService) Delete(ctx context.Context, ID string) (*Response
 	}
 
 	resp, err := s.client.Do(req, nil)
-	return resp, err
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
 }
@@ -72,6 +72,36 @@ func TestIssueLinkTypeService_Create(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	} else if linkType == nil {
 		t.Error("Expected linkType. LinkType is nil")
+	} else if linkType.ID != "10021" {
+		t.Errorf("Expected the created linkType with ID 10021. Got %q", linkType.ID)
+	}
+}
+
+func TestIssueLinkTypeService_Create_MissingDescriptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, _, err := testClient.IssueLinkType.Create(context.Background(), &IssueLinkType{Name: "Blocks"})
+	if err == nil {
+		t.Error("Expected an error for a link type without inward and outward description")
+	}
+}
+
+func TestIssueLinkTypeService_Update_ReturnsStoredType(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issueLinkType/100", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"id":"100","name":"Problem/Incident","inward":"is caused by","outward":"causes",
+		"self":"https://www.example.com/jira/rest/api/2/issueLinkType/100"}`)
+	})
+
+	linkType, _, err := testClient.IssueLinkType.Update(context.Background(), &IssueLinkType{ID: "100", Name: "Problem/Incident"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if linkType.Outward != "causes" {
+		t.Errorf("Expected the stored linkType. Got %+v", linkType)
 	}
 }
 
@@ -0,0 +1,106 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// IssueSecuritySchemeService handles issue security schemes for the Jira instance / API.
+// Issue security schemes define the security levels restricting who can see an issue.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuesecurityschemes
+type IssueSecuritySchemeService service
+
+// IssueSecurityScheme is an issue security scheme with its security levels.
+type IssueSecurityScheme struct {
+	Self                   string          `json:"self,omitempty" structs:"self,omitempty"`
+	ID                     int64           `json:"id" structs:"id"`
+	Name                   string          `json:"name" structs:"name"`
+	Description            string          `json:"description,omitempty" structs:"description,omitempty"`
+	DefaultSecurityLevelID int64           `json:"defaultSecurityLevelId,omitempty" structs:"defaultSecurityLevelId,omitempty"`
+	Levels                 []SecurityLevel `json:"levels,omitempty" structs:"levels,omitempty"`
+}
+
+// GetList returns all issue security schemes.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuesecurityschemes-getIssueSecuritySchemes
+func (s *IssueSecuritySchemeService) GetList(ctx context.Context) ([]IssueSecurityScheme, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "rest/api/2/issuesecurityschemes", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schemes := new(struct {
+		IssueSecuritySchemes []IssueSecurityScheme `json:"issueSecuritySchemes"`
+	})
+	resp, err := s.client.Do(req, schemes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return schemes.IssueSecuritySchemes, resp, nil
+}
+
+// Get returns the issue security scheme schemeID with its security levels.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuesecurityschemes-getIssueSecurityScheme
+func (s *IssueSecuritySchemeService) Get(ctx context.Context, schemeID string) (*IssueSecurityScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuesecurityschemes/%s", url.PathEscape(schemeID))
+	return s.getScheme(ctx, apiEndpoint)
+}
+
+// GetForProject returns the issue security scheme of the project projectIDOrKey with its security levels.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectKeyOrId}/issuesecuritylevelscheme-getIssueSecurityScheme
+func (s *IssueSecuritySchemeService) GetForProject(ctx context.Context, projectIDOrKey string) (*IssueSecurityScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/issuesecuritylevelscheme", url.PathEscape(projectIDOrKey))
+	return s.getScheme(ctx, apiEndpoint)
+}
+
+func (s *IssueSecuritySchemeService) getScheme(ctx context.Context, apiEndpoint string) (*IssueSecurityScheme, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(IssueSecurityScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// GetLevel returns the security level levelID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/securitylevel-getIssuesecuritylevel
+func (s *IssueSecuritySchemeService) GetLevel(ctx context.Context, levelID string) (*SecurityLevel, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/securitylevel/%s", url.PathEscape(levelID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	level := new(SecurityLevel)
+	resp, err := s.client.Do(req, level)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return level, resp, nil
+}
+
+// SetSecurityLevel sets the security level levelID of the issue issueIDOrKey.
+// The level has to be part of the issue security scheme of the project.
+// An empty levelID removes the security level, making the issue visible to everyone who can browse the project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-editIssue
+func (s *IssueService) SetSecurityLevel(ctx context.Context, issueIDOrKey, levelID string) (*Response, error) {
+	var security interface{}
+	if levelID != "" {
+		security = map[string]interface{}{"id": levelID}
+	}
+	return s.UpdateIssue(ctx, url.PathEscape(issueIDOrKey), map[string]interface{}{
+		"fields": map[string]interface{}{"security": security},
+	})
+}
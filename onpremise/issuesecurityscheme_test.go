@@ -0,0 +1,115 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestIssueSecuritySchemeService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuesecurityschemes"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"issueSecuritySchemes":[{"self":"https://jira.example.com/rest/api/2/issuesecurityschemes/10000","id":10000,"name":"Default Issue Security Scheme","description":"Description for the default issue security scheme","defaultSecurityLevelId":10021}]}`)
+	})
+
+	schemes, _, err := testClient.IssueSecurityScheme.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(schemes) != 1 || schemes[0].ID != 10000 || schemes[0].DefaultSecurityLevelID != 10021 {
+		t.Errorf("Unexpected issue security schemes %+v", schemes)
+	}
+}
+
+func TestIssueSecuritySchemeService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuesecurityschemes/10000"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"id":10000,"name":"Default Issue Security Scheme","defaultSecurityLevelId":10021,"levels":[{"self":"https://jira.example.com/rest/api/2/securitylevel/10021","id":"10021","description":"Only the reporter and internal staff can see this issue.","name":"Reporter Only"}]}`)
+	})
+
+	scheme, _, err := testClient.IssueSecurityScheme.Get(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(scheme.Levels) != 1 || scheme.Levels[0].Name != "Reporter Only" {
+		t.Errorf("Unexpected security levels %+v", scheme.Levels)
+	}
+}
+
+func TestIssueSecuritySchemeService_GetLevel(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/securitylevel/10021"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"self":"https://jira.example.com/rest/api/2/securitylevel/10021","id":"10021","name":"Reporter Only"}`)
+	})
+
+	level, _, err := testClient.IssueSecurityScheme.GetLevel(context.Background(), "10021")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if level.Name != "Reporter Only" {
+		t.Errorf("Unexpected security level %+v", level)
+	}
+}
+
+func TestIssueSecuritySchemeService_GetForProject(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/project/EX/issuesecuritylevelscheme"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"id":10000,"name":"Legal Hold","defaultSecurityLevelId":10021,"levels":[{"id":"10021","name":"Legal"}]}`)
+	})
+
+	scheme, _, err := testClient.IssueSecurityScheme.GetForProject(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if scheme.Name != "Legal Hold" || len(scheme.Levels) != 1 {
+		t.Errorf("Unexpected issue security scheme %+v", scheme)
+	}
+}
+
+func TestIssueService_SetSecurityLevel(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issue/EX-1"
+
+	bodies := []string{}
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.SetSecurityLevel(context.Background(), "EX-1", "10021"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Issue.SetSecurityLevel(context.Background(), "EX-1", ""); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	want := []string{`{"fields":{"security":{"id":"10021"}}}` + "\n", `{"fields":{"security":null}}` + "\n"}
+	if len(bodies) != 2 || bodies[0] != want[0] || bodies[1] != want[1] {
+		t.Errorf("Unexpected request bodies\ngot:  %q\nwant: %q", bodies, want)
+	}
+}
@@ -0,0 +1,175 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// IssueTypeService handles issue types for the Jira instance / API.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype
+type IssueTypeService service
+
+// Kinds of issue types, for IssueTypeCreatePayload.Type
+const (
+	IssueTypeStandard = "standard"
+	IssueTypeSubtask  = "subtask"
+)
+
+// IssueTypeCreatePayload is an issue type created by IssueTypeService.Create.
+type IssueTypeCreatePayload struct {
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// Type is IssueTypeStandard or IssueTypeSubtask. By default, a standard issue type is created.
+	Type string `json:"type,omitempty" structs:"type,omitempty"`
+}
+
+// IssueTypeUpdatePayload are the changes of IssueTypeService.Update. Empty attributes are left unchanged.
+type IssueTypeUpdatePayload struct {
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	AvatarID    int    `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+}
+
+// GetList returns all issue types visible to the user.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-getIssueAllTypes
+func (s *IssueTypeService) GetList(ctx context.Context) ([]IssueType, *Response, error) {
+	issueTypes := []IssueType{}
+	resp, err := s.do(ctx, http.MethodGet, "rest/api/2/issuetype", nil, &issueTypes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issueTypes, resp, nil
+}
+
+// Get returns the issue type issueTypeID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-getIssueType
+func (s *IssueTypeService) Get(ctx context.Context, issueTypeID string) (*IssueType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s", url.PathEscape(issueTypeID))
+	issueType := new(IssueType)
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, issueType)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issueType, resp, nil
+}
+
+// Create creates an issue type and adds it to the default issue type scheme.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-createIssueType
+func (s *IssueTypeService) Create(ctx context.Context, payload *IssueTypeCreatePayload) (*IssueType, *Response, error) {
+	issueType := new(IssueType)
+	resp, err := s.do(ctx, http.MethodPost, "rest/api/2/issuetype", payload, issueType)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issueType, resp, nil
+}
+
+// Update changes the issue type issueTypeID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-updateIssueType
+func (s *IssueTypeService) Update(ctx context.Context, issueTypeID string, payload *IssueTypeUpdatePayload) (*IssueType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s", url.PathEscape(issueTypeID))
+	issueType := new(IssueType)
+	resp, err := s.do(ctx, http.MethodPut, apiEndpoint, payload, issueType)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issueType, resp, nil
+}
+
+// Delete deletes the issue type issueTypeID.
+// If issues of the type exist, they are moved to the issue type alternativeID,
+// see GetAlternatives for the issue types allowed. Otherwise alternativeID can be empty.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-deleteIssueType
+func (s *IssueTypeService) Delete(ctx context.Context, issueTypeID, alternativeID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s", url.PathEscape(issueTypeID))
+	if alternativeID != "" {
+		apiEndpoint += "?alternativeIssueTypeId=" + url.QueryEscape(alternativeID)
+	}
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// GetAlternatives returns the issue types the issues of the issue type issueTypeID can be moved to when it is deleted.
+// These are the issue types using the same workflow, field configuration and screen scheme in all projects.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-getAlternativeIssueTypes
+func (s *IssueTypeService) GetAlternatives(ctx context.Context, issueTypeID string) ([]IssueType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s/alternatives", url.PathEscape(issueTypeID))
+	issueTypes := []IssueType{}
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, &issueTypes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issueTypes, resp, nil
+}
+
+// Avatar represents a custom or system avatar of a user, project or issue type.
+type Avatar struct {
+	ID             string            `json:"id" structs:"id"`
+	Owner          string            `json:"owner,omitempty" structs:"owner,omitempty"`
+	IsSystemAvatar bool              `json:"isSystemAvatar" structs:"isSystemAvatar"`
+	IsSelected     bool              `json:"isSelected" structs:"isSelected"`
+	IsDeletable    bool              `json:"isDeletable" structs:"isDeletable"`
+	URLs           map[string]string `json:"urls,omitempty" structs:"urls,omitempty"`
+}
+
+// AvatarCropping is the square of a temporary avatar used for the avatar.
+type AvatarCropping struct {
+	CropperWidth   int    `json:"cropperWidth" structs:"cropperWidth"`
+	CropperOffsetX int    `json:"cropperOffsetX" structs:"cropperOffsetX"`
+	CropperOffsetY int    `json:"cropperOffsetY" structs:"cropperOffsetY"`
+	URL            string `json:"url,omitempty" structs:"url,omitempty"`
+	NeedsCropping  bool   `json:"needsCropping,omitempty" structs:"needsCropping,omitempty"`
+}
+
+// LoadAvatar uploads image as custom avatar of the issue type issueTypeID.
+// filename is used to determine the format of the image and size is its length in bytes.
+// Jira first stores a temporary avatar, which is then converted into the avatar with the cropping suggested by Jira.
+// The avatar is not selected automatically, use Update with its ID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-storeTemporaryAvatar
+func (s *IssueTypeService) LoadAvatar(ctx context.Context, issueTypeID, filename string, size int64, image io.Reader) (*Avatar, *Response, error) {
+	escapedID := url.PathEscape(issueTypeID)
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s/avatar/temporary?filename=%s&size=%d", escapedID, url.QueryEscape(filename), size)
+	req, err := s.client.NewRawRequest(ctx, http.MethodPost, apiEndpoint, image)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("X-Atlassian-Token", "nocheck")
+
+	cropping := new(AvatarCropping)
+	resp, err := s.client.Do(req, cropping)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	avatar := new(Avatar)
+	resp, err = s.do(ctx, http.MethodPost, fmt.Sprintf("rest/api/2/issuetype/%s/avatar2", escapedID), cropping, avatar)
+	if err != nil {
+		return nil, resp, err
+	}
+	return avatar, resp, nil
+}
+
+// do sends a request with the optional body to apiEndpoint and decodes the response into v, if not nil.
+func (s *IssueTypeService) do(ctx context.Context, method, apiEndpoint string, body, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
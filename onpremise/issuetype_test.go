@@ -0,0 +1,132 @@
+package onpremise
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestIssueTypeService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuetype"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `[{"self":"https://jira.example.com/rest/api/2/issueType/3","id":"3","description":"A task that needs to be done.","iconUrl":"https://jira.example.com/secure/viewavatar?size=xsmall&avatarId=10299&avatarType=issuetype","name":"Task","subtask":false,"avatarId":1},{"self":"https://jira.example.com/rest/api/2/issueType/1","id":"1","description":"A problem with the software.","name":"Bug","subtask":false,"avatarId":10002}]`)
+	})
+
+	issueTypes, _, err := testClient.IssueType.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issueTypes) != 2 || issueTypes[1].Name != "Bug" {
+		t.Errorf("Unexpected issue types %+v", issueTypes)
+	}
+}
+
+func TestIssueTypeService_CreateUpdateDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issuetype", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"name":"Procurement","description":"A purchase request","type":"standard"}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10010","name":"Procurement","description":"A purchase request","subtask":false,"avatarId":10318}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issuetype/10010", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"avatarId":10400}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+			fmt.Fprint(w, `{"id":"10010","name":"Procurement","avatarId":10400}`)
+		case http.MethodDelete:
+			testRequestURL(t, r, "/rest/api/2/issuetype/10010?alternativeIssueTypeId=3")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	issueType, _, err := testClient.IssueType.Create(context.Background(), &IssueTypeCreatePayload{Name: "Procurement", Description: "A purchase request", Type: IssueTypeStandard})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	issueType, _, err = testClient.IssueType.Update(context.Background(), issueType.ID, &IssueTypeUpdatePayload{AvatarID: 10400})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issueType.AvatarID != 10400 {
+		t.Errorf("Expected avatar 10400, got %d", issueType.AvatarID)
+	}
+	if _, err := testClient.IssueType.Delete(context.Background(), issueType.ID, "3"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueTypeService_GetAlternatives(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/issuetype/10010/alternatives"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `[{"id":"3","name":"Task","subtask":false}]`)
+	})
+
+	issueTypes, _, err := testClient.IssueType.GetAlternatives(context.Background(), "10010")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issueTypes) != 1 || issueTypes[0].ID != "3" {
+		t.Errorf("Unexpected alternatives %+v", issueTypes)
+	}
+}
+
+func TestIssueTypeService_LoadAvatar(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issuetype/10010/avatar/temporary", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, "/rest/api/2/issuetype/10010/avatar/temporary?filename=procurement.png&size=3")
+		if got := r.Header.Get("X-Atlassian-Token"); got != "nocheck" {
+			t.Errorf("Expected X-Atlassian-Token nocheck, got %s", got)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "png" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"cropperWidth":120,"cropperOffsetX":50,"cropperOffsetY":50,"url":"https://jira.example.com/secure/temporaryavatar?cropped=true","needsCropping":true}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issuetype/10010/avatar2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		want := `{"cropperWidth":120,"cropperOffsetX":50,"cropperOffsetY":50,"url":"https://jira.example.com/secure/temporaryavatar?cropped=true","needsCropping":true}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body\ngot:  %s\nwant: %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10400","owner":"10010","isSystemAvatar":false,"isSelected":false,"isDeletable":true,"urls":{"16x16":"https://jira.example.com/secure/viewavatar?size=xsmall&avatarId=10400&avatarType=issuetype"}}`)
+	})
+
+	avatar, _, err := testClient.IssueType.LoadAvatar(context.Background(), "10010", "procurement.png", 3, bytes.NewBufferString("png"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if avatar.ID != "10400" || avatar.Owner != "10010" || avatar.URLs["16x16"] == "" {
+		t.Errorf("Unexpected avatar %+v", avatar)
+	}
+}
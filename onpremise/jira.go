@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -34,6 +36,49 @@ type Client struct {
 	// User agent used when communicating with the Jira API.
 	UserAgent string
 
+	// Default timeout for every request sent by this client.
+	// Can be overridden per call via the Timeout request option.
+	requestTimeout time.Duration
+
+	// Keep the undecoded response body in Response.RawBody
+	keepRawBody bool
+
+	// Headers added to every request
+	headers http.Header
+
+	// Version of the Jira platform REST API, replacing the version in "rest/api/<version>" paths
+	apiVersion string
+
+	// Maximum size of a decoded response body, 0 means unlimited
+	maxResponseBytes int64
+
+	// Cache of GET responses carrying an ETag
+	cache Cache
+
+	// Recorder of mutating requests, which are not sent in dry-run mode
+	dryRun *DryRun
+
+	// Writer of debug dumps of all requests and responses
+	debug *debugDumper
+
+	// Retry policy of failed requests, nil disables retries
+	retry *RetryPolicy
+
+	// Rate limiters gating outgoing requests
+	rateLimiters rateLimiters
+
+	// Logger reporting every attempt of a request
+	logger Logger
+
+	// Metrics observing every attempt of a request
+	metrics Metrics
+
+	// Limit of concurrent requests, adapting to rate limiting
+	concurrency *adaptiveConcurrency
+
+	// Create meta information cached by IssueService.ValidateCreate
+	createMeta createMetaCache
+
 	// Session storage if the user authenticates with a Session cookie
 	// TODO Needed in Cloud and/or onpremise?
 	session *Session
@@ -42,28 +87,34 @@ type Client struct {
 	common service
 
 	// Services used for talking to different parts of the Jira API.
-	Authentication   *AuthenticationService
-	Issue            *IssueService
-	Project          *ProjectService
-	Board            *BoardService
-	Sprint           *SprintService
-	User             *UserService
-	Group            *GroupService
-	Version          *VersionService
-	Priority         *PriorityService
-	Field            *FieldService
-	Component        *ComponentService
-	Resolution       *ResolutionService
-	StatusCategory   *StatusCategoryService
-	Filter           *FilterService
-	Role             *RoleService
-	PermissionScheme *PermissionSchemeService
-	Status           *StatusService
-	IssueLinkType    *IssueLinkTypeService
-	Organization     *OrganizationService
-	ServiceDesk      *ServiceDeskService
-	Customer         *CustomerService
-	Request          *RequestService
+	Authentication      *AuthenticationService
+	Issue               *IssueService
+	Project             *ProjectService
+	Board               *BoardService
+	Sprint              *SprintService
+	User                *UserService
+	Group               *GroupService
+	Version             *VersionService
+	Priority            *PriorityService
+	Field               *FieldService
+	Component           *ComponentService
+	Resolution          *ResolutionService
+	StatusCategory      *StatusCategoryService
+	Filter              *FilterService
+	Role                *RoleService
+	PermissionScheme    *PermissionSchemeService
+	Status              *StatusService
+	IssueLinkType       *IssueLinkTypeService
+	Organization        *OrganizationService
+	ServiceDesk         *ServiceDeskService
+	Customer            *CustomerService
+	Request             *RequestService
+	IssueSecurityScheme *IssueSecuritySchemeService
+	Screen              *ScreenService
+	IssueType           *IssueTypeService
+	NotificationScheme  *NotificationSchemeService
+	Permission          *PermissionService
+	Avatar              *AvatarService
 }
 
 // service is the base structure to bundle API services
@@ -84,7 +135,8 @@ func (c *Client) Client() *http.Client {
 // If a nil httpClient is provided, a new http.Client will be used.
 // To use API methods which require authentication, provide an http.Client that will perform the authentication for you (such as that provided by the golang.org/x/oauth2 library).
 // baseURL is the HTTP endpoint of your Jira instance and should always be specified with a trailing slash.
-func NewClient(baseURL string, httpClient *http.Client) (*Client, error) {
+// Optional behaviour of the client can be configured via opts.
+func NewClient(baseURL string, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
@@ -128,21 +180,198 @@ func NewClient(baseURL string, httpClient *http.Client) (*Client, error) {
 	c.ServiceDesk = (*ServiceDeskService)(&c.common)
 	c.Customer = (*CustomerService)(&c.common)
 	c.Request = (*RequestService)(&c.common)
+	c.IssueSecurityScheme = (*IssueSecuritySchemeService)(&c.common)
+	c.Screen = (*ScreenService)(&c.common)
+	c.IssueType = (*IssueTypeService)(&c.common)
+	c.NotificationScheme = (*NotificationSchemeService)(&c.common)
+	c.Permission = (*PermissionService)(&c.common)
+	c.Avatar = (*AvatarService)(&c.common)
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
 
 	return c, nil
 }
 
+// ClientOption configures optional behaviour of a Client created by NewClient.
+type ClientOption func(*Client) error
+
+// WithRequestTimeout sets a default timeout for every request sent by the client.
+// The timeout covers the whole exchange, including reading the response body.
+// A per-call Timeout request option takes precedence over this default.
+func WithRequestTimeout(d time.Duration) ClientOption {
+	return func(c *Client) error {
+		if d < 0 {
+			return fmt.Errorf("request timeout must not be negative, got %s", d)
+		}
+		c.requestTimeout = d
+		return nil
+	}
+}
+
+// KeepRawBody makes the client keep the undecoded JSON body of every decoded response in Response.RawBody.
+// This is useful to archive the original payload or to access fields that are not modelled by the structs of this library.
+func KeepRawBody() ClientOption {
+	return func(c *Client) error {
+		c.keepRawBody = true
+		return nil
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to communicate with the API.
+// It takes precedence over the httpClient argument of NewClient.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("http client must not be nil")
+		}
+		c.client = httpClient
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithHeader adds the header key with value to every request sent by the client.
+// Headers set by a request option or by the library itself (like Content-Type) take precedence.
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.headers == nil {
+			c.headers = http.Header{}
+		}
+		c.headers.Add(key, value)
+		return nil
+	}
+}
+
+// WithAPIVersion makes the client use version of the Jira platform REST API, like "2" or "latest".
+// The version of all "rest/api/<version>" paths is replaced, others like "rest/agile/1.0" are not touched.
+// The services are written against the version they reference, so responses of other versions may not be decoded completely.
+func WithAPIVersion(version string) ClientOption {
+	return func(c *Client) error {
+		if !apiVersionRegex.MatchString(version) {
+			return fmt.Errorf("invalid API version %q", version)
+		}
+		c.apiVersion = version
+		return nil
+	}
+}
+
+// WithMaxResponseBytes limits the size of response bodies decoded by Client.Do to n bytes.
+// Larger responses fail with ErrResponseTooLarge, which protects the client against unexpectedly huge payloads.
+// Responses that aren't decoded, like attachment downloads, are not limited.
+func WithMaxResponseBytes(n int64) ClientOption {
+	return func(c *Client) error {
+		if n < 1 {
+			return fmt.Errorf("max response bytes must be positive, got %d", n)
+		}
+		c.maxResponseBytes = n
+		return nil
+	}
+}
+
+// ErrResponseTooLarge is returned by Client.Do if a response body exceeds the limit set by WithMaxResponseBytes.
+var ErrResponseTooLarge = errors.New("response body too large")
+
+// limitedBody fails reading with ErrResponseTooLarge once more than n bytes were read.
+type limitedBody struct {
+	io.ReadCloser
+	n int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.n < 0 {
+		return 0, ErrResponseTooLarge
+	}
+	if int64(len(p)) > b.n+1 {
+		p = p[:b.n+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.n -= int64(n)
+	if b.n < 0 {
+		return n, ErrResponseTooLarge
+	}
+	return n, err
+}
+
+// prepareRequest applies the settings of the client to req, before the request options are applied.
+func (c *Client) prepareRequest(req *http.Request) {
+	for key, values := range c.headers {
+		if req.Header.Get(key) == "" {
+			req.Header[key] = values
+		}
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+}
+
+// apiPath replaces the version of a "rest/api/<version>" path with the version set by WithAPIVersion.
+func (c *Client) apiPath(path string) string {
+	if c.apiVersion == "" || !strings.HasPrefix(path, "rest/api/") {
+		return path
+	}
+	rest := strings.TrimPrefix(path, "rest/api/")
+	version, tail, _ := strings.Cut(rest, "/")
+	if !apiVersionRegex.MatchString(version) {
+		return path
+	}
+	return "rest/api/" + c.apiVersion + "/" + tail
+}
+
+// RequestOption configures a single API request.
+// It can be passed to NewRequest, NewRawRequest and NewMultiPartRequest.
+type RequestOption func(*http.Request) error
+
+// requestTimeoutKey is the context key for a per-call timeout set by Timeout.
+type requestTimeoutKey struct{}
+
+// Timeout sets the timeout for a single request and overrides the default set by WithRequestTimeout.
+// A value of zero disables the timeout for this request.
+//
+// Timeout only applies to requests created via NewRequest, NewRawRequest or NewMultiPartRequest,
+// as the service methods don't accept request options. To limit a service call, pass a context
+// created by context.WithTimeout instead; the shorter of its deadline and the client default applies.
+func Timeout(d time.Duration) RequestOption {
+	return func(r *http.Request) error {
+		if d < 0 {
+			return fmt.Errorf("request timeout must not be negative, got %s", d)
+		}
+		*r = *r.WithContext(context.WithValue(r.Context(), requestTimeoutKey{}, d))
+		return nil
+	}
+}
+
+// applyRequestOptions applies opts to req in the given order.
+func applyRequestOptions(req *http.Request, opts []RequestOption) error {
+	for _, opt := range opts {
+		if err := opt(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // TODO Do we need it?
 // NewRawRequest creates an API request.
 // A relative URL can be provided in urlStr, in which case it is resolved relative to the baseURL of the Client.
 // Allows using an optional native io.Reader for sourcing the request body.
-func (c *Client) NewRawRequest(ctx context.Context, method, urlStr string, body io.Reader) (*http.Request, error) {
+func (c *Client) NewRawRequest(ctx context.Context, method, urlStr string, body io.Reader, opts ...RequestOption) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
 	// Relative URLs should be specified without a preceding slash since baseURL will have the trailing slash
-	rel.Path = strings.TrimLeft(rel.Path, "/")
+	rel.Path = c.apiPath(strings.TrimLeft(rel.Path, "/"))
 
 	u := c.BaseURL.ResolveReference(rel)
 
@@ -166,6 +395,16 @@ func (c *Client) NewRawRequest(ctx context.Context, method, urlStr string, body
 		if c.Authentication.username != "" {
 			req.SetBasicAuth(c.Authentication.username, c.Authentication.password)
 		}
+	} else if c.Authentication.authType == authTypePAT {
+		// Set personal access token
+		if c.Authentication.token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.Authentication.token)
+		}
+	}
+
+	c.prepareRequest(req)
+	if err := applyRequestOptions(req, opts); err != nil {
+		return nil, err
 	}
 
 	return req, nil
@@ -174,13 +413,13 @@ func (c *Client) NewRawRequest(ctx context.Context, method, urlStr string, body
 // NewRequest creates an API request.
 // A relative URL can be provided in urlStr, in which case it is resolved relative to the BaseURL of the Client.
 // If specified, the value pointed to by body is JSON encoded and included as the request body.
-func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
+func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}, opts ...RequestOption) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
 	// Relative URLs should be specified without a preceding slash since BaseURL will have the trailing slash
-	rel.Path = strings.TrimLeft(rel.Path, "/")
+	rel.Path = c.apiPath(strings.TrimLeft(rel.Path, "/"))
 
 	u := c.BaseURL.ResolveReference(rel)
 
@@ -215,6 +454,16 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 		if c.Authentication.username != "" {
 			req.SetBasicAuth(c.Authentication.username, c.Authentication.password)
 		}
+	} else if c.Authentication.authType == authTypePAT {
+		// Set personal access token
+		if c.Authentication.token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.Authentication.token)
+		}
+	}
+
+	c.prepareRequest(req)
+	if err := applyRequestOptions(req, opts); err != nil {
+		return nil, err
 	}
 
 	return req, nil
@@ -245,13 +494,13 @@ func addOptions(s string, opts interface{}) (string, error) {
 // NewMultiPartRequest creates an API request including a multi-part file.
 // A relative URL can be provided in urlStr, in which case it is resolved relative to the baseURL of the Client.
 // If specified, the value pointed to by buf is a multipart form.
-func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string, buf *bytes.Buffer) (*http.Request, error) {
+func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string, buf *bytes.Buffer, opts ...RequestOption) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
 	// Relative URLs should be specified without a preceding slash since baseURL will have the trailing slash
-	rel.Path = strings.TrimLeft(rel.Path, "/")
+	rel.Path = c.apiPath(strings.TrimLeft(rel.Path, "/"))
 
 	u := c.BaseURL.ResolveReference(rel)
 
@@ -276,6 +525,16 @@ func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string,
 		if c.Authentication.username != "" {
 			req.SetBasicAuth(c.Authentication.username, c.Authentication.password)
 		}
+	} else if c.Authentication.authType == authTypePAT {
+		// Set personal access token
+		if c.Authentication.token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.Authentication.token)
+		}
+	}
+
+	c.prepareRequest(req)
+	if err := applyRequestOptions(req, opts); err != nil {
+		return nil, err
 	}
 
 	return req, nil
@@ -284,10 +543,31 @@ func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string,
 // Do sends an API request and returns the API response.
 // The API response is JSON decoded and stored in the value pointed to by v, or returned as an error if an API error has occurred.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	httpResp, err := c.client.Do(req)
+	if httpResp, ok, err := c.dryRun.intercept(req); ok {
+		return newResponse(httpResp, nil), err
+	}
+
+	// Only responses decoded into v are cached and limited.
+	// Bodies the caller reads on its own, like attachment downloads, are streamed as is.
+	decode := v != nil
+	var cached *CachedResponse
+	if decode {
+		req, cached = c.cacheRequest(req)
+	}
+	httpResp, err := c.send(req)
 	if err != nil {
 		return nil, err
 	}
+	var fromCache bool
+	if decode {
+		if c.maxResponseBytes > 0 {
+			httpResp.Body = &limitedBody{ReadCloser: httpResp.Body, n: c.maxResponseBytes}
+		}
+		fromCache, err = c.cacheResponse(req, httpResp, cached)
+		if err != nil {
+			return newResponse(httpResp, nil), err
+		}
+	}
 
 	err = CheckResponse(httpResp)
 	if err != nil {
@@ -296,18 +576,71 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 		return newResponse(httpResp, nil), err
 	}
 
+	var rawBody []byte
 	if v != nil {
 		// Open a NewDecoder and defer closing the reader only if there is a provided interface to decode to
 		defer httpResp.Body.Close()
-		err = json.NewDecoder(httpResp.Body).Decode(v)
+		if c.keepRawBody {
+			rawBody, err = io.ReadAll(httpResp.Body)
+			if err != nil {
+				return newResponse(httpResp, nil), err
+			}
+			err = json.NewDecoder(bytes.NewReader(rawBody)).Decode(v)
+		} else {
+			err = json.NewDecoder(httpResp.Body).Decode(v)
+		}
 	}
 
 	resp := newResponse(httpResp, v)
+	resp.RawBody = rawBody
+	resp.FromCache = fromCache
 	return resp, err
 }
 
+// Do sends an API request and returns the API response decoded into a new value of type T.
+// It behaves like Client.Do, but spares the caller to declare the target value upfront:
+//
+//	issue, resp, err := jira.Do[jira.Issue](client, req)
+func Do[T any](c *Client, req *http.Request) (*T, *Response, error) {
+	v := new(T)
+	resp, err := c.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// withTimeout attaches the effective timeout of req to its context.
+// The per-call Timeout option wins over the client default.
+// The returned cancel function must be called once the response is no longer needed.
+func (c *Client) withTimeout(req *http.Request) (*http.Request, context.CancelFunc) {
+	timeout := c.requestTimeout
+	if d, ok := req.Context().Value(requestTimeoutKey{}).(time.Duration); ok {
+		timeout = d
+	}
+	if timeout <= 0 {
+		return req, func() {}
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	return req.WithContext(ctx), cancel
+}
+
+// cancelOnCloseBody releases the request context once the response body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
 // A response is considered an error if it has a status code outside the 200 range.
+// The returned error is a *ResponseError.
 // The caller is responsible to analyze the response body.
 // The body can contain JSON (if the error is intended) or xml (sometimes Jira just failes).
 func CheckResponse(r *http.Response) error {
@@ -315,8 +648,7 @@ func CheckResponse(r *http.Response) error {
 		return nil
 	}
 
-	err := fmt.Errorf("request failed. Please analyze the request body for more details. Status code: %d", r.StatusCode)
-	return err
+	return newResponseError(r)
 }
 
 // Response represents Jira API response. It wraps http.Response returned from
@@ -327,6 +659,14 @@ type Response struct {
 	StartAt    int
 	MaxResults int
 	Total      int
+
+	// RawBody is the undecoded response body.
+	// It is only set if the client was created with KeepRawBody and the method decoded the response.
+	RawBody []byte
+
+	// FromCache reports whether the body was served from the cache set by WithCache,
+	// because Jira responded with 304 Not Modified.
+	FromCache bool
 }
 
 func newResponse(r *http.Response, v interface{}) *Response {
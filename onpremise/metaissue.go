@@ -2,9 +2,15 @@ package onpremise
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
 	"github.com/trivago/tgo/tcontainer"
@@ -239,3 +245,440 @@ func (t *MetaIssueType) CheckCompleteAndAvailable(config map[string]string) (boo
 
 	return true, nil
 }
+
+// defaultCreateMetaTTL is how long ValidateCreate uses cached create meta information, unless changed via WithCreateMetaTTL.
+const defaultCreateMetaTTL = 10 * time.Minute
+
+// createMetaCache keeps the create meta information of projects and issue types,
+// so that validating several issues of the same project only needs a few requests.
+type createMetaCache struct {
+	mu  sync.Mutex
+	ttl time.Duration
+	// issueTypes are the issue types by project key
+	issueTypes map[string]createMetaEntry[[]*MetaIssueType]
+	// fields are the fields of the create screens by project key and issue type ID
+	fields map[string]createMetaEntry[tcontainer.MarshalMap]
+}
+
+// createMetaEntry is a value of the createMetaCache and the time it was fetched.
+type createMetaEntry[T any] struct {
+	value   T
+	fetched time.Time
+}
+
+// WithCreateMetaTTL sets how long IssueService.ValidateCreate uses cached create meta information before fetching it again.
+// The default is 10 minutes.
+func WithCreateMetaTTL(ttl time.Duration) ClientOption {
+	return func(c *Client) error {
+		if ttl <= 0 {
+			return fmt.Errorf("create meta TTL must be positive, got %s", ttl)
+		}
+		c.createMeta.ttl = ttl
+		return nil
+	}
+}
+
+// CreateValidationProblem describes one problem found by IssueService.ValidateCreate.
+type CreateValidationProblem struct {
+	// Field is the field ID, like "summary" or "customfield_10016".
+	Field string
+	// Name is the name of the field, if it is known to Jira.
+	Name    string
+	Message string
+}
+
+// CreateValidationError is returned by IssueService.ValidateCreate.
+// It lists all problems of the payload at once.
+type CreateValidationError struct {
+	Problems []CreateValidationProblem
+}
+
+// Error is a short string representing the error
+func (e *CreateValidationError) Error() string {
+	problems := make([]string, 0, len(e.Problems))
+	for _, p := range e.Problems {
+		problems = append(problems, fmt.Sprintf("%s: %s", p.Field, p.Message))
+	}
+	return fmt.Sprintf("issue payload is invalid: %s", strings.Join(problems, "; "))
+}
+
+// ValidateCreate checks issue against the create meta information of its project and issue type before it is sent to IssueService.Create.
+// It reports required fields that are missing, fields that are not available on the create screen
+// and values that are not part of the allowed values of a field.
+// All problems are returned at once as *CreateValidationError.
+//
+// The create meta information is fetched via GetCreateMetaIssueTypes and GetCreateMetaFields
+// and cached in the client for the TTL set by WithCreateMetaTTL.
+// Use InvalidateCreateMeta to drop the cache, e.g. after the project configuration changed.
+func (s *IssueService) ValidateCreate(ctx context.Context, issue *Issue) error {
+	if issue == nil || issue.Fields == nil {
+		return errors.New("issue has no fields")
+	}
+
+	projectKey := issue.Fields.Project.Key
+	if projectKey == "" {
+		return errors.New("issue has no project key")
+	}
+	issueTypes, err := s.createMetaIssueTypes(ctx, projectKey)
+	if err != nil {
+		return err
+	}
+
+	issueTypeName := issue.Fields.Type.Name
+	var metaIssueType *MetaIssueType
+	for _, t := range issueTypes {
+		if strings.EqualFold(t.Name, issueTypeName) || (issue.Fields.Type.ID != "" && t.Id == issue.Fields.Type.ID) {
+			metaIssueType = t
+			break
+		}
+	}
+	if metaIssueType == nil {
+		return fmt.Errorf("issue type %q is not available in project %s", issueTypeName, projectKey)
+	}
+	metaFields, err := s.createMetaFields(ctx, projectKey, metaIssueType.Id)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(issue.Fields)
+	if err != nil {
+		return err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return err
+	}
+
+	problems := validateCreateFields(metaFields, fields)
+	if len(problems) > 0 {
+		return &CreateValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// InvalidateCreateMeta drops the create meta information cached by ValidateCreate.
+func (s *IssueService) InvalidateCreateMeta() {
+	cache := &s.client.createMeta
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.issueTypes = nil
+	cache.fields = nil
+}
+
+// createMetaIssueTypes returns the issue types of a project, fetching all pages if they are not cached yet.
+func (s *IssueService) createMetaIssueTypes(ctx context.Context, projectKey string) ([]*MetaIssueType, error) {
+	cache := &s.client.createMeta
+	cache.mu.Lock()
+	entry, ok := cache.issueTypes[projectKey]
+	cache.mu.Unlock()
+	if ok && entry.fresh(cache.ttl) {
+		return entry.value, nil
+	}
+
+	var issueTypes []*MetaIssueType
+	for {
+		page, _, err := s.GetCreateMetaIssueTypes(ctx, projectKey, &CreateMetaOptions{StartAt: len(issueTypes)})
+		if err != nil {
+			return nil, err
+		}
+		issueTypes = append(issueTypes, page.IssueTypes...)
+		if len(page.IssueTypes) == 0 || page.IsLast {
+			break
+		}
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if cache.issueTypes == nil {
+		cache.issueTypes = map[string]createMetaEntry[[]*MetaIssueType]{}
+	}
+	cache.issueTypes[projectKey] = createMetaEntry[[]*MetaIssueType]{value: issueTypes, fetched: time.Now()}
+	return issueTypes, nil
+}
+
+// createMetaFields returns the fields of the create screen of an issue type by field key,
+// in the notation of MetaIssueType.Fields, fetching all pages if they are not cached yet.
+func (s *IssueService) createMetaFields(ctx context.Context, projectKey, issueTypeID string) (tcontainer.MarshalMap, error) {
+	cache := &s.client.createMeta
+	cacheKey := projectKey + "/" + issueTypeID
+	cache.mu.Lock()
+	entry, ok := cache.fields[cacheKey]
+	cache.mu.Unlock()
+	if ok && entry.fresh(cache.ttl) {
+		return entry.value, nil
+	}
+
+	var metas []*FieldMeta
+	for {
+		page, _, err := s.GetCreateMetaFields(ctx, projectKey, issueTypeID, &CreateMetaOptions{StartAt: len(metas)})
+		if err != nil {
+			return nil, err
+		}
+		metas = append(metas, page.Fields...)
+		if len(page.Fields) == 0 || page.IsLast {
+			break
+		}
+	}
+
+	fields := tcontainer.NewMarshalMap()
+	for _, meta := range metas {
+		key := meta.Key
+		if key == "" {
+			key = meta.FieldID
+		}
+		data, err := json.Marshal(meta)
+		if err != nil {
+			return nil, err
+		}
+		field := map[string]interface{}{}
+		if err := json.Unmarshal(data, &field); err != nil {
+			return nil, err
+		}
+		fields[key] = field
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if cache.fields == nil {
+		cache.fields = map[string]createMetaEntry[tcontainer.MarshalMap]{}
+	}
+	cache.fields[cacheKey] = createMetaEntry[tcontainer.MarshalMap]{value: fields, fetched: time.Now()}
+	return fields, nil
+}
+
+// fresh reports whether the entry is younger than ttl, or defaultCreateMetaTTL if ttl is not set.
+func (e createMetaEntry[T]) fresh(ttl time.Duration) bool {
+	if ttl == 0 {
+		ttl = defaultCreateMetaTTL
+	}
+	return time.Since(e.fetched) < ttl
+}
+
+// validateCreateFields compares the fields of a create payload with the create meta information of an issue type.
+func validateCreateFields(metaFields tcontainer.MarshalMap, fields map[string]interface{}) []CreateValidationProblem {
+	var problems []CreateValidationProblem
+
+	for key := range metaFields {
+		if _, ok := fields[key]; ok {
+			continue
+		}
+		required, _ := metaFields.Bool(key + "/required")
+		hasDefault, _ := metaFields.Bool(key + "/hasDefaultValue")
+		if required && !hasDefault {
+			name, _ := metaFields.String(key + "/name")
+			problems = append(problems, CreateValidationProblem{Field: key, Name: name, Message: "field is required"})
+		}
+	}
+
+	for key, value := range fields {
+		if _, ok := metaFields[key]; !ok {
+			problems = append(problems, CreateValidationProblem{Field: key, Message: "field is not available on the create screen"})
+			continue
+		}
+
+		allowed, err := metaFields.Array(key + "/allowedValues")
+		if err != nil || len(allowed) == 0 {
+			continue
+		}
+		name, _ := metaFields.String(key + "/name")
+		values, isArray := value.([]interface{})
+		if !isArray {
+			values = []interface{}{value}
+		}
+		for _, v := range values {
+			if !isAllowedValue(v, allowed) {
+				problems = append(problems, CreateValidationProblem{Field: key, Name: name, Message: fmt.Sprintf("value %v is not allowed", optionLabel(v))})
+			}
+		}
+	}
+
+	// Stable order for readable errors
+	sort.Slice(problems, func(i, j int) bool {
+		return problems[i].Field < problems[j].Field
+	})
+	return problems
+}
+
+// optionIdentifiers are the keys Jira uses to reference an allowed value.
+var optionIdentifiers = []string{"id", "key", "name", "value"}
+
+// isAllowedValue reports whether v references one of the allowed values.
+// Objects match if one of their identifiers (id, key, name or value) matches, plain values match by equality.
+func isAllowedValue(v interface{}, allowed []interface{}) bool {
+	obj, isObject := v.(map[string]interface{})
+	for _, a := range allowed {
+		allowedObj, ok := a.(map[string]interface{})
+		if !ok {
+			if fmt.Sprint(a) == fmt.Sprint(v) {
+				return true
+			}
+			continue
+		}
+		if !isObject {
+			continue
+		}
+		for _, id := range optionIdentifiers {
+			want, ok := obj[id]
+			if ok && fmt.Sprint(want) == fmt.Sprint(allowedObj[id]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// optionLabel returns a human readable representation of an option value.
+func optionLabel(v interface{}) string {
+	if obj, ok := v.(map[string]interface{}); ok {
+		for _, id := range optionIdentifiers {
+			if label, ok := obj[id]; ok {
+				return fmt.Sprintf("%q", fmt.Sprint(label))
+			}
+		}
+	}
+	return fmt.Sprintf("%q", fmt.Sprint(v))
+}
+
+// FieldMeta is the typed meta information about a field of the create and edit screens.
+type FieldMeta struct {
+	// FieldID is only returned by the paginated create meta endpoints.
+	FieldID         string              `json:"fieldId,omitempty"`
+	Key             string              `json:"key,omitempty"`
+	Name            string              `json:"name,omitempty"`
+	Required        bool                `json:"required"`
+	Schema          FieldSchema         `json:"schema,omitempty"`
+	HasDefaultValue bool                `json:"hasDefaultValue,omitempty"`
+	DefaultValue    interface{}         `json:"defaultValue,omitempty"`
+	Operations      []string            `json:"operations,omitempty"`
+	AutoCompleteURL string              `json:"autoCompleteUrl,omitempty"`
+	AllowedValues   []FieldAllowedValue `json:"allowedValues,omitempty"`
+}
+
+// FieldAllowedValue is a value a field can be set to.
+// Depending on the field, it is an option, a priority, a version, a component, ...
+// so only the attributes common to those are available.
+type FieldAllowedValue struct {
+	Self        string `json:"self,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Key         string `json:"key,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Value       string `json:"value,omitempty"`
+	Description string `json:"description,omitempty"`
+	Disabled    bool   `json:"disabled,omitempty"`
+	Archived    bool   `json:"archived,omitempty"`
+	Released    bool   `json:"released,omitempty"`
+	// Children are the child options of a cascading select option.
+	Children []FieldAllowedValue `json:"children,omitempty"`
+}
+
+// Label returns the value shown to users: Value for options, Name for everything else.
+func (v FieldAllowedValue) Label() string {
+	if v.Value != "" {
+		return v.Value
+	}
+	return v.Name
+}
+
+// FieldsMeta returns the typed meta information of all fields of the issue type, by field key.
+func (t *MetaIssueType) FieldsMeta() (map[string]*FieldMeta, error) {
+	return decodeFieldsMeta(t.Fields)
+}
+
+// FieldsMeta returns the typed meta information of all editable fields, by field key.
+func (m *EditMetaInfo) FieldsMeta() (map[string]*FieldMeta, error) {
+	return decodeFieldsMeta(m.Fields)
+}
+
+// decodeFieldsMeta converts the untyped fields of create or edit meta information into FieldMeta.
+func decodeFieldsMeta(fields tcontainer.MarshalMap) (map[string]*FieldMeta, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	var metas map[string]*FieldMeta
+	if err := json.Unmarshal(data, &metas); err != nil {
+		return nil, fmt.Errorf("could not decode field meta information: %w", err)
+	}
+	for key, meta := range metas {
+		if meta.Key == "" {
+			meta.Key = key
+		}
+	}
+	return metas, nil
+}
+
+// CreateMetaOptions specifies the optional parameters for the paginated create meta endpoints
+// IssueService.GetCreateMetaIssueTypes and IssueService.GetCreateMetaFields.
+type CreateMetaOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// CreateMetaIssueTypes is a page of the issue types available to create issues in a project.
+type CreateMetaIssueTypes struct {
+	StartAt    int              `json:"startAt"`
+	MaxResults int              `json:"maxResults"`
+	Total      int              `json:"total"`
+	IsLast     bool             `json:"isLast"`
+	IssueTypes []*MetaIssueType `json:"values"`
+}
+
+// CreateMetaFields is a page of the fields of the create screen of an issue type.
+type CreateMetaFields struct {
+	StartAt    int          `json:"startAt"`
+	MaxResults int          `json:"maxResults"`
+	Total      int          `json:"total"`
+	IsLast     bool         `json:"isLast"`
+	Fields     []*FieldMeta `json:"values"`
+}
+
+// GetCreateMetaIssueTypes returns a page of the issue types available to create issues in the project projectIDOrKey.
+// This paginated endpoint is available since Jira 8.4 and replaces the create meta information of IssueService.GetCreateMeta,
+// which was removed in Jira 9.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getCreateIssueMetaProjectIssueTypes
+func (s *IssueService) GetCreateMetaIssueTypes(ctx context.Context, projectIDOrKey string, options *CreateMetaOptions) (*CreateMetaIssueTypes, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/createmeta/%s/issuetypes", url.PathEscape(projectIDOrKey))
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(CreateMetaIssueTypes)
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return page, resp, nil
+}
+
+// GetCreateMetaFields returns a page of the fields of the create screen of the issue type issueTypeID
+// in the project projectIDOrKey, including whether they are required and their allowed values.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getCreateIssueMetaFields
+func (s *IssueService) GetCreateMetaFields(ctx context.Context, projectIDOrKey, issueTypeID string, options *CreateMetaOptions) (*CreateMetaFields, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/createmeta/%s/issuetypes/%s", url.PathEscape(projectIDOrKey), url.PathEscape(issueTypeID))
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(CreateMetaFields)
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return page, resp, nil
+}
@@ -0,0 +1,64 @@
+package onpremise
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestClient_Do_Metrics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"key":"EX-1"}`))
+	})
+
+	var observed []RequestMetrics
+	metrics := MetricsFunc(func(m RequestMetrics) {
+		observed = append(observed, m)
+	})
+	client, _ := NewClient(testServer.URL, nil, WithMetrics(metrics), WithRetry(RetryPolicy{MinBackoff: time.Millisecond}))
+	if _, _, err := client.Issue.Get(context.Background(), "EX-1", nil); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if len(observed) != 2 {
+		t.Fatalf("Expected 2 observations. Got %d", len(observed))
+	}
+	first, second := observed[0], observed[1]
+	if first.Service != "issue" || first.Endpoint != "/rest/api/2/issue/{id}" || first.Method != http.MethodGet {
+		t.Errorf("Unexpected labels %+v", first)
+	}
+	if !first.RateLimited || !first.Retry {
+		t.Errorf("Expected the first attempt to be rate limited and retried. Got %+v", first)
+	}
+	if second.StatusCode != http.StatusOK || second.Attempt != 2 || second.Retry {
+		t.Errorf("Unexpected second observation %+v", second)
+	}
+}
+
+func TestServiceName(t *testing.T) {
+	tests := map[string]string{
+		"/rest/api/2/issue/EX-1":                        "issue",
+		"/rest/api/3/field/search":                      "field",
+		"/jira/rest/agile/1.0/board/1/sprint":           "board",
+		"/rest/servicedeskapi/request":                  "request",
+		"/rest/atlassian-connect/1/addons/a/properties": "addons",
+		"/secure/attachment/1/":                         "",
+	}
+	for path, want := range tests {
+		if got := serviceName(&url.URL{Path: path}); got != want {
+			t.Errorf("serviceName(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GetPreference returns the value of a preference of the current user.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/mypreferences-getPreference
+func (s *UserService) GetPreference(ctx context.Context, key string) (string, *Response, error) {
+	apiEndpoint := "rest/api/2/mypreferences?key=" + url.QueryEscape(key)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return "", resp, NewJiraError(resp, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", resp, err
+	}
+
+	// Depending on the preference, the value is returned as JSON string or as plain text
+	var value string
+	if err := json.Unmarshal(body, &value); err != nil {
+		value = string(body)
+	}
+	return value, resp, nil
+}
+
+// SetPreference sets the value of a preference of the current user.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/mypreferences-setPreference
+func (s *UserService) SetPreference(ctx context.Context, key, value string) (*Response, error) {
+	apiEndpoint := "rest/api/2/mypreferences?key=" + url.QueryEscape(key)
+	req, err := s.client.NewRawRequest(ctx, http.MethodPut, apiEndpoint, strings.NewReader(value))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeletePreference deletes a preference of the current user, which restores the default value.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/mypreferences-removePreference
+func (s *UserService) DeletePreference(ctx context.Context, key string) (*Response, error) {
+	apiEndpoint := "rest/api/2/mypreferences?key=" + url.QueryEscape(key)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GetLocale returns the locale of the current user, like "en_US".
+// If the user has no locale set, the default locale of the instance is returned.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/mypreferences/locale-getLocale
+func (s *UserService) GetLocale(ctx context.Context) (string, *Response, error) {
+	const apiEndpoint = "rest/api/2/mypreferences/locale"
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	locale := new(struct {
+		Locale string `json:"locale"`
+	})
+	resp, err := s.client.Do(req, locale)
+	if err != nil {
+		return "", resp, NewJiraError(resp, err)
+	}
+	return locale.Locale, resp, nil
+}
+
+// SetLocale sets the locale of the current user, like "de_DE".
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/mypreferences/locale-setLocale
+func (s *UserService) SetLocale(ctx context.Context, locale string) (*Response, error) {
+	const apiEndpoint = "rest/api/2/mypreferences/locale"
+	body := struct {
+		Locale string `json:"locale"`
+	}{locale}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteLocale resets the locale of the current user to the default locale of the instance.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/mypreferences/locale-deleteLocale
+func (s *UserService) DeleteLocale(ctx context.Context) (*Response, error) {
+	const apiEndpoint = "rest/api/2/mypreferences/locale"
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,135 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestUserService_GetPreference(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/mypreferences"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?key=user.notifications.mimetype")
+		fmt.Fprint(w, `html`)
+	})
+
+	value, _, err := testClient.User.GetPreference(context.Background(), "user.notifications.mimetype")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if value != "html" {
+		t.Errorf("Expected preference value html. Got %q", value)
+	}
+}
+
+func TestUserService_SetPreference(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/mypreferences"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint+"?key=user.notifications.mimetype")
+		if body, _ := io.ReadAll(r.Body); string(body) != "text" {
+			t.Errorf("Expected the raw value as body. Got %q", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.User.SetPreference(context.Background(), "user.notifications.mimetype", "text"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestUserService_DeletePreference(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/mypreferences"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testapiEndpoint+"?key=user.notifications.mimetype")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.User.DeletePreference(context.Background(), "user.notifications.mimetype"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestUserService_GetLocale(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/mypreferences/locale"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"locale":"en_US"}`)
+	})
+
+	locale, _, err := testClient.User.GetLocale(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if locale != "en_US" {
+		t.Errorf("Expected locale en_US. Got %q", locale)
+	}
+}
+
+func TestUserService_SetLocale(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/mypreferences/locale"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		if body, _ := io.ReadAll(r.Body); string(body) != `{"locale":"de_DE"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.User.SetLocale(context.Background(), "de_DE"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestUserService_DeleteLocale(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/mypreferences/locale"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.User.DeleteLocale(context.Background()); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestUserService_GetSelf_Expand(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/myself?expand=groups")
+		fmt.Fprint(w, `{"name":"fred","timeZone":"Europe/Berlin","locale":"de_DE","groups":{"size":1,"items":[{"name":"jira-software-users"}]}}`)
+	})
+
+	user, _, err := testClient.User.GetSelf(context.Background(), "groups")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.Groups == nil || user.Groups.Items[0].Name != "jira-software-users" || user.TimeZone != "Europe/Berlin" {
+		t.Errorf("Unexpected user %+v", user)
+	}
+}
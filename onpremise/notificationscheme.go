@@ -0,0 +1,119 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// NotificationSchemeService handles notification schemes for the Jira instance / API.
+// Jira Data Center only allows reading notification schemes via the REST API.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/notificationscheme
+type NotificationSchemeService service
+
+// NotificationScheme defines who is notified about which events of the issues of a project.
+type NotificationScheme struct {
+	Expand                   string                    `json:"expand,omitempty" structs:"expand,omitempty"`
+	ID                       int64                     `json:"id" structs:"id"`
+	Self                     string                    `json:"self,omitempty" structs:"self,omitempty"`
+	Name                     string                    `json:"name" structs:"name"`
+	Description              string                    `json:"description,omitempty" structs:"description,omitempty"`
+	NotificationSchemeEvents []NotificationSchemeEvent `json:"notificationSchemeEvents,omitempty" structs:"notificationSchemeEvents,omitempty"`
+}
+
+// NotificationSchemeEvent is an event of a notification scheme together with its recipients.
+type NotificationSchemeEvent struct {
+	Event         NotificationEvent   `json:"event" structs:"event"`
+	Notifications []EventNotification `json:"notifications,omitempty" structs:"notifications,omitempty"`
+}
+
+// NotificationEvent is an event notifications are sent for, like "Issue created".
+type NotificationEvent struct {
+	ID          int64  `json:"id" structs:"id"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// EventNotification is a recipient of the notifications of an event.
+// Depending on NotificationType, like "CurrentAssignee", "Group" or "User", one of the other fields is set.
+type EventNotification struct {
+	Expand           string `json:"expand,omitempty" structs:"expand,omitempty"`
+	ID               int64  `json:"id" structs:"id"`
+	NotificationType string `json:"notificationType" structs:"notificationType"`
+	// Parameter identifies the recipient of the notification type, like the name of a group.
+	Parameter    string             `json:"parameter,omitempty" structs:"parameter,omitempty"`
+	EmailAddress string             `json:"emailAddress,omitempty" structs:"emailAddress,omitempty"`
+	Group        *NotificationGroup `json:"group,omitempty" structs:"group,omitempty"`
+	Field        *Field             `json:"field,omitempty" structs:"field,omitempty"`
+	ProjectRole  *Role              `json:"projectRole,omitempty" structs:"projectRole,omitempty"`
+	User         *User              `json:"user,omitempty" structs:"user,omitempty"`
+}
+
+// NotificationGroup is a group receiving notifications.
+type NotificationGroup struct {
+	Name string `json:"name" structs:"name"`
+	Self string `json:"self,omitempty" structs:"self,omitempty"`
+}
+
+// NotificationSchemeList is a page of notification schemes, as returned by NotificationSchemeService.GetList.
+type NotificationSchemeList struct {
+	StartAt    int                  `json:"startAt" structs:"startAt"`
+	MaxResults int                  `json:"maxResults" structs:"maxResults"`
+	Total      int                  `json:"total" structs:"total"`
+	IsLast     bool                 `json:"isLast" structs:"isLast"`
+	Values     []NotificationScheme `json:"values" structs:"values"`
+}
+
+// NotificationSchemeListOptions specifies the optional parameters for NotificationSchemeService.GetList.
+type NotificationSchemeListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// Expand is a comma separated list of "all", "field", "group", "notificationSchemeEvents", "projectRole" and "user".
+	// Use "notificationSchemeEvents" to return the events and "all" to return their recipients, too.
+	Expand string `url:"expand,omitempty"`
+}
+
+// GetList returns one page of the notification schemes, ordered by name.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/notificationscheme-getNotificationSchemes
+func (s *NotificationSchemeService) GetList(ctx context.Context, options *NotificationSchemeListOptions) (*NotificationSchemeList, *Response, error) {
+	u, err := addOptions("rest/api/2/notificationscheme", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list := new(NotificationSchemeList)
+	resp, err := s.client.Do(req, list)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return list, resp, nil
+}
+
+// Get returns the notification scheme schemeID.
+// expand is a comma separated list as described in NotificationSchemeListOptions.Expand.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/notificationscheme-getNotificationScheme
+func (s *NotificationSchemeService) Get(ctx context.Context, schemeID int64, expand string) (*NotificationScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/notificationscheme/%d", schemeID)
+	if expand != "" {
+		apiEndpoint += "?expand=" + url.QueryEscape(expand)
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(NotificationScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
@@ -0,0 +1,52 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestNotificationSchemeService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/notificationscheme"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?expand=all&startAt=1")
+		fmt.Fprint(w, `{"maxResults":50,"startAt":1,"total":2,"isLast":true,"values":[{"expand":"notificationSchemeEvents,user,group,projectRole,field,all","id":10100,"self":"https://jira.example.com/rest/api/2/notificationscheme/10100","name":"Standard","notificationSchemeEvents":[{"event":{"id":1,"name":"Issue created"},"notifications":[{"id":1,"notificationType":"Group","parameter":"jira-administrators","group":{"name":"jira-administrators","self":"https://jira.example.com/rest/api/2/group?groupname=jira-administrators"}}]}]}]}`)
+	})
+
+	list, _, err := testClient.NotificationScheme.GetList(context.Background(), &NotificationSchemeListOptions{StartAt: 1, Expand: "all"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if list.Total != 2 || len(list.Values) != 1 || !list.IsLast {
+		t.Fatalf("Unexpected notification schemes %+v", list)
+	}
+	notifications := list.Values[0].NotificationSchemeEvents[0].Notifications
+	if len(notifications) != 1 || notifications[0].Group == nil || notifications[0].Group.Name != "jira-administrators" {
+		t.Errorf("Unexpected notifications %+v", notifications)
+	}
+}
+
+func TestNotificationSchemeService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/notificationscheme/10100"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"id":10100,"name":"Standard","description":"The standard notification scheme"}`)
+	})
+
+	scheme, _, err := testClient.NotificationScheme.Get(context.Background(), 10100, "")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if scheme.Name != "Standard" {
+		t.Errorf("Expected notification scheme Standard, got %s", scheme.Name)
+	}
+}
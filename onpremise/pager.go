@@ -0,0 +1,302 @@
+package onpremise
+
+import "context"
+
+// PageFunc requests the page of a paginated endpoint starting with the item at index startAt.
+// It returns the items of the page and reports whether it is the last one.
+type PageFunc[T any] func(ctx context.Context, startAt int) ([]T, bool, *Response, error)
+
+// Pager iterates over all items of a paginated endpoint.
+// It requests the following page once all items of the current page were consumed,
+// so callers don't need to keep track of startAt and maxResults:
+//
+//	pager := client.Issue.SearchPager("project = EX", nil)
+//	for pager.Next(ctx) {
+//		issue := pager.Value()
+//		// ...
+//	}
+//	if err := pager.Err(); err != nil {
+//		// ...
+//	}
+//
+// A Pager is not safe for concurrent use.
+type Pager[T any] struct {
+	// fetch requests the following page and reports whether it is the last one.
+	fetch func(ctx context.Context) ([]T, bool, *Response, error)
+
+	values []T
+	last   bool
+	resp   *Response
+	index  int
+	value  T
+	err    error
+}
+
+// NewPager returns a Pager requesting its pages via fetch.
+// The first page is requested with startAt 0 by the first call of Pager.Next.
+func NewPager[T any](fetch PageFunc[T]) *Pager[T] {
+	startAt := 0
+	return newPager(func(ctx context.Context) ([]T, bool, *Response, error) {
+		values, last, resp, err := fetch(ctx, startAt)
+		if err != nil {
+			return nil, true, resp, err
+		}
+		startAt += len(values)
+		return values, last, resp, nil
+	})
+}
+
+// newPager returns a Pager requesting the following page via fetch.
+func newPager[T any](fetch func(ctx context.Context) ([]T, bool, *Response, error)) *Pager[T] {
+	return &Pager[T]{fetch: fetch}
+}
+
+// Next advances the pager to the next item, which will then be available via Pager.Value.
+// It returns false if there are no more items or an error occurred.
+// In the latter case, the error is returned by Pager.Err.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	for p.err == nil {
+		if p.index < len(p.values) {
+			p.value = p.values[p.index]
+			p.index++
+			return true
+		}
+
+		if p.last {
+			return false
+		}
+
+		values, last, resp, err := p.fetch(ctx)
+		p.resp = resp
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.values, p.index = values, 0
+		// An empty page would never end
+		p.last = last || len(values) == 0
+	}
+	return false
+}
+
+// Value returns the current item.
+// It is only valid after a call of Pager.Next returned true.
+func (p *Pager[T]) Value() T {
+	return p.value
+}
+
+// Err returns the first error that occurred while requesting a page.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Response returns the response of the last requested page.
+func (p *Pager[T]) Response() *Response {
+	return p.resp
+}
+
+// All consumes the pager and returns all remaining items.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var values []T
+	for p.Next(ctx) {
+		values = append(values, p.Value())
+	}
+	return values, p.Err()
+}
+
+// SearchPager returns a Pager over all issues matching jql.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *IssueService) SearchPager(jql string, options *SearchOptions) *Pager[Issue] {
+	return NewPager(func(ctx context.Context, startAt int) ([]Issue, bool, *Response, error) {
+		opts := SearchOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		issues, resp, err := s.Search(ctx, jql, &opts)
+		if err != nil {
+			return nil, true, resp, err
+		}
+		return issues, startAt+len(issues) >= resp.Total, resp, nil
+	})
+}
+
+// MembersPager returns a Pager over all members of the group name.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *GroupService) MembersPager(name string, options *GroupSearchOptions) *Pager[GroupMember] {
+	return NewPager(func(ctx context.Context, startAt int) ([]GroupMember, bool, *Response, error) {
+		opts := GroupSearchOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+		if opts.MaxResults == 0 {
+			// Get sends maxResults as given, which makes Jira return an empty page for 0
+			opts.MaxResults = 50
+		}
+
+		members, resp, err := s.Get(ctx, name, &opts)
+		if err != nil {
+			return nil, true, resp, err
+		}
+		return members, startAt+len(members) >= resp.Total, resp, nil
+	})
+}
+
+// SprintsPager returns a Pager over all sprints of the board boardID.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *BoardService) SprintsPager(boardID int, options *GetAllSprintsOptions) *Pager[Sprint] {
+	return NewPager(func(ctx context.Context, startAt int) ([]Sprint, bool, *Response, error) {
+		opts := GetAllSprintsOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		sprints, resp, err := s.GetAllSprints(ctx, boardID, &opts)
+		if err != nil {
+			return nil, true, resp, err
+		}
+		return sprints.Values, sprints.IsLast, resp, nil
+	})
+}
+
+// WorklogsPager returns a Pager over all worklog records of the issue issueID.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *IssueService) WorklogsPager(issueID string, options *GetWorklogsQueryOptions) *Pager[WorklogRecord] {
+	return NewPager(func(ctx context.Context, startAt int) ([]WorklogRecord, bool, *Response, error) {
+		opts := GetWorklogsQueryOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = int64(startAt)
+
+		worklog, resp, err := s.GetWorklogs(ctx, issueID, WithQueryOptions(&opts))
+		if err != nil {
+			return nil, true, resp, NewJiraError(resp, err)
+		}
+		return worklog.Worklogs, worklog.StartAt+len(worklog.Worklogs) >= worklog.Total, resp, nil
+	})
+}
+
+// CommentsPager returns a Pager over all comments of the issue issueID.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *IssueService) CommentsPager(issueID string, options *GetCommentsOptions) *Pager[*Comment] {
+	return NewPager(func(ctx context.Context, startAt int) ([]*Comment, bool, *Response, error) {
+		opts := GetCommentsOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		comments, resp, err := s.GetComments(ctx, issueID, &opts)
+		if err != nil {
+			return nil, true, resp, err
+		}
+		return comments.Comments, comments.StartAt+len(comments.Comments) >= comments.Total, resp, nil
+	})
+}
+
+// FindPager returns a Pager over all users matching property.
+// A start index set via WithStartAt is ignored, WithMaxResults defines the page size.
+func (s *UserService) FindPager(property string, tweaks ...userSearchF) *Pager[User] {
+	return NewPager(func(ctx context.Context, startAt int) ([]User, bool, *Response, error) {
+		page := append(tweaks[:len(tweaks):len(tweaks)], withoutStartAt, WithStartAt(startAt))
+
+		users, resp, err := s.Find(ctx, property, page...)
+		if err != nil {
+			return nil, true, resp, err
+		}
+		return users, userPageLast(users), resp, nil
+	})
+}
+
+// FindAssignablePager returns a Pager over all assignable users matching the options.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *UserService) FindAssignablePager(options *UserAssignableSearchOptions) *Pager[User] {
+	return NewPager(func(ctx context.Context, startAt int) ([]User, bool, *Response, error) {
+		opts := UserAssignableSearchOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		users, resp, err := s.FindAssignable(ctx, &opts)
+		if err != nil {
+			return nil, true, resp, err
+		}
+		return users, userPageLast(users), resp, nil
+	})
+}
+
+// FindAssignableMultiProjectPager returns a Pager over all users matching the options that are assignable in all of the projects.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *UserService) FindAssignableMultiProjectPager(options *UserMultiProjectAssignableSearchOptions) *Pager[User] {
+	return NewPager(func(ctx context.Context, startAt int) ([]User, bool, *Response, error) {
+		opts := UserMultiProjectAssignableSearchOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		users, resp, err := s.FindAssignableMultiProject(ctx, &opts)
+		if err != nil {
+			return nil, true, resp, err
+		}
+		return users, userPageLast(users), resp, nil
+	})
+}
+
+// FindWithPermissionsPager returns a Pager over all users matching the options that hold all of the permissions.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *UserService) FindWithPermissionsPager(options *UserPermissionSearchOptions) *Pager[User] {
+	return NewPager(func(ctx context.Context, startAt int) ([]User, bool, *Response, error) {
+		opts := UserPermissionSearchOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		users, resp, err := s.FindWithPermissions(ctx, &opts)
+		if err != nil {
+			return nil, true, resp, err
+		}
+		return users, userPageLast(users), resp, nil
+	})
+}
+
+// FindViewablePager returns a Pager over all users matching the options that can view the issue or project.
+// options.StartAt is ignored, options.MaxResults defines the page size.
+func (s *UserService) FindViewablePager(options *UserViewableSearchOptions) *Pager[User] {
+	return NewPager(func(ctx context.Context, startAt int) ([]User, bool, *Response, error) {
+		opts := UserViewableSearchOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.StartAt = startAt
+
+		users, resp, err := s.FindViewable(ctx, &opts)
+		if err != nil {
+			return nil, true, resp, err
+		}
+		return users, userPageLast(users), resp, nil
+	})
+}
+
+// userPageLast reports whether users is the last page of the user search endpoints.
+// They filter users after selecting the page, so only an empty page reliably marks the end.
+func userPageLast(users []User) bool {
+	return len(users) == 0
+}
+
+// withoutStartAt removes the start index set by WithStartAt from a user search.
+func withoutStartAt(s userSearch) userSearch {
+	params := userSearch{}
+	for _, param := range s {
+		if param.name != "startAt" {
+			params = append(params, param)
+		}
+	}
+	return params
+}
@@ -0,0 +1,216 @@
+package onpremise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestPager_Next(t *testing.T) {
+	var requested []int
+	pages := [][]int{{1, 2}, {3, 4}, {5}}
+	pager := NewPager(func(ctx context.Context, startAt int) ([]int, bool, *Response, error) {
+		requested = append(requested, startAt)
+		return pages[len(requested)-1], len(requested) == len(pages), nil, nil
+	})
+
+	values, err := pager.All(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if fmt.Sprint(values) != "[1 2 3 4 5]" {
+		t.Errorf("Unexpected values %v", values)
+	}
+	if fmt.Sprint(requested) != "[0 2 4]" {
+		t.Errorf("Unexpected start indexes %v", requested)
+	}
+}
+
+func TestPager_Err(t *testing.T) {
+	calls := 0
+	pager := NewPager(func(ctx context.Context, startAt int) ([]int, bool, *Response, error) {
+		calls++
+		if calls == 2 {
+			return nil, true, nil, errors.New("boom")
+		}
+		return []int{1}, false, nil, nil
+	})
+
+	count := 0
+	for pager.Next(context.Background()) {
+		count++
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 value. Got %d", count)
+	}
+	if pager.Err() == nil {
+		t.Error("Expected an error")
+	}
+	if pager.Next(context.Background()) {
+		t.Error("Expected Next to return false after an error")
+	}
+}
+
+func TestIssueService_SearchPager(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"issues":[{"key":"EX-1"},{"key":"EX-2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"startAt":2,"maxResults":2,"total":3,"issues":[{"key":"EX-3"}]}`)
+		default:
+			t.Errorf("Unexpected startAt %q", r.URL.Query().Get("startAt"))
+		}
+	})
+
+	issues, err := testClient.Issue.SearchPager("project = EX", &SearchOptions{MaxResults: 2}).All(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issues) != 3 || issues[2].Key != "EX-3" {
+		t.Errorf("Unexpected issues %v", issues)
+	}
+}
+
+func TestGroupService_MembersPager(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/member", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("maxResults") != "50" {
+			t.Errorf("Expected the default page size. Got %q", r.URL.Query().Get("maxResults"))
+		}
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":2,"values":[{"name":"a"}]}`)
+		case "1":
+			fmt.Fprint(w, `{"startAt":1,"maxResults":50,"total":2,"values":[{"name":"b"}]}`)
+		default:
+			t.Errorf("Unexpected startAt %q", r.URL.Query().Get("startAt"))
+		}
+	})
+
+	members, err := testClient.Group.MembersPager("default", nil).All(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(members) != 2 || members[1].Name != "b" {
+		t.Errorf("Unexpected members %v", members)
+	}
+}
+
+func TestBoardService_SprintsPager(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/board/123/sprint", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"maxResults":1,"startAt":0,"isLast":false,"values":[{"id":1}]}`)
+		case "1":
+			fmt.Fprint(w, `{"maxResults":1,"startAt":1,"isLast":true,"values":[{"id":2}]}`)
+		default:
+			t.Errorf("Unexpected startAt %q", r.URL.Query().Get("startAt"))
+		}
+	})
+
+	pager := testClient.Board.SprintsPager(123, nil)
+	var ids []int
+	for pager.Next(context.Background()) {
+		ids = append(ids, pager.Value().ID)
+	}
+	if err := pager.Err(); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if fmt.Sprint(ids) != "[1 2]" {
+		t.Errorf("Unexpected sprint IDs %v", ids)
+	}
+}
+
+func TestIssueService_WorklogsPager(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.URL.Query().Get("maxResults"); got != "2" {
+			t.Errorf("Expected maxResults 2, got %q", got)
+		}
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"worklogs":[{"id":"1"},{"id":"2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"startAt":2,"maxResults":2,"total":3,"worklogs":[{"id":"3"}]}`)
+		default:
+			t.Errorf("Unexpected startAt %q", r.URL.Query().Get("startAt"))
+		}
+	})
+
+	records, err := testClient.Issue.WorklogsPager("EX-1", &GetWorklogsQueryOptions{MaxResults: 2}).All(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(records) != 3 || records[2].ID != "3" {
+		t.Errorf("Unexpected worklog records %v", records)
+	}
+}
+
+func TestIssueService_CommentsPager(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.URL.Query().Get("orderBy"); got != "-created" {
+			t.Errorf("Expected orderBy -created, got %q", got)
+		}
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"comments":[{"id":"2"}]}`)
+		case "1":
+			fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"comments":[{"id":"1"}]}`)
+		default:
+			t.Errorf("Unexpected startAt %q", r.URL.Query().Get("startAt"))
+		}
+	})
+
+	comments, err := testClient.Issue.CommentsPager("EX-1", &GetCommentsOptions{OrderBy: "-created"}).All(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(comments) != 2 || comments[0].ID != "2" || comments[1].ID != "1" {
+		t.Errorf("Unexpected comments %v", comments)
+	}
+}
+
+func TestUserService_FindPager(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.URL.Query()["startAt"]; len(got) != 1 {
+			t.Errorf("Expected a single startAt, got %v", got)
+		}
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `[{"name":"a"},{"name":"b"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"name":"c"}]`)
+		case "3":
+			fmt.Fprint(w, `[]`)
+		default:
+			t.Errorf("Unexpected startAt %q", r.URL.Query().Get("startAt"))
+		}
+	})
+
+	users, err := testClient.User.FindPager("fred", WithStartAt(10), WithMaxResults(2)).All(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 3 || users[2].Name != "c" {
+		t.Errorf("Unexpected users %v", users)
+	}
+}
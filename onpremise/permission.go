@@ -0,0 +1,85 @@
+package onpremise
+
+import (
+	"context"
+	"net/http"
+)
+
+// PermissionService handles the permissions of the current user for the Jira instance / API.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/permissions
+type PermissionService service
+
+// UserPermission is a permission, as returned by PermissionService.GetMyPermissions and PermissionService.GetAll.
+type UserPermission struct {
+	ID   string        `json:"id,omitempty" structs:"id,omitempty"`
+	Key  PermissionKey `json:"key" structs:"key"`
+	Name string        `json:"name" structs:"name"`
+	// Type is "GLOBAL" or "PROJECT".
+	Type        string `json:"type" structs:"type"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// HavePermission is only returned by PermissionService.GetMyPermissions.
+	HavePermission bool `json:"havePermission,omitempty" structs:"havePermission,omitempty"`
+	DeprecatedKey  bool `json:"deprecatedKey,omitempty" structs:"deprecatedKey,omitempty"`
+}
+
+// MyPermissionsOptions specifies the context of PermissionService.GetMyPermissions.
+// Without a project or issue, the project permissions are reported for any project.
+type MyPermissionsOptions struct {
+	ProjectKey string `url:"projectKey,omitempty"`
+	ProjectID  string `url:"projectId,omitempty"`
+	IssueKey   string `url:"issueKey,omitempty"`
+	IssueID    string `url:"issueId,omitempty"`
+	// Permissions is a comma separated list of the permission keys to report, like "BROWSE_PROJECTS,EDIT_ISSUES".
+	// By default, all permissions are returned.
+	Permissions string `url:"permissions,omitempty"`
+}
+
+// GetMyPermissions returns the permissions of the current user, keyed by permission key.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/mypermissions-getPermissions
+func (s *PermissionService) GetMyPermissions(ctx context.Context, options *MyPermissionsOptions) (map[PermissionKey]UserPermission, *Response, error) {
+	u, err := addOptions("rest/api/2/mypermissions", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.getPermissions(ctx, u)
+}
+
+// HasPermission reports whether the current user has the permission in the context of options.
+func (s *PermissionService) HasPermission(ctx context.Context, permission PermissionKey, options *MyPermissionsOptions) (bool, *Response, error) {
+	opts := MyPermissionsOptions{}
+	if options != nil {
+		opts = *options
+	}
+	opts.Permissions = string(permission)
+
+	permissions, resp, err := s.GetMyPermissions(ctx, &opts)
+	if err != nil {
+		return false, resp, err
+	}
+	return permissions[permission].HavePermission, resp, nil
+}
+
+// GetAll returns all permissions of the Jira instance, including the ones defined by apps, keyed by permission key.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/permissions-getAllPermissions
+func (s *PermissionService) GetAll(ctx context.Context) (map[PermissionKey]UserPermission, *Response, error) {
+	return s.getPermissions(ctx, "rest/api/2/permissions")
+}
+
+func (s *PermissionService) getPermissions(ctx context.Context, apiEndpoint string) (map[PermissionKey]UserPermission, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := struct {
+		Permissions map[PermissionKey]UserPermission `json:"permissions"`
+	}{}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Permissions, resp, nil
+}
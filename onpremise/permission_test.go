@@ -0,0 +1,71 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestPermissionService_GetMyPermissions(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/mypermissions"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint+"?issueKey=EX-1&permissions=EDIT_ISSUES%2CDELETE_ISSUES")
+		fmt.Fprint(w, `{"permissions":{"EDIT_ISSUES":{"id":"12","key":"EDIT_ISSUES","name":"Edit Issues","type":"PROJECT","description":"Ability to edit issues.","havePermission":true},"DELETE_ISSUES":{"id":"16","key":"DELETE_ISSUES","name":"Delete Issues","type":"PROJECT","havePermission":false}}}`)
+	})
+
+	permissions, _, err := testClient.Permission.GetMyPermissions(context.Background(), &MyPermissionsOptions{
+		IssueKey:    "EX-1",
+		Permissions: "EDIT_ISSUES,DELETE_ISSUES",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !permissions[PermissionEditIssues].HavePermission || permissions[PermissionDeleteIssues].HavePermission {
+		t.Errorf("Unexpected permissions %+v", permissions)
+	}
+}
+
+func TestPermissionService_HasPermission(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/mypermissions"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint+"?permissions=BROWSE_PROJECTS&projectKey=EX")
+		fmt.Fprint(w, `{"permissions":{"BROWSE_PROJECTS":{"id":"10","key":"BROWSE_PROJECTS","name":"Browse Projects","type":"PROJECT","havePermission":true}}}`)
+	})
+
+	ok, _, err := testClient.Permission.HasPermission(context.Background(), PermissionBrowseProjects, &MyPermissionsOptions{ProjectKey: "EX"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !ok {
+		t.Error("Expected permission BROWSE_PROJECTS")
+	}
+}
+
+func TestPermissionService_GetAll(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/permissions"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"permissions":{"BULK_CHANGE":{"key":"BULK_CHANGE","name":"Bulk Change","type":"GLOBAL","description":"Ability to modify a collection of issues at once."}}}`)
+	})
+
+	permissions, _, err := testClient.Permission.GetAll(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if permission, ok := permissions["BULK_CHANGE"]; !ok || permission.Type != "GLOBAL" {
+		t.Errorf("Unexpected permissions %+v", permissions)
+	}
+}
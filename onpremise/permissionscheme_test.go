@@ -3,6 +3,7 @@ package onpremise
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"testing"
@@ -105,3 +106,78 @@ func TestPermissionSchemeService_Get_NoScheme(t *testing.T) {
 		t.Errorf("No error given")
 	}
 }
+
+func TestPermissionSchemeService_GetGrants(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/permissionscheme/10000/permission"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"permissions":[{"id":10000,"self":"https://jira.example.com/rest/api/2/permissionscheme/10000/permission/10000","holder":{"type":"group","parameter":"jira-developers","expand":"group"},"permission":"ADMINISTER_PROJECTS"}]}`)
+	})
+
+	grants, _, err := testClient.PermissionScheme.GetGrants(context.Background(), 10000)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(grants) != 1 || grants[0].Name != PermissionAdministerProjects || grants[0].Holder.Parameter != "jira-developers" {
+		t.Errorf("Unexpected grants %+v", grants)
+	}
+}
+
+func TestPermissionSchemeService_CreateGrant(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/permissionscheme/10000/permission"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"holder":{"type":"anyone"},"permission":"BROWSE_PROJECTS"}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":10001,"holder":{"type":"anyone"},"permission":"BROWSE_PROJECTS"}`)
+	})
+
+	grant, _, err := testClient.PermissionScheme.CreateGrant(context.Background(), 10000, &PermissionGrantPayload{
+		Holder:     Holder{Type: "anyone"},
+		Permission: PermissionBrowseProjects,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if grant.ID != 10001 {
+		t.Errorf("Expected grant 10001, got %d", grant.ID)
+	}
+}
+
+func TestPermissionSchemeService_GetDeleteGrant(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/permissionscheme/10000/permission/10001"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"id":10001,"holder":{"type":"anyone"},"permission":"BROWSE_PROJECTS"}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	grant, _, err := testClient.PermissionScheme.GetGrant(context.Background(), 10000, 10001)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if grant.Name != PermissionBrowseProjects {
+		t.Errorf("Expected permission %s, got %s", PermissionBrowseProjects, grant.Name)
+	}
+	if _, err := testClient.PermissionScheme.DeleteGrant(context.Background(), 10000, 10001); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -2,7 +2,9 @@ package onpremise
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // PriorityService handles priorities for the Jira instance / API.
@@ -41,3 +43,30 @@ func (s *PriorityService) GetList(ctx context.Context) ([]Priority, *Response, e
 	}
 	return priorityList, resp, nil
 }
+
+// Get returns the priority priorityID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/priority-getPriority
+func (s *PriorityService) Get(ctx context.Context, priorityID string) (*Priority, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/priority/%s", url.PathEscape(priorityID))
+	priority := new(Priority)
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, priority)
+	if err != nil {
+		return nil, resp, err
+	}
+	return priority, resp, nil
+}
+
+// do sends a request with the optional body to apiEndpoint and decodes the response into v, if not nil.
+func (s *PriorityService) do(ctx context.Context, method, apiEndpoint string, body, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -31,3 +31,23 @@ func TestPriorityService_GetList(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestPriorityService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/priority/1"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"self":"https://jira.example.com/rest/api/2/priority/1","statusColor":"#cc0000","description":"Blocks development and/or testing work, production could not run.","iconUrl":"https://jira.example.com/images/icons/priorities/blocker.svg","name":"Blocker","id":"1"}`)
+	})
+
+	priority, _, err := testClient.Priority.Get(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if priority.Name != "Blocker" {
+		t.Errorf("Expected priority Blocker, got %s", priority.Name)
+	}
+}
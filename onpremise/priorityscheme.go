@@ -0,0 +1,172 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// PriorityScheme defines the priorities available in the projects using it.
+type PriorityScheme struct {
+	Expand          string   `json:"expand,omitempty" structs:"expand,omitempty"`
+	Self            string   `json:"self,omitempty" structs:"self,omitempty"`
+	ID              int64    `json:"id" structs:"id"`
+	Name            string   `json:"name" structs:"name"`
+	Description     string   `json:"description,omitempty" structs:"description,omitempty"`
+	DefaultOptionID string   `json:"defaultOptionId,omitempty" structs:"defaultOptionId,omitempty"`
+	OptionIDs       []string `json:"optionIds,omitempty" structs:"optionIds,omitempty"`
+	DefaultScheme   bool     `json:"defaultScheme,omitempty" structs:"defaultScheme,omitempty"`
+	// ProjectKeys is only returned with the expand "projectKeys".
+	ProjectKeys []string `json:"projectKeys,omitempty" structs:"projectKeys,omitempty"`
+}
+
+// PrioritySchemeList is a page of priority schemes, as returned by PriorityService.GetSchemes.
+type PrioritySchemeList struct {
+	Expand     string           `json:"expand,omitempty" structs:"expand,omitempty"`
+	Self       string           `json:"self,omitempty" structs:"self,omitempty"`
+	StartAt    int              `json:"startAt" structs:"startAt"`
+	MaxResults int              `json:"maxResults" structs:"maxResults"`
+	Total      int              `json:"total" structs:"total"`
+	Schemes    []PriorityScheme `json:"schemes" structs:"schemes"`
+}
+
+// PrioritySchemePayload is a priority scheme created by PriorityService.CreateScheme or changed by PriorityService.UpdateScheme.
+type PrioritySchemePayload struct {
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// DefaultOptionID is the ID of the default priority. It has to be one of OptionIDs.
+	DefaultOptionID string `json:"defaultOptionId,omitempty" structs:"defaultOptionId,omitempty"`
+	// OptionIDs are the IDs of the priorities of the scheme, in their order.
+	OptionIDs []string `json:"optionIds" structs:"optionIds"`
+}
+
+// PrioritySchemeListOptions specifies the optional parameters for PriorityService.GetSchemes.
+type PrioritySchemeListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// Expand "schemes.projectKeys" to return the keys of the projects using a scheme.
+	Expand string `url:"expand,omitempty"`
+}
+
+// GetSchemes returns one page of the priority schemes.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/priorityschemes-getPrioritySchemes
+func (s *PriorityService) GetSchemes(ctx context.Context, options *PrioritySchemeListOptions) (*PrioritySchemeList, *Response, error) {
+	u, err := addOptions("rest/api/2/priorityschemes", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list := new(PrioritySchemeList)
+	resp, err := s.do(ctx, http.MethodGet, u, nil, list)
+	if err != nil {
+		return nil, resp, err
+	}
+	return list, resp, nil
+}
+
+// GetScheme returns the priority scheme schemeID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/priorityschemes-getPriorityScheme
+func (s *PriorityService) GetScheme(ctx context.Context, schemeID int64) (*PriorityScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/priorityschemes/%d", schemeID)
+	scheme := new(PriorityScheme)
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, scheme)
+	if err != nil {
+		return nil, resp, err
+	}
+	return scheme, resp, nil
+}
+
+// CreateScheme creates a priority scheme.
+// It can be associated with projects via ProjectService.AssignPriorityScheme.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/priorityschemes-createPriorityScheme
+func (s *PriorityService) CreateScheme(ctx context.Context, payload *PrioritySchemePayload) (*PriorityScheme, *Response, error) {
+	scheme := new(PriorityScheme)
+	resp, err := s.do(ctx, http.MethodPost, "rest/api/2/priorityschemes", payload, scheme)
+	if err != nil {
+		return nil, resp, err
+	}
+	return scheme, resp, nil
+}
+
+// UpdateScheme replaces the name, description and priorities of the priority scheme schemeID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/priorityschemes-updatePriorityScheme
+func (s *PriorityService) UpdateScheme(ctx context.Context, schemeID int64, payload *PrioritySchemePayload) (*PriorityScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/priorityschemes/%d", schemeID)
+	scheme := new(PriorityScheme)
+	resp, err := s.do(ctx, http.MethodPut, apiEndpoint, payload, scheme)
+	if err != nil {
+		return nil, resp, err
+	}
+	return scheme, resp, nil
+}
+
+// DeleteScheme deletes the priority scheme schemeID.
+// Projects using it are associated with the default priority scheme.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/priorityschemes-deletePriorityScheme
+func (s *PriorityService) DeleteScheme(ctx context.Context, schemeID int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/priorityschemes/%d", schemeID)
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// GetPriorityScheme returns the priority scheme of the project projectIDOrKey.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectKeyOrId}/priorityscheme-getAssignedPriorityScheme
+func (s *ProjectService) GetPriorityScheme(ctx context.Context, projectIDOrKey string) (*PriorityScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/priorityscheme", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(PriorityScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// AssignPriorityScheme associates the priority scheme schemeID with the project projectIDOrKey.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectKeyOrId}/priorityscheme-assignPriorityScheme
+func (s *ProjectService) AssignPriorityScheme(ctx context.Context, projectIDOrKey string, schemeID int64) (*PriorityScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/priorityscheme", url.PathEscape(projectIDOrKey))
+	body := struct {
+		ID int64 `json:"id"`
+	}{schemeID}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(PriorityScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// UnassignPriorityScheme removes the association of the priority scheme schemeID with the project projectIDOrKey.
+// The project then uses the default priority scheme.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectKeyOrId}/priorityscheme-unassignPriorityScheme
+func (s *ProjectService) UnassignPriorityScheme(ctx context.Context, projectIDOrKey string, schemeID int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/priorityscheme/%d", url.PathEscape(projectIDOrKey), schemeID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
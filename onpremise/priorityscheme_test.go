@@ -0,0 +1,125 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestPriorityService_GetSchemes(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/priorityschemes"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?expand=schemes.projectKeys&maxResults=10")
+		fmt.Fprint(w, `{"expand":"schemes","self":"https://jira.example.com/rest/api/2/priorityschemes?maxResults=10&startAt=0","maxResults":10,"startAt":0,"total":2,"schemes":[{"expand":"projectKeys","self":"https://jira.example.com/rest/api/2/priorityschemes/1","id":1,"name":"Default priority scheme","description":"The default priority scheme","defaultOptionId":"3","optionIds":["1","2","3","4","5"],"defaultScheme":true},{"self":"https://jira.example.com/rest/api/2/priorityschemes/10100","id":10100,"name":"Support","defaultOptionId":"2","optionIds":["1","2"],"projectKeys":["SUP"]}]}`)
+	})
+
+	list, _, err := testClient.Priority.GetSchemes(context.Background(), &PrioritySchemeListOptions{MaxResults: 10, Expand: "schemes.projectKeys"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if list.Total != 2 || len(list.Schemes) != 2 {
+		t.Fatalf("Unexpected priority schemes %+v", list)
+	}
+	if !list.Schemes[0].DefaultScheme || list.Schemes[1].ProjectKeys[0] != "SUP" {
+		t.Errorf("Unexpected priority schemes %+v", list.Schemes)
+	}
+}
+
+func TestPriorityService_SchemeCRUD(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/priorityschemes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		want := `{"name":"Support","defaultOptionId":"2","optionIds":["1","2"]}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":10100,"name":"Support","defaultOptionId":"2","optionIds":["1","2"]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/priorityschemes/10100", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"id":10100,"name":"Support","defaultOptionId":"2","optionIds":["1","2"]}`)
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			want := `{"name":"Support","defaultOptionId":"2","optionIds":["1","2","3"]}` + "\n"
+			if got := string(body); got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+			fmt.Fprint(w, `{"id":10100,"name":"Support","defaultOptionId":"2","optionIds":["1","2","3"]}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	scheme, _, err := testClient.Priority.CreateScheme(context.Background(), &PrioritySchemePayload{Name: "Support", DefaultOptionID: "2", OptionIDs: []string{"1", "2"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	scheme, _, err = testClient.Priority.GetScheme(context.Background(), scheme.ID)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	scheme, _, err = testClient.Priority.UpdateScheme(context.Background(), scheme.ID, &PrioritySchemePayload{
+		Name:            scheme.Name,
+		DefaultOptionID: scheme.DefaultOptionID,
+		OptionIDs:       append(scheme.OptionIDs, "3"),
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(scheme.OptionIDs) != 3 {
+		t.Errorf("Unexpected priorities %v", scheme.OptionIDs)
+	}
+	if _, err := testClient.Priority.DeleteScheme(context.Background(), scheme.ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_PriorityScheme(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/SUP/priorityscheme", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"id":10100}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+		fmt.Fprint(w, `{"id":10100,"name":"Support","defaultOptionId":"2","optionIds":["1","2"]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/project/SUP/priorityscheme/10100", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	scheme, _, err := testClient.Project.AssignPriorityScheme(context.Background(), "SUP", 10100)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if scheme.Name != "Support" {
+		t.Errorf("Unexpected priority scheme %+v", scheme)
+	}
+	if _, _, err := testClient.Project.GetPriorityScheme(context.Background(), "SUP"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Project.UnassignPriorityScheme(context.Background(), "SUP", 10100); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/google/go-querystring/query"
 )
@@ -13,6 +14,24 @@ import (
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project
 type ProjectService service
 
+// ProjectType represents the type of a project.
+type ProjectType string
+
+const (
+	ProjectTypeSoftware    ProjectType = "software"
+	ProjectTypeServiceDesk ProjectType = "service_desk"
+	ProjectTypeBusiness    ProjectType = "business"
+)
+
+// IsValid reports whether t is a project type known to Jira.
+func (t ProjectType) IsValid() bool {
+	switch t {
+	case ProjectTypeSoftware, ProjectTypeServiceDesk, ProjectTypeBusiness:
+		return true
+	}
+	return false
+}
+
 // ProjectList represent a list of Projects
 type ProjectList []struct {
 	Expand          string          `json:"expand" structs:"expand"`
@@ -21,7 +40,7 @@ type ProjectList []struct {
 	Key             string          `json:"key" structs:"key"`
 	Name            string          `json:"name" structs:"name"`
 	AvatarUrls      AvatarUrls      `json:"avatarUrls" structs:"avatarUrls"`
-	ProjectTypeKey  string          `json:"projectTypeKey" structs:"projectTypeKey"`
+	ProjectTypeKey  ProjectType     `json:"projectTypeKey" structs:"projectTypeKey"`
 	ProjectCategory ProjectCategory `json:"projectCategory,omitempty" structs:"projectsCategory,omitempty"`
 	IssueTypes      []IssueType     `json:"issueTypes,omitempty" structs:"issueTypes,omitempty"`
 }
@@ -161,3 +180,269 @@ func (s *ProjectService) GetPermissionScheme(ctx context.Context, projectID stri
 
 	return ps, resp, nil
 }
+
+// CreateProjectPayload is used for creating new projects via ProjectService.Create.
+type CreateProjectPayload struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	// ProjectTypeKey defines the features of the project, like boards for ProjectTypeSoftware.
+	ProjectTypeKey ProjectType `json:"projectTypeKey,omitempty"`
+	// ProjectTemplateKey is the template the project is created from, like "com.pyxis.greenhopper.jira:gh-scrum-template".
+	ProjectTemplateKey string `json:"projectTemplateKey,omitempty"`
+	Description        string `json:"description,omitempty"`
+	// Lead is the username of the project lead.
+	Lead string `json:"lead"`
+	URL  string `json:"url,omitempty"`
+	// AssigneeType is "PROJECT_LEAD" or "UNASSIGNED".
+	AssigneeType        string `json:"assigneeType,omitempty"`
+	AvatarID            int64  `json:"avatarId,omitempty"`
+	IssueSecurityScheme int64  `json:"issueSecurityScheme,omitempty"`
+	PermissionScheme    int64  `json:"permissionScheme,omitempty"`
+	NotificationScheme  int64  `json:"notificationScheme,omitempty"`
+	CategoryID          int64  `json:"categoryId,omitempty"`
+}
+
+// UpdateProjectPayload is used for updating projects via ProjectService.Update.
+// Only non-empty values are changed.
+type UpdateProjectPayload struct {
+	Key                 string `json:"key,omitempty"`
+	Name                string `json:"name,omitempty"`
+	Description         string `json:"description,omitempty"`
+	Lead                string `json:"lead,omitempty"`
+	URL                 string `json:"url,omitempty"`
+	AssigneeType        string `json:"assigneeType,omitempty"`
+	AvatarID            int64  `json:"avatarId,omitempty"`
+	IssueSecurityScheme int64  `json:"issueSecurityScheme,omitempty"`
+	PermissionScheme    int64  `json:"permissionScheme,omitempty"`
+	NotificationScheme  int64  `json:"notificationScheme,omitempty"`
+	CategoryID          int64  `json:"categoryId,omitempty"`
+}
+
+// ProjectIdentifiers identifies a project created via ProjectService.Create.
+type ProjectIdentifiers struct {
+	Self string `json:"self" structs:"self"`
+	ID   int64  `json:"id" structs:"id"`
+	Key  string `json:"key" structs:"key"`
+}
+
+// Create creates a project and returns its ID and key.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-createProject
+func (s *ProjectService) Create(ctx context.Context, project *CreateProjectPayload) (*ProjectIdentifiers, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "rest/api/2/project", project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(ProjectIdentifiers)
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return created, resp, nil
+}
+
+// Update changes the project projectIDOrKey and returns the updated project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-updateProject
+func (s *ProjectService) Update(ctx context.Context, projectIDOrKey string, project *UpdateProjectPayload) (*Project, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(Project)
+	resp, err := s.client.Do(req, updated)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return updated, resp, nil
+}
+
+// Delete deletes the project projectIDOrKey including its issues.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-deleteProject
+func (s *ProjectService) Delete(ctx context.Context, projectIDOrKey string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GetPropertyKeys returns the keys of all properties of the project projectIDOrKey.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectIdOrKey}/properties-getPropertiesKeys
+func (s *ProjectService) GetPropertyKeys(ctx context.Context, projectIDOrKey string) (*PropertyKeys, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/properties", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(PropertyKeys)
+	resp, err := s.client.Do(req, keys)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return keys, resp, nil
+}
+
+// GetProperty returns a property of the project projectIDOrKey.
+// Its value can be decoded into a typed value via EntityProperty.Decode.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectIdOrKey}/properties-getProperty
+func (s *ProjectService) GetProperty(ctx context.Context, projectIDOrKey, propertyKey string) (*EntityProperty, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, projectPropertyEndpoint(projectIDOrKey, propertyKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return property, resp, nil
+}
+
+// SetProperty creates or updates a property of the project projectIDOrKey, like the configuration of an automation.
+// value is encoded as JSON and may not be larger than 32 KB.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectIdOrKey}/properties-setProperty
+func (s *ProjectService) SetProperty(ctx context.Context, projectIDOrKey, propertyKey string, value interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPut, projectPropertyEndpoint(projectIDOrKey, propertyKey), value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteProperty deletes a property of the project projectIDOrKey.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectIdOrKey}/properties-deleteProperty
+func (s *ProjectService) DeleteProperty(ctx context.Context, projectIDOrKey, propertyKey string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, projectPropertyEndpoint(projectIDOrKey, propertyKey), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// projectPropertyEndpoint returns the endpoint of a single project property.
+func projectPropertyEndpoint(projectIDOrKey, propertyKey string) string {
+	return fmt.Sprintf("rest/api/2/project/%s/properties/%s", url.PathEscape(projectIDOrKey), url.PathEscape(propertyKey))
+}
+
+// GetNotificationScheme returns the notification scheme of the project projectIDOrKey,
+// including the recipients of all events.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectKeyOrId}/notificationscheme-getNotificationScheme
+func (s *ProjectService) GetNotificationScheme(ctx context.Context, projectIDOrKey string) (*NotificationScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/notificationscheme?expand=all", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(NotificationScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// SetNotificationScheme associates the notification scheme schemeID with the project projectIDOrKey.
+// It requires the Administer Jira global permission.
+func (s *ProjectService) SetNotificationScheme(ctx context.Context, projectIDOrKey string, schemeID int64) (*Project, *Response, error) {
+	return s.Update(ctx, projectIDOrKey, &UpdateProjectPayload{NotificationScheme: schemeID})
+}
+
+// SecurityLevel is a level of an issue security scheme, restricting who can see an issue.
+type SecurityLevel struct {
+	Self        string `json:"self,omitempty" structs:"self,omitempty"`
+	ID          string `json:"id" structs:"id"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// GetPermissionSchemeGrants returns the permission scheme of the project projectIDOrKey,
+// including its permission grants in PermissionScheme.Permissions.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectKeyOrId}/permissionscheme-getAssignedPermissionScheme
+func (s *ProjectService) GetPermissionSchemeGrants(ctx context.Context, projectIDOrKey string) (*PermissionScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/permissionscheme?expand=permissions", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(PermissionScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// AssignPermissionScheme associates the permission scheme schemeID with the project projectIDOrKey
+// and returns the scheme including its permission grants.
+// It requires the Administer Jira global permission.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectKeyOrId}/permissionscheme-assignPermissionScheme
+func (s *ProjectService) AssignPermissionScheme(ctx context.Context, projectIDOrKey string, schemeID int) (*PermissionScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/permissionscheme?expand=permissions", url.PathEscape(projectIDOrKey))
+	body := struct {
+		ID int `json:"id"`
+	}{schemeID}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(PermissionScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// GetSecurityLevels returns the issue security levels of the project projectIDOrKey
+// the current user can set on issues.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectKeyOrId}/securitylevel-getSecurityLevelsForProject
+func (s *ProjectService) GetSecurityLevels(ctx context.Context, projectIDOrKey string) ([]SecurityLevel, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/securitylevel", url.PathEscape(projectIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	levels := new(struct {
+		Levels []SecurityLevel `json:"levels"`
+	})
+	resp, err := s.client.Do(req, levels)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return levels.Levels, resp, nil
+}
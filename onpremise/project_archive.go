@@ -0,0 +1,38 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Archive archives the project projectIDOrKey.
+// Archived projects and their issues are read-only and left out of searches, until they are restored via ProjectService.Restore.
+// Archiving requires Jira Data Center 7.10 or newer.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-archiveProject
+func (s *ProjectService) Archive(ctx context.Context, projectIDOrKey string) (*Response, error) {
+	return s.putArchiveState(ctx, projectIDOrKey, "archive")
+}
+
+// Restore restores the archived project projectIDOrKey.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-restoreProject
+func (s *ProjectService) Restore(ctx context.Context, projectIDOrKey string) (*Response, error) {
+	return s.putArchiveState(ctx, projectIDOrKey, "restore")
+}
+
+func (s *ProjectService) putArchiveState(ctx context.Context, projectIDOrKey, action string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/%s", url.PathEscape(projectIDOrKey), action)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
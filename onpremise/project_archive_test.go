@@ -0,0 +1,39 @@
+package onpremise
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestProjectService_Archive(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/project/EX/archive"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Project.Archive(context.Background(), "EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_Restore(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/project/EX/restore"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Project.Restore(context.Background(), "EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
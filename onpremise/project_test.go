@@ -2,7 +2,9 @@ package onpremise
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"testing"
@@ -137,3 +139,243 @@ func TestProjectService_GetPermissionScheme_Success(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestProjectService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, "/rest/api/2/project")
+
+		body, _ := io.ReadAll(r.Body)
+		want := `{"key":"EX","name":"Example","projectTypeKey":"software","projectTemplateKey":"TEMPLATE","lead":"fred","permissionScheme":10011}` + "\n"
+		if string(body) != want {
+			t.Errorf("Unexpected request body\ngot:  %s\nwant: %s", body, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"self":"https://jira.example.com/rest/api/2/project/10042","id":10042,"key":"EX"}`)
+	})
+
+	project, _, err := testClient.Project.Create(context.Background(), &CreateProjectPayload{
+		Key:                "EX",
+		Name:               "Example",
+		ProjectTypeKey:     ProjectTypeSoftware,
+		ProjectTemplateKey: "TEMPLATE",
+		Lead:               "fred",
+		PermissionScheme:   10011,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if project == nil || project.ID != 10042 || project.Key != "EX" {
+		t.Errorf("Unexpected project %+v", project)
+	}
+}
+
+func TestProjectService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, "/rest/api/2/project/EX")
+
+		body, _ := io.ReadAll(r.Body)
+		if want := `{"name":"Renamed","description":"New description"}` + "\n"; string(body) != want {
+			t.Errorf("Unexpected request body\ngot:  %s\nwant: %s", body, want)
+		}
+		fmt.Fprint(w, `{"id":"10042","key":"EX","name":"Renamed","description":"New description"}`)
+	})
+
+	project, _, err := testClient.Project.Update(context.Background(), "EX", &UpdateProjectPayload{Name: "Renamed", Description: "New description"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if project == nil || project.Name != "Renamed" {
+		t.Errorf("Unexpected project %+v", project)
+	}
+}
+
+func TestProjectService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/api/2/project/EX")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Project.Delete(context.Background(), "EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_Properties(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/project/EX/properties"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"keys":[{"self":"https://jira.example.com/rest/api/2/project/EX/properties/automation","key":"automation"}]}`)
+	})
+	testMux.HandleFunc(testapiEndpoint+"/automation", func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, testapiEndpoint+"/automation")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"key":"automation","value":{"ruleId":"nightly-triage","revision":3}}`)
+		case http.MethodPut:
+			var value map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&value); err != nil {
+				t.Fatal(err)
+			}
+			if value["ruleId"] != "nightly-triage" || value["revision"] != float64(3) {
+				t.Errorf("Unexpected property value %+v", value)
+			}
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request method %s", r.Method)
+		}
+	})
+
+	type automationConfig struct {
+		RuleID   string `json:"ruleId"`
+		Revision int    `json:"revision"`
+	}
+
+	keys, _, err := testClient.Project.GetPropertyKeys(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(keys.Keys) != 1 || keys.Keys[0].Key != "automation" {
+		t.Errorf("Expected property key automation. Got %+v", keys.Keys)
+	}
+
+	if _, err := testClient.Project.SetProperty(context.Background(), "EX", "automation", automationConfig{RuleID: "nightly-triage", Revision: 3}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	property, _, err := testClient.Project.GetProperty(context.Background(), "EX", "automation")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	var config automationConfig
+	if err := property.Decode(&config); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if config != (automationConfig{RuleID: "nightly-triage", Revision: 3}) {
+		t.Errorf("Expected the decoded automation config. Got %+v", config)
+	}
+
+	if _, err := testClient.Project.DeleteProperty(context.Background(), "EX", "automation"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_GetNotificationScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/project/EX/notificationscheme"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?expand=all")
+		fmt.Fprint(w, `{"expand":"notificationSchemeEvents,user,group,projectRole,field,all","id":10100,"name":"Default Notification Scheme","notificationSchemeEvents":[{"event":{"id":1,"name":"Issue created","description":"This is the issue created event."},"notifications":[{"id":1,"notificationType":"Group","parameter":"jira-administrators","group":{"name":"jira-administrators"}},{"id":2,"notificationType":"CurrentAssignee"}]}]}`)
+	})
+
+	scheme, _, err := testClient.Project.GetNotificationScheme(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if scheme.ID != 10100 || len(scheme.NotificationSchemeEvents) != 1 {
+		t.Fatalf("Unexpected notification scheme %+v", scheme)
+	}
+	notifications := scheme.NotificationSchemeEvents[0].Notifications
+	if len(notifications) != 2 || notifications[0].Group == nil || notifications[0].Group.Name != "jira-administrators" {
+		t.Errorf("Expected the group jira-administrators to be notified. Got %+v", notifications)
+	}
+}
+
+func TestProjectService_SetNotificationScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/project/EX"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint)
+		if b, _ := io.ReadAll(r.Body); string(b) != `{"notificationScheme":10100}`+"\n" {
+			t.Errorf("Unexpected request body %s", b)
+		}
+		fmt.Fprint(w, `{"id":"10000","key":"EX"}`)
+	})
+
+	if _, _, err := testClient.Project.SetNotificationScheme(context.Background(), "EX", 10100); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_GetPermissionSchemeGrants(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/project/EX/permissionscheme"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint+"?expand=permissions")
+		fmt.Fprint(w, `{"id":10000,"name":"Default Permission Scheme","permissions":[{"id":10001,"holder":{"type":"group","parameter":"jira-developers"},"permission":"BROWSE_PROJECTS"}]}`)
+	})
+
+	scheme, _, err := testClient.Project.GetPermissionSchemeGrants(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(scheme.Permissions) != 1 || scheme.Permissions[0].Name != PermissionBrowseProjects || scheme.Permissions[0].Holder.Parameter != "jira-developers" {
+		t.Errorf("Unexpected permission grants %+v", scheme.Permissions)
+	}
+}
+
+func TestProjectService_AssignPermissionScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/project/EX/permissionscheme"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testapiEndpoint+"?expand=permissions")
+		if b, _ := io.ReadAll(r.Body); string(b) != `{"id":10001}`+"\n" {
+			t.Errorf("Unexpected request body %s", b)
+		}
+		fmt.Fprint(w, `{"id":10001,"name":"Restricted Permission Scheme","permissions":[]}`)
+	})
+
+	scheme, _, err := testClient.Project.AssignPermissionScheme(context.Background(), "EX", 10001)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if scheme.ID != 10001 {
+		t.Errorf("Expected permission scheme 10001. Got %+v", scheme)
+	}
+}
+
+func TestProjectService_GetSecurityLevels(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/project/EX/securitylevel"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"levels":[{"self":"https://jira.example.com/rest/api/2/securitylevel/100000","id":"100000","description":"Only the reporter and internal staff can see this issue.","name":"Reporter Only"}]}`)
+	})
+
+	levels, _, err := testClient.Project.GetSecurityLevels(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(levels) != 1 || levels[0].Name != "Reporter Only" {
+		t.Errorf("Unexpected security levels %+v", levels)
+	}
+}
@@ -0,0 +1,65 @@
+package onpremise
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// RateLimiter gates outgoing requests.
+// Wait blocks until the request may be sent or ctx is done.
+//
+// A *rate.Limiter of golang.org/x/time/rate satisfies this interface.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// rateLimiters are the rate limiters of a client.
+type rateLimiters struct {
+	// all gates requests to hosts without an own rate limiter.
+	all RateLimiter
+	// hosts are the rate limiters per host.
+	hosts map[string]RateLimiter
+}
+
+// WithRateLimiter gates every request sent by the client through l.
+// Every attempt of a retried request is gated.
+// Hosts with a rate limiter set via WithHostRateLimiter use their own rate limiter instead.
+func WithRateLimiter(l RateLimiter) ClientOption {
+	return func(c *Client) error {
+		if l == nil {
+			return errors.New("rate limiter must not be nil")
+		}
+		c.rateLimiters.all = l
+		return nil
+	}
+}
+
+// WithHostRateLimiter gates every request sent to host through l.
+// This allows separate budgets, for example if requests go to several Jira sites or to api.atlassian.com.
+// host is matched against the host (and port, if any) of the request URL, case insensitive.
+func WithHostRateLimiter(host string, l RateLimiter) ClientOption {
+	return func(c *Client) error {
+		if host == "" || l == nil {
+			return errors.New("host and rate limiter must not be empty")
+		}
+		if c.rateLimiters.hosts == nil {
+			c.rateLimiters.hosts = map[string]RateLimiter{}
+		}
+		c.rateLimiters.hosts[strings.ToLower(host)] = l
+		return nil
+	}
+}
+
+// wait blocks until req may be sent.
+func (r *rateLimiters) wait(req *http.Request) error {
+	l := r.all
+	if hl, found := r.hosts[strings.ToLower(req.URL.Host)]; found {
+		l = hl
+	}
+	if l == nil {
+		return nil
+	}
+	return l.Wait(req.Context())
+}
@@ -0,0 +1,187 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ScreenService handles screens and screen tabs for the Jira instance / API.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens
+type ScreenService service
+
+// ScreenTab is a tab of a screen.
+type ScreenTab struct {
+	ID   int64  `json:"id" structs:"id"`
+	Name string `json:"name" structs:"name"`
+}
+
+// ScreenableField is a field on a screen tab, or a field that can be added to a screen.
+type ScreenableField struct {
+	ID   string `json:"id" structs:"id"`
+	Name string `json:"name" structs:"name"`
+}
+
+// AddFieldToDefault adds the field fieldID to the default tab of the default screen.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens-addFieldToDefaultScreen
+func (s *ScreenService) AddFieldToDefault(ctx context.Context, fieldID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/addToDefault/%s", url.PathEscape(fieldID))
+	return s.do(ctx, http.MethodPost, apiEndpoint, nil, nil)
+}
+
+// GetAvailableFields returns the fields that can be added to the tabs of the screen screenID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens-getFieldsToAdd
+func (s *ScreenService) GetAvailableFields(ctx context.Context, screenID int64) ([]ScreenableField, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/availableFields", screenID)
+	fields := []ScreenableField{}
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, &fields)
+	if err != nil {
+		return nil, resp, err
+	}
+	return fields, resp, nil
+}
+
+// GetTabs returns the tabs of the screen screenID.
+// If projectKey is set, only the tabs visible in the project are returned.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens/{screenId}/tabs-getAllTabs
+func (s *ScreenService) GetTabs(ctx context.Context, screenID int64, projectKey string) ([]ScreenTab, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs", screenID)
+	if projectKey != "" {
+		apiEndpoint += "?projectKey=" + url.QueryEscape(projectKey)
+	}
+	tabs := []ScreenTab{}
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, &tabs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return tabs, resp, nil
+}
+
+// CreateTab adds a tab named name to the screen screenID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens/{screenId}/tabs-addTab
+func (s *ScreenService) CreateTab(ctx context.Context, screenID int64, name string) (*ScreenTab, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs", screenID)
+	body := struct {
+		Name string `json:"name"`
+	}{name}
+	tab := new(ScreenTab)
+	resp, err := s.do(ctx, http.MethodPost, apiEndpoint, &body, tab)
+	if err != nil {
+		return nil, resp, err
+	}
+	return tab, resp, nil
+}
+
+// RenameTab renames the tab tabID of the screen screenID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens/{screenId}/tabs-renameTab
+func (s *ScreenService) RenameTab(ctx context.Context, screenID, tabID int64, name string) (*ScreenTab, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d", screenID, tabID)
+	body := struct {
+		Name string `json:"name"`
+	}{name}
+	tab := new(ScreenTab)
+	resp, err := s.do(ctx, http.MethodPut, apiEndpoint, &body, tab)
+	if err != nil {
+		return nil, resp, err
+	}
+	return tab, resp, nil
+}
+
+// DeleteTab deletes the tab tabID of the screen screenID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens/{screenId}/tabs-deleteTab
+func (s *ScreenService) DeleteTab(ctx context.Context, screenID, tabID int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d", screenID, tabID)
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// MoveTab moves the tab tabID of the screen screenID to the zero based position pos.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens/{screenId}/tabs-moveTab
+func (s *ScreenService) MoveTab(ctx context.Context, screenID, tabID int64, pos int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d/move/%d", screenID, tabID, pos)
+	return s.do(ctx, http.MethodPost, apiEndpoint, nil, nil)
+}
+
+// GetTabFields returns the fields on the tab tabID of the screen screenID, in their order.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens/{screenId}/tabs/{tabId}/fields-getAllFields
+func (s *ScreenService) GetTabFields(ctx context.Context, screenID, tabID int64) ([]ScreenableField, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d/fields", screenID, tabID)
+	fields := []ScreenableField{}
+	resp, err := s.do(ctx, http.MethodGet, apiEndpoint, nil, &fields)
+	if err != nil {
+		return nil, resp, err
+	}
+	return fields, resp, nil
+}
+
+// AddTabField adds the field fieldID to the end of the tab tabID of the screen screenID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens/{screenId}/tabs/{tabId}/fields-addField
+func (s *ScreenService) AddTabField(ctx context.Context, screenID, tabID int64, fieldID string) (*ScreenableField, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d/fields", screenID, tabID)
+	body := struct {
+		FieldID string `json:"fieldId"`
+	}{fieldID}
+	field := new(ScreenableField)
+	resp, err := s.do(ctx, http.MethodPost, apiEndpoint, &body, field)
+	if err != nil {
+		return nil, resp, err
+	}
+	return field, resp, nil
+}
+
+// RemoveTabField removes the field fieldID from the tab tabID of the screen screenID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens/{screenId}/tabs/{tabId}/fields-removeField
+func (s *ScreenService) RemoveTabField(ctx context.Context, screenID, tabID int64, fieldID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d/fields/%s", screenID, tabID, url.PathEscape(fieldID))
+	return s.do(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// Positions of a field on a screen tab, for ScreenTabFieldMove.Position
+const (
+	ScreenTabFieldPositionEarlier = "Earlier"
+	ScreenTabFieldPositionLater   = "Later"
+	ScreenTabFieldPositionFirst   = "First"
+	ScreenTabFieldPositionLast    = "Last"
+)
+
+// ScreenTabFieldMove describes where ScreenService.MoveTabField moves a field to.
+// Either After or Position has to be set.
+type ScreenTabFieldMove struct {
+	// After is the ID of the field the field is placed after.
+	After string `json:"after,omitempty" structs:"after,omitempty"`
+	// Position is one of the ScreenTabFieldPosition constants.
+	Position string `json:"position,omitempty" structs:"position,omitempty"`
+}
+
+// MoveTabField moves the field fieldID on the tab tabID of the screen screenID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/screens/{screenId}/tabs/{tabId}/fields-moveField
+func (s *ScreenService) MoveTabField(ctx context.Context, screenID, tabID int64, fieldID string, move *ScreenTabFieldMove) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d/fields/%s/move", screenID, tabID, url.PathEscape(fieldID))
+	return s.do(ctx, http.MethodPost, apiEndpoint, move, nil)
+}
+
+// do sends a request with the optional body to apiEndpoint and decodes the response into v, if not nil.
+func (s *ScreenService) do(ctx context.Context, method, apiEndpoint string, body, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
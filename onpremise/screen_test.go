@@ -0,0 +1,149 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestScreenService_AvailableFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/screens/10005/availableFields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":"customfield_10000","name":"Approvers"}]`)
+	})
+	testMux.HandleFunc("/rest/api/2/screens/addToDefault/customfield_10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{}`)
+	})
+
+	fields, _, err := testClient.Screen.GetAvailableFields(context.Background(), 10005)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(fields) != 1 || fields[0].ID != "customfield_10000" {
+		t.Errorf("Unexpected fields %+v", fields)
+	}
+	if _, err := testClient.Screen.AddFieldToDefault(context.Background(), fields[0].ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestScreenService_Tabs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/screens/10005/tabs", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			testRequestURL(t, r, "/rest/api/2/screens/10005/tabs?projectKey=PRJ")
+			fmt.Fprint(w, `[{"id":10000,"name":"Fields Tab"}]`)
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"name":"Details"}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+			fmt.Fprint(w, `{"id":10001,"name":"Details"}`)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/screens/10005/tabs/10001", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			fmt.Fprint(w, `{"id":10001,"name":"More details"}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/screens/10005/tabs/10001/move/0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tabs, _, err := testClient.Screen.GetTabs(context.Background(), 10005, "PRJ")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(tabs) != 1 || tabs[0].Name != "Fields Tab" {
+		t.Errorf("Unexpected tabs %+v", tabs)
+	}
+
+	tab, _, err := testClient.Screen.CreateTab(context.Background(), 10005, "Details")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	tab, _, err = testClient.Screen.RenameTab(context.Background(), 10005, tab.ID, "More details")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if tab.Name != "More details" {
+		t.Errorf("Unexpected tab %+v", tab)
+	}
+	if _, err := testClient.Screen.MoveTab(context.Background(), 10005, tab.ID, 0); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Screen.DeleteTab(context.Background(), 10005, tab.ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestScreenService_TabFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/screens/10005/tabs/10000/fields", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"id":"summary","name":"Summary"}]`)
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"fieldId":"environment"}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+			fmt.Fprint(w, `{"id":"environment","name":"Environment"}`)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/screens/10005/tabs/10000/fields/environment/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"position":"First"}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/screens/10005/tabs/10000/fields/environment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	fields, _, err := testClient.Screen.GetTabFields(context.Background(), 10005, 10000)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(fields) != 1 || fields[0].ID != "summary" {
+		t.Errorf("Unexpected fields %+v", fields)
+	}
+
+	field, _, err := testClient.Screen.AddTabField(context.Background(), 10005, 10000, "environment")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if field.Name != "Environment" {
+		t.Errorf("Unexpected field %+v", field)
+	}
+	if _, err := testClient.Screen.MoveTabField(context.Background(), 10005, 10000, field.ID, &ScreenTabFieldMove{Position: ScreenTabFieldPositionFirst}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Screen.RemoveTabField(context.Background(), 10005, 10000, field.ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -2,9 +2,10 @@ package onpremise
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 // UserService handles users for the Jira instance / API.
@@ -27,6 +28,8 @@ type User struct {
 	TimeZone        string     `json:"timeZone,omitempty" structs:"timeZone,omitempty"`
 	Locale          string     `json:"locale,omitempty" structs:"locale,omitempty"`
 	ApplicationKeys []string   `json:"applicationKeys,omitempty" structs:"applicationKeys,omitempty"`
+	// Groups is only returned with the expand "groups".
+	Groups *UserGroups `json:"groups,omitempty" structs:"groups,omitempty"`
 }
 
 // UserGroup represents the group list
@@ -35,6 +38,12 @@ type UserGroup struct {
 	Name string `json:"name,omitempty" structs:"name,omitempty"`
 }
 
+// UserGroups is a wrapper for UserGroup
+type UserGroups struct {
+	Size  int         `json:"size,omitempty" structs:"size,omitempty"`
+	Items []UserGroup `json:"items,omitempty" structs:"items,omitempty"`
+}
+
 type userSearchParam struct {
 	name  string
 	value string
@@ -87,44 +96,95 @@ func (s *UserService) GetByAccountID(ctx context.Context, accountID string) (*Us
 	return user, resp, nil
 }
 
-// Create creates an user in Jira.
-//
-// Jira API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-createUser
+// UserCreatePayload is a user created by UserService.Create.
+type UserCreatePayload struct {
+	Name         string `json:"name" structs:"name"`
+	EmailAddress string `json:"emailAddress" structs:"emailAddress"`
+	DisplayName  string `json:"displayName" structs:"displayName"`
+	// Password is the initial password. If it is empty, Jira generates a random one
+	// and the user has to reset it.
+	Password string `json:"password,omitempty" structs:"password,omitempty"`
+	// Notification sends the new user an email with a link to set their password.
+	Notification bool `json:"notification,omitempty" structs:"notification,omitempty"`
+	// ApplicationKeys are the applications the user gets access to, like "jira-software".
+	// By default, the user gets access to the default applications.
+	ApplicationKeys []string `json:"applicationKeys,omitempty" structs:"applicationKeys,omitempty"`
+}
+
+// UserUpdatePayload are the changes of UserService.Update.
+// Empty attributes are left unchanged.
+type UserUpdatePayload struct {
+	// Name renames the user.
+	Name         string `json:"name,omitempty" structs:"name,omitempty"`
+	EmailAddress string `json:"emailAddress,omitempty" structs:"emailAddress,omitempty"`
+	DisplayName  string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	// Active activates or deactivates the user.
+	Active *bool `json:"active,omitempty" structs:"active,omitempty"`
+}
+
+// Create creates a user in Jira and returns it.
 //
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *UserService) Create(ctx context.Context, user *User) (*User, *Response, error) {
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user-createUser
+func (s *UserService) Create(ctx context.Context, payload *UserCreatePayload) (*User, *Response, error) {
 	apiEndpoint := "/rest/api/2/user"
-	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, user)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(req, nil)
+	responseUser := new(User)
+	resp, err := s.client.Do(req, responseUser)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, NewJiraError(resp, err)
 	}
-	defer resp.Body.Close()
+	return responseUser, resp, nil
+}
 
-	responseUser := new(User)
-	err = json.NewDecoder(resp.Body).Decode(&responseUser)
+// Update changes the user username and returns it.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user-updateUser
+func (s *UserService) Update(ctx context.Context, username string, payload *UserUpdatePayload) (*User, *Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/user?username=%s", url.QueryEscape(username))
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, payload)
 	if err != nil {
-		return nil, resp, err
+		return nil, nil, err
 	}
 
-	return responseUser, resp, nil
+	user := new(User)
+	resp, err := s.client.Do(req, user)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return user, resp, nil
 }
 
-// Delete deletes an user from Jira.
-// Returns http.StatusNoContent on success.
+// SetPassword sets the password of the user username.
+// This requires administrator permissions.
 //
-// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-user-delete
-// Caller must close resp.Body
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user-changeUserPassword
+func (s *UserService) SetPassword(ctx context.Context, username, password string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/user/password?username=%s", url.QueryEscape(username))
+	body := struct {
+		Password string `json:"password"`
+	}{password}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete deletes the user username from Jira.
+// Returns http.StatusNoContent on success.
 //
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *UserService) Delete(ctx context.Context, accountId string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/2/user?accountId=%s", accountId)
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user-removeUser
+func (s *UserService) Delete(ctx context.Context, username string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/user?username=%s", url.QueryEscape(username))
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
 	if err != nil {
 		return nil, err
@@ -137,6 +197,97 @@ func (s *UserService) Delete(ctx context.Context, accountId string) (*Response,
 	return resp, nil
 }
 
+// UserAssignableSearchOptions specifies the parameters for UserService.FindAssignable.
+// One of Project or IssueKey is required.
+type UserAssignableSearchOptions struct {
+	// Username is matched against the username, display name and email address of users.
+	Username string `url:"username,omitempty"`
+	// Project is the key of the project new issues are assigned in.
+	Project  string `url:"project,omitempty"`
+	IssueKey string `url:"issueKey,omitempty"`
+	// ActionDescriptorID is the ID of a workflow transition, to find users assignable during it.
+	ActionDescriptorID int `url:"actionDescriptorId,omitempty"`
+	StartAt            int `url:"startAt,omitempty"`
+	MaxResults         int `url:"maxResults,omitempty"`
+}
+
+// UserMultiProjectAssignableSearchOptions specifies the parameters for UserService.FindAssignableMultiProject.
+type UserMultiProjectAssignableSearchOptions struct {
+	Username string `url:"username,omitempty"`
+	// ProjectKeys are the projects the users have to be assignable in.
+	ProjectKeys []string `url:"projectKeys,comma,omitempty"`
+	StartAt     int      `url:"startAt,omitempty"`
+	MaxResults  int      `url:"maxResults,omitempty"`
+}
+
+// UserPermissionSearchOptions specifies the parameters for UserService.FindWithPermissions.
+// One of IssueKey or ProjectKey is required.
+type UserPermissionSearchOptions struct {
+	Username string `url:"username,omitempty"`
+	// Permissions the users need to hold all of. It is required.
+	Permissions []PermissionKey `url:"permissions,comma,omitempty"`
+	IssueKey    string          `url:"issueKey,omitempty"`
+	ProjectKey  string          `url:"projectKey,omitempty"`
+	StartAt     int             `url:"startAt,omitempty"`
+	MaxResults  int             `url:"maxResults,omitempty"`
+}
+
+// UserViewableSearchOptions specifies the parameters for UserService.FindViewable.
+// One of IssueKey or ProjectKey is required.
+type UserViewableSearchOptions struct {
+	Username   string `url:"username,omitempty"`
+	IssueKey   string `url:"issueKey,omitempty"`
+	ProjectKey string `url:"projectKey,omitempty"`
+	StartAt    int    `url:"startAt,omitempty"`
+	MaxResults int    `url:"maxResults,omitempty"`
+}
+
+// FindAssignable returns one page of the users that can be assigned to the issue or to new issues of the project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user-findAssignableUsers
+func (s *UserService) FindAssignable(ctx context.Context, options *UserAssignableSearchOptions) ([]User, *Response, error) {
+	return s.findUsers(ctx, "/rest/api/2/user/assignable/search", options)
+}
+
+// FindAssignableMultiProject returns one page of the users that can be assigned to issues in all of the projects.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user-findBulkAssignableUsers
+func (s *UserService) FindAssignableMultiProject(ctx context.Context, options *UserMultiProjectAssignableSearchOptions) ([]User, *Response, error) {
+	return s.findUsers(ctx, "/rest/api/2/user/assignable/multiProjectSearch", options)
+}
+
+// FindWithPermissions returns one page of the users that hold all of the permissions in the context of the issue or project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user-findUsersWithAllPermissions
+func (s *UserService) FindWithPermissions(ctx context.Context, options *UserPermissionSearchOptions) ([]User, *Response, error) {
+	return s.findUsers(ctx, "/rest/api/2/user/permission/search", options)
+}
+
+// FindViewable returns one page of the users that have permission to view the issue, or the issues of the project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user-findUsersWithBrowsePermission
+func (s *UserService) FindViewable(ctx context.Context, options *UserViewableSearchOptions) ([]User, *Response, error) {
+	return s.findUsers(ctx, "/rest/api/2/user/viewissue/search", options)
+}
+
+func (s *UserService) findUsers(ctx context.Context, apiEndpoint string, options interface{}) ([]User, *Response, error) {
+	u, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users := []User{}
+	resp, err := s.client.Do(req, &users)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return users, resp, nil
+}
+
 // GetGroups returns the groups which the user belongs to
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-user-groups-get
@@ -158,14 +309,15 @@ func (s *UserService) GetGroups(ctx context.Context, accountId string) (*[]UserG
 	return userGroups, resp, nil
 }
 
-// GetSelf information about the current logged-in user
+// GetSelf returns details for the current user.
+// expand can be "groups" to return the groups of the user in User.Groups.
 //
-// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-myself-get
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *UserService) GetSelf(ctx context.Context) (*User, *Response, error) {
-	const apiEndpoint = "rest/api/2/myself"
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/myself-getUser
+func (s *UserService) GetSelf(ctx context.Context, expand ...string) (*User, *Response, error) {
+	apiEndpoint := "rest/api/2/myself"
+	if len(expand) > 0 {
+		apiEndpoint += "?expand=" + url.QueryEscape(strings.Join(expand, ","))
+	}
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,189 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Statuses of UserAnonymizationProgress.Status
+const (
+	UserAnonymizationInProgress       = "IN_PROGRESS"
+	UserAnonymizationCompleted        = "COMPLETED"
+	UserAnonymizationInterrupted      = "INTERRUPTED"
+	UserAnonymizationValidationFailed = "VALIDATION_FAILED"
+)
+
+// UserAnonymizationMessages are the errors or warnings of an anonymization step, like "USER_NOT_FOUND".
+type UserAnonymizationMessages struct {
+	ErrorMessages []string          `json:"errorMessages,omitempty" structs:"errorMessages,omitempty"`
+	Errors        map[string]string `json:"errors,omitempty" structs:"errors,omitempty"`
+}
+
+// UserAnonymizationEntity is an entity changed by the anonymization of a user, like a project lead or a filter.
+type UserAnonymizationEntity struct {
+	Type                string `json:"type" structs:"type"`
+	Description         string `json:"description,omitempty" structs:"description,omitempty"`
+	NumberOfOccurrences int    `json:"numberOfOccurrences,omitempty" structs:"numberOfOccurrences,omitempty"`
+	URIDisplayName      string `json:"uriDisplayName,omitempty" structs:"uriDisplayName,omitempty"`
+	URI                 string `json:"uri,omitempty" structs:"uri,omitempty"`
+}
+
+// UserAnonymizationValidation is the result of validating the anonymization of a user.
+// Success reports whether the anonymization can be scheduled.
+type UserAnonymizationValidation struct {
+	Expand      string `json:"expand,omitempty" structs:"expand,omitempty"`
+	UserKey     string `json:"userKey,omitempty" structs:"userKey,omitempty"`
+	UserName    string `json:"userName,omitempty" structs:"userName,omitempty"`
+	DisplayName string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	Email       string `json:"email,omitempty" structs:"email,omitempty"`
+	Deleted     bool   `json:"deleted,omitempty" structs:"deleted,omitempty"`
+	Success     bool   `json:"success" structs:"success"`
+	// Errors and Warnings are keyed by their type, like "USER_NOT_FOUND".
+	Errors                        map[string]UserAnonymizationMessages `json:"errors,omitempty" structs:"errors,omitempty"`
+	Warnings                      map[string]UserAnonymizationMessages `json:"warnings,omitempty" structs:"warnings,omitempty"`
+	BusinessLogicValidationFailed bool                                 `json:"businessLogicValidationFailed,omitempty" structs:"businessLogicValidationFailed,omitempty"`
+	// Operations are the steps of the anonymization, like "USER_KEY_CHANGE".
+	Operations []string `json:"operations,omitempty" structs:"operations,omitempty"`
+	// AffectedEntities is only returned with the expand "affectedEntities". It is keyed by the kind of change, like "ANONYMIZE".
+	AffectedEntities map[string][]UserAnonymizationEntity `json:"affectedEntities,omitempty" structs:"affectedEntities,omitempty"`
+}
+
+// UserAnonymizationProgress is the progress of a scheduled anonymization task.
+type UserAnonymizationProgress struct {
+	Expand          string                               `json:"expand,omitempty" structs:"expand,omitempty"`
+	Errors          map[string]UserAnonymizationMessages `json:"errors,omitempty" structs:"errors,omitempty"`
+	Warnings        map[string]UserAnonymizationMessages `json:"warnings,omitempty" structs:"warnings,omitempty"`
+	UserKey         string                               `json:"userKey,omitempty" structs:"userKey,omitempty"`
+	UserName        string                               `json:"userName,omitempty" structs:"userName,omitempty"`
+	FullName        string                               `json:"fullName,omitempty" structs:"fullName,omitempty"`
+	ProgressURL     string                               `json:"progressUrl,omitempty" structs:"progressUrl,omitempty"`
+	CurrentProgress int                                  `json:"currentProgress" structs:"currentProgress"`
+	CurrentSubTask  string                               `json:"currentSubTask,omitempty" structs:"currentSubTask,omitempty"`
+	SubmittedTime   string                               `json:"submittedTime,omitempty" structs:"submittedTime,omitempty"`
+	StartTime       string                               `json:"startTime,omitempty" structs:"startTime,omitempty"`
+	FinishTime      string                               `json:"finishTime,omitempty" structs:"finishTime,omitempty"`
+	Operations      []string                             `json:"operations,omitempty" structs:"operations,omitempty"`
+	// Status is one of the UserAnonymization* constants.
+	Status        string `json:"status" structs:"status"`
+	IsRerun       bool   `json:"isRerun,omitempty" structs:"isRerun,omitempty"`
+	ExecutingNode string `json:"executingNode,omitempty" structs:"executingNode,omitempty"`
+}
+
+// TaskID returns the ID of the anonymization task, as contained in ProgressURL.
+// It can be passed to UserService.GetAnonymizationProgress.
+func (p *UserAnonymizationProgress) TaskID() (int64, error) {
+	u, err := url.Parse(p.ProgressURL)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(u.Query().Get("taskId"), 10, 64)
+}
+
+// ValidateAnonymization checks whether the user userKey can be anonymized.
+// expand "affectedEntities" returns the entities that will be changed.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user/anonymization-validateUserAnonymization
+func (s *UserService) ValidateAnonymization(ctx context.Context, userKey, expand string) (*UserAnonymizationValidation, *Response, error) {
+	query := url.Values{"userKey": {userKey}}
+	if expand != "" {
+		query.Set("expand", expand)
+	}
+	validation := new(UserAnonymizationValidation)
+	resp, err := s.anonymization(ctx, http.MethodGet, "/rest/api/2/user/anonymization?"+query.Encode(), nil, validation)
+	if err != nil {
+		return nil, resp, err
+	}
+	return validation, resp, nil
+}
+
+// ScheduleAnonymization schedules the anonymization of the user userKey.
+// Entities owned by the user, like filters or projects, are transferred to the user newOwnerKey.
+// The user has to be deactivated before, and only one anonymization can run at a time.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user/anonymization-scheduleUserAnonymization
+func (s *UserService) ScheduleAnonymization(ctx context.Context, userKey, newOwnerKey string) (*UserAnonymizationProgress, *Response, error) {
+	body := struct {
+		UserKey     string `json:"userKey"`
+		NewOwnerKey string `json:"newOwnerKey"`
+	}{userKey, newOwnerKey}
+	progress := new(UserAnonymizationProgress)
+	resp, err := s.anonymization(ctx, http.MethodPost, "/rest/api/2/user/anonymization", &body, progress)
+	if err != nil {
+		return nil, resp, err
+	}
+	return progress, resp, nil
+}
+
+// GetAnonymizationProgress returns the progress of the anonymization task taskID.
+// If taskID is 0, the progress of the last anonymization task is returned.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user/anonymization/progress-getProgress
+func (s *UserService) GetAnonymizationProgress(ctx context.Context, taskID int64) (*UserAnonymizationProgress, *Response, error) {
+	apiEndpoint := "/rest/api/2/user/anonymization/progress"
+	if taskID != 0 {
+		apiEndpoint += fmt.Sprintf("?taskId=%d", taskID)
+	}
+	progress := new(UserAnonymizationProgress)
+	resp, err := s.anonymization(ctx, http.MethodGet, apiEndpoint, nil, progress)
+	if err != nil {
+		return nil, resp, err
+	}
+	return progress, resp, nil
+}
+
+// ValidateAnonymizationRerun checks whether the anonymization of a user can be run again,
+// for example after it was interrupted. The user is identified by its key or name before the anonymization.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user/anonymization/rerun-validateUserAnonymizationRerun
+func (s *UserService) ValidateAnonymizationRerun(ctx context.Context, oldUserKey, oldUserName, expand string) (*UserAnonymizationValidation, *Response, error) {
+	query := url.Values{}
+	if oldUserKey != "" {
+		query.Set("oldUserKey", oldUserKey)
+	}
+	if oldUserName != "" {
+		query.Set("oldUserName", oldUserName)
+	}
+	if expand != "" {
+		query.Set("expand", expand)
+	}
+	validation := new(UserAnonymizationValidation)
+	resp, err := s.anonymization(ctx, http.MethodGet, "/rest/api/2/user/anonymization/rerun?"+query.Encode(), nil, validation)
+	if err != nil {
+		return nil, resp, err
+	}
+	return validation, resp, nil
+}
+
+// ScheduleAnonymizationRerun schedules the anonymization of a user again.
+// The user is identified by its key or name before the anonymization.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user/anonymization/rerun-scheduleUserAnonymizationRerun
+func (s *UserService) ScheduleAnonymizationRerun(ctx context.Context, oldUserKey, oldUserName string) (*UserAnonymizationProgress, *Response, error) {
+	body := struct {
+		OldUserKey  string `json:"oldUserKey,omitempty"`
+		OldUserName string `json:"oldUserName,omitempty"`
+	}{oldUserKey, oldUserName}
+	progress := new(UserAnonymizationProgress)
+	resp, err := s.anonymization(ctx, http.MethodPost, "/rest/api/2/user/anonymization/rerun", &body, progress)
+	if err != nil {
+		return nil, resp, err
+	}
+	return progress, resp, nil
+}
+
+// anonymization sends a request with the optional body to apiEndpoint and decodes the response into v.
+func (s *UserService) anonymization(ctx context.Context, method, apiEndpoint string, body, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,116 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestUserService_ValidateAnonymization(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/user/anonymization?expand=affectedEntities&userKey=JIRAUSER10100")
+		fmt.Fprint(w, `{"errors":{},"warnings":{"FILTERS_OWNED":{"errorMessages":["The user owns filters."],"errors":{}}},"expand":"affectedEntities","userKey":"JIRAUSER10100","userName":"fred","displayName":"Fred F. User","email":"fred@example.com","deleted":false,"success":true,"affectedEntities":{"TRANSFER_OWNERSHIP":[{"type":"TRANSFER_OWNERSHIP","description":"Filters","numberOfOccurrences":2}]},"operations":["USER_NAME_CHANGE","USER_KEY_CHANGE"],"businessLogicValidationFailed":false}`)
+	})
+
+	validation, _, err := testClient.User.ValidateAnonymization(context.Background(), "JIRAUSER10100", "affectedEntities")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !validation.Success || len(validation.Operations) != 2 {
+		t.Errorf("Unexpected validation %+v", validation)
+	}
+	if got := validation.Warnings["FILTERS_OWNED"].ErrorMessages; len(got) != 1 {
+		t.Errorf("Unexpected warnings %+v", validation.Warnings)
+	}
+	if got := validation.AffectedEntities["TRANSFER_OWNERSHIP"]; len(got) != 1 || got[0].NumberOfOccurrences != 2 {
+		t.Errorf("Unexpected affected entities %+v", validation.AffectedEntities)
+	}
+}
+
+func TestUserService_ScheduleAnonymization(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"userKey":"JIRAUSER10100","newOwnerKey":"admin"}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"userKey":"JIRAUSER10100","userName":"fred","progressUrl":"/rest/api/2/user/anonymization/progress?taskId=10102","currentProgress":0,"submittedTime":"2019-11-05T10:21:18.929+0000","status":"IN_PROGRESS","isRerun":false}`)
+	})
+
+	progress, _, err := testClient.User.ScheduleAnonymization(context.Background(), "JIRAUSER10100", "admin")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if progress.Status != UserAnonymizationInProgress {
+		t.Errorf("Expected status %s, got %s", UserAnonymizationInProgress, progress.Status)
+	}
+	taskID, err := progress.TaskID()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if taskID != 10102 {
+		t.Errorf("Expected task 10102, got %d", taskID)
+	}
+}
+
+func TestUserService_GetAnonymizationProgress(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization/progress", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/user/anonymization/progress?taskId=10102")
+		fmt.Fprint(w, `{"userKey":"JIRAUSER10100","progressUrl":"/rest/api/2/user/anonymization/progress?taskId=10102","currentProgress":100,"currentSubTask":"Anonymizing user key","startTime":"2019-11-05T10:21:19.100+0000","finishTime":"2019-11-05T10:23:04.188+0000","status":"COMPLETED","executingNode":"node1"}`)
+	})
+
+	progress, _, err := testClient.User.GetAnonymizationProgress(context.Background(), 10102)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if progress.Status != UserAnonymizationCompleted || progress.CurrentProgress != 100 {
+		t.Errorf("Unexpected progress %+v", progress)
+	}
+}
+
+func TestUserService_AnonymizationRerun(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization/rerun", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			testRequestURL(t, r, "/rest/api/2/user/anonymization/rerun?oldUserName=fred")
+			fmt.Fprint(w, `{"userKey":"JIRAUSER10100","success":true,"operations":["USER_NAME_CHANGE"]}`)
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := string(body), `{"oldUserName":"fred"}`+"\n"; got != want {
+				t.Errorf("Unexpected body %s, want %s", got, want)
+			}
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{"userKey":"JIRAUSER10100","progressUrl":"/rest/api/2/user/anonymization/progress?taskId=10103","status":"IN_PROGRESS","isRerun":true}`)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	validation, _, err := testClient.User.ValidateAnonymizationRerun(context.Background(), "", "fred", "")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !validation.Success {
+		t.Errorf("Unexpected validation %+v", validation)
+	}
+	progress, _, err := testClient.User.ScheduleAnonymizationRerun(context.Background(), "", "fred")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !progress.IsRerun {
+		t.Errorf("Unexpected progress %+v", progress)
+	}
+}
@@ -3,6 +3,7 @@ package onpremise
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 )
@@ -59,17 +60,23 @@ func TestUserService_Create(t *testing.T) {
 	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodPost)
 		testRequestURL(t, r, "/rest/api/2/user")
+		body, _ := io.ReadAll(r.Body)
+		want := `{"name":"charlie","emailAddress":"charlie@atlassian.com","displayName":"Charlie of Atlassian","password":"abracadabra","notification":true,"applicationKeys":["jira-core"]}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Unexpected body\ngot:  %s\nwant: %s", got, want)
+		}
 
 		w.WriteHeader(http.StatusCreated)
-		fmt.Fprint(w, `{"name":"charlie","password":"abracadabra","emailAddress":"charlie@atlassian.com",
+		fmt.Fprint(w, `{"name":"charlie","emailAddress":"charlie@atlassian.com",
         "displayName":"Charlie of Atlassian","applicationKeys":["jira-core"]}`)
 	})
 
-	u := &User{
+	u := &UserCreatePayload{
 		Name:            "charlie",
 		Password:        "abracadabra",
 		EmailAddress:    "charlie@atlassian.com",
 		DisplayName:     "Charlie of Atlassian",
+		Notification:    true,
 		ApplicationKeys: []string{"jira-core"},
 	}
 
@@ -85,12 +92,12 @@ func TestUserService_Delete(t *testing.T) {
 	defer teardown()
 	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodDelete)
-		testRequestURL(t, r, "/rest/api/2/user?accountId=000000000000000000000000")
+		testRequestURL(t, r, "/rest/api/2/user?username=charlie")
 
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	resp, err := testClient.User.Delete(context.Background(), "000000000000000000000000")
+	resp, err := testClient.User.Delete(context.Background(), "charlie")
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
@@ -100,6 +107,47 @@ func TestUserService_Delete(t *testing.T) {
 	}
 }
 
+func TestUserService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, "/rest/api/2/user?username=charlie%2Bold")
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"displayName":"Charlie","active":false}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		fmt.Fprint(w, `{"name":"charlie+old","displayName":"Charlie","active":false}`)
+	})
+
+	active := false
+	user, _, err := testClient.User.Update(context.Background(), "charlie+old", &UserUpdatePayload{DisplayName: "Charlie", Active: &active})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.DisplayName != "Charlie" || user.Active {
+		t.Errorf("Unexpected user %+v", user)
+	}
+}
+
+func TestUserService_SetPassword(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/password", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, "/rest/api/2/user/password?username=charlie")
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"password":"s3cr3t"}`+"\n"; got != want {
+			t.Errorf("Unexpected body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.User.SetPassword(context.Background(), "charlie", "s3cr3t"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
 func TestUserService_GetGroups(t *testing.T) {
 	setup()
 	defer teardown()
@@ -191,3 +239,79 @@ func TestUserService_Find_SuccessParams(t *testing.T) {
 		t.Error("Expected user. User is nil")
 	}
 }
+
+func TestUserService_FindAssignable(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/assignable/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/user/assignable/search?maxResults=10&project=EX&username=fred")
+		fmt.Fprint(w, `[{"self":"https://jira.example.com/rest/api/2/user?username=fred","key":"JIRAUSER10100","name":"fred","displayName":"Fred F. User","active":true}]`)
+	})
+
+	users, _, err := testClient.User.FindAssignable(context.Background(), &UserAssignableSearchOptions{Username: "fred", Project: "EX", MaxResults: 10})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].Name != "fred" {
+		t.Errorf("Unexpected users %+v", users)
+	}
+}
+
+func TestUserService_FindAssignableMultiProject(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/assignable/multiProjectSearch", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/user/assignable/multiProjectSearch?projectKeys=EX%2CABC&startAt=50")
+		fmt.Fprint(w, `[]`)
+	})
+
+	users, _, err := testClient.User.FindAssignableMultiProject(context.Background(), &UserMultiProjectAssignableSearchOptions{ProjectKeys: []string{"EX", "ABC"}, StartAt: 50})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 0 {
+		t.Errorf("Expected no users, got %d", len(users))
+	}
+}
+
+func TestUserService_FindWithPermissions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/permission/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/user/permission/search?issueKey=EX-1&permissions=ADMINISTER_PROJECTS&username=fred")
+		fmt.Fprint(w, `[{"key":"JIRAUSER10100","name":"fred","displayName":"Fred F. User","active":true}]`)
+	})
+
+	users, _, err := testClient.User.FindWithPermissions(context.Background(), &UserPermissionSearchOptions{
+		Username:    "fred",
+		Permissions: []PermissionKey{PermissionAdministerProjects},
+		IssueKey:    "EX-1",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].Key != "JIRAUSER10100" {
+		t.Errorf("Unexpected users %+v", users)
+	}
+}
+
+func TestUserService_FindViewable(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/viewissue/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/user/viewissue/search?projectKey=EX&username=fr")
+		fmt.Fprint(w, `[{"key":"JIRAUSER10100","name":"fred","displayName":"Fred F. User","active":true}]`)
+	})
+
+	users, _, err := testClient.User.FindViewable(context.Background(), &UserViewableSearchOptions{Username: "fr", ProjectKey: "EX"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 {
+		t.Errorf("Expected 1 user, got %d", len(users))
+	}
+}
@@ -0,0 +1,79 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// VersionRemoteLink is a link of a version to an object in a remote application, like a build.
+type VersionRemoteLink struct {
+	Self string `json:"self,omitempty" structs:"self,omitempty"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+	// Link is the JSON stored for the link. Its format is defined by the application creating it.
+	Link json.RawMessage `json:"link,omitempty" structs:"link,omitempty"`
+}
+
+// GetRemoteLinks returns the remote links of the version versionID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/version-getRemoteVersionLinksByVersionId
+func (s *VersionService) GetRemoteLinks(ctx context.Context, versionID string) ([]VersionRemoteLink, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, versionRemoteLinkEndpoint(versionID, ""), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	links := new(struct {
+		Links []VersionRemoteLink `json:"links"`
+	})
+	resp, err := s.client.Do(req, links)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return links.Links, resp, nil
+}
+
+// SetRemoteLink creates or updates the remote link globalID of the version versionID.
+// link is encoded as JSON. If globalID is empty, a new link is created.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/version-createOrUpdateRemoteVersionLink
+func (s *VersionService) SetRemoteLink(ctx context.Context, versionID, globalID string, link interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, versionRemoteLinkEndpoint(versionID, globalID), link)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteRemoteLink deletes the remote link globalID of the version versionID.
+// If globalID is empty, all remote links of the version are deleted.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/version-deleteRemoteVersionLink
+func (s *VersionService) DeleteRemoteLink(ctx context.Context, versionID, globalID string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, versionRemoteLinkEndpoint(versionID, globalID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// versionRemoteLinkEndpoint returns the endpoint of the remote link globalID of a version, or of all its remote links.
+func versionRemoteLinkEndpoint(versionID, globalID string) string {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s/remotelink", url.PathEscape(versionID))
+	if globalID != "" {
+		apiEndpoint += "/" + url.PathEscape(globalID)
+	}
+	return apiEndpoint
+}
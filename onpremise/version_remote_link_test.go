@@ -0,0 +1,54 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestVersionService_RemoteLinks(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/version/10000/remotelink"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, testapiEndpoint)
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"links":[{"self":"https://jira.example.com/rest/api/2/version/10000/remotelink/build-42","name":"Build #42","link":{"url":"https://ci.example.com/builds/42"}}]}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request method %s", r.Method)
+		}
+	})
+	testMux.HandleFunc(testapiEndpoint+"/build-42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var link map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&link); err != nil {
+			t.Fatal(err)
+		}
+		if link["url"] != "https://ci.example.com/builds/42" {
+			t.Errorf("Unexpected remote link %+v", link)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	if _, err := testClient.Version.SetRemoteLink(context.Background(), "10000", "build-42", map[string]string{"url": "https://ci.example.com/builds/42"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	links, _, err := testClient.Version.GetRemoteLinks(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(links) != 1 || links[0].Name != "Build #42" || string(links[0].Link) != `{"url":"https://ci.example.com/builds/42"}` {
+		t.Errorf("Unexpected remote links %+v", links)
+	}
+
+	if _, err := testClient.Version.DeleteRemoteLink(context.Background(), "10000", ""); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}